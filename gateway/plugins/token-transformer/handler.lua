@@ -17,7 +17,7 @@ function TokenTransformerHandler:access(conf)
     return kong.response.exit(401, { message = "Invalid Authorization header format" })
   end
 
-  local auth_server_url = "http://auth:8000/introspect"
+  local auth_server_url = conf.auth_url .. "/introspect"
 
   -- resty.http を正しく使用
   local httpc = http.new()
@@ -25,9 +25,11 @@ function TokenTransformerHandler:access(conf)
   
   local res, err = httpc:request_uri(auth_server_url, {
     method = "POST",
-    body = '{"token":"' .. opaque_token .. '"}',
+    body = cjson.encode({ token = opaque_token }),
     headers = {
-      ["Content-Type"] = "application/json"
+      ["Content-Type"] = "application/json",
+      -- authのレート制限とロックアウトはクライアントのIP単位
+      ["X-Forwarded-For"] = kong.client.get_forwarded_ip()
     }
   })
 
@@ -36,6 +38,11 @@ function TokenTransformerHandler:access(conf)
     return kong.response.exit(500, { message = "Internal Server Error" })
   end
 
+  if res.status == 429 then
+    kong.log.warn("Introspection rate limited")
+    return kong.response.exit(429, { message = "Too many requests" })
+  end
+
   if res.status ~= 200 then
     kong.log.info("Introspection failed with status: ", res.status)
     return kong.response.exit(401, { message = "Invalid token" })
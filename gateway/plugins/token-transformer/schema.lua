@@ -4,5 +4,11 @@ return {
     { consumer = { type = "foreign", reference = "consumers" } },
     { route = { type = "foreign", reference = "routes" } },
     { service = { type = "foreign", reference = "services" } },
+    { config = {
+        type = "record",
+        fields = {
+          { auth_url = { type = "string", default = "http://auth:8000" } },
+        },
+    } },
   },
 }
\ No newline at end of file
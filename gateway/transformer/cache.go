@@ -0,0 +1,78 @@
+package transformer
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// cache is an LRU cache of the internal JWTs by token hash. Entries expire at
+// their own deadline and the least recently used one is evicted when full.
+type cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type cacheEntry struct {
+	key       string
+	jwt       string
+	expiresAt time.Time
+}
+
+func newCache(size int) *cache {
+	return &cache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns the JWT cached for the key, false if none is fresh.
+func (c *cache) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !time.Now().Before(entry.expiresAt) {
+		c.remove(elem)
+		return "", false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.jwt, true
+}
+
+// put caches the JWT until expiresAt.
+func (c *cache) put(key, jwt string, expiresAt time.Time) {
+	if c.size <= 0 || !time.Now().Before(expiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.jwt = jwt
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, jwt: jwt, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove must be called with the lock held.
+func (c *cache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+}
@@ -0,0 +1,71 @@
+package transformer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheGetPut(t *testing.T) {
+	c := newCache(2)
+	if _, ok := c.get("a"); ok {
+		t.Fatal("get on an empty cache hit")
+	}
+
+	c.put("a", "jwt-a", time.Now().Add(time.Minute))
+	jwt, ok := c.get("a")
+	if !ok || jwt != "jwt-a" {
+		t.Fatalf("get = %q, %v, want jwt-a, true", jwt, ok)
+	}
+
+	c.put("a", "jwt-a2", time.Now().Add(time.Minute))
+	if jwt, _ := c.get("a"); jwt != "jwt-a2" {
+		t.Errorf("get after update = %q, want jwt-a2", jwt)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	c := newCache(2)
+	c.put("expired", "jwt", time.Now().Add(-time.Second))
+	if _, ok := c.get("expired"); ok {
+		t.Error("an already expired entry was cached")
+	}
+
+	c.put("short", "jwt", time.Now().Add(10*time.Millisecond))
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.get("short"); ok {
+		t.Error("an expired entry was served")
+	}
+	if len(c.entries) != 0 || c.order.Len() != 0 {
+		t.Errorf("expired entry not removed: %d entries, %d in order", len(c.entries), c.order.Len())
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newCache(2)
+	exp := time.Now().Add(time.Minute)
+	c.put("a", "jwt-a", exp)
+	c.put("b", "jwt-b", exp)
+	// a becomes the most recently used, b the least.
+	c.get("a")
+	c.put("c", "jwt-c", exp)
+
+	if _, ok := c.get("b"); ok {
+		t.Error("the least recently used entry was not evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.get(key); !ok {
+			t.Errorf("%s was evicted", key)
+		}
+	}
+	if len(c.entries) != 2 {
+		t.Errorf("cache holds %d entries, want 2", len(c.entries))
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	c := newCache(0)
+	c.put("a", "jwt-a", time.Now().Add(time.Minute))
+	if _, ok := c.get("a"); ok {
+		t.Error("a disabled cache served an entry")
+	}
+}
@@ -0,0 +1,114 @@
+// Command transformer is a reverse proxy exchanging the opaque tokens of the
+// requests for internal JWTs before passing them to the upstream service.
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"goa.design/clue/log"
+	"object-t.com/hackz-giganoto/gateway/transformer"
+)
+
+func main() {
+	format := log.FormatJSON
+	if log.IsTerminal() {
+		format = log.FormatTerminal
+	}
+	ctx := log.Context(context.Background(), log.WithFormat(format))
+
+	addr := envString("TRANSFORMER_ADDR", ":8080")
+	upstreamURL := envString("UPSTREAM_URL", "http://bff:50000")
+	// gRPC upstreams are served over HTTP/2 without TLS.
+	upstreamH2C := envBool("UPSTREAM_H2C", true)
+
+	upstream, err := url.Parse(upstreamURL)
+	if err != nil {
+		log.Fatalf(ctx, err, "invalid UPSTREAM_URL")
+	}
+
+	t := transformer.New(transformer.Config{
+		AuthURL:   envString("AUTH_URL", "http://auth:8000"),
+		CacheSize: envInt("TOKEN_CACHE_SIZE", 10000),
+		CacheTTL:  envDuration("TOKEN_CACHE_TTL", time.Minute),
+		Timeout:   envDuration("AUTH_TIMEOUT", 5*time.Second),
+	})
+
+	proxy := httputil.NewSingleHostReverseProxy(upstream)
+	// Flush immediately so that streaming RPCs are not buffered.
+	proxy.FlushInterval = -1
+	var protocols http.Protocols
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	if upstreamH2C {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetUnencryptedHTTP2(true)
+		proxy.Transport = transport
+	}
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           log.HTTP(ctx)(t.Handler(proxy)),
+		Protocols:         &protocols,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		log.Printf(ctx, "token transformer listening on %q, forwarding to %s", addr, upstreamURL)
+		errc <- srv.ListenAndServe()
+	}()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-errc:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf(ctx, err, "server failed")
+		}
+	case sig := <-sigc:
+		log.Printf(ctx, "shutting down on %s", sig)
+		shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf(ctx, "failed to shutdown: %v", err)
+		}
+	}
+}
+
+func envString(name, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
+
+func envInt(name string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(name)); err == nil {
+		return v
+	}
+	return def
+}
+
+func envBool(name string, def bool) bool {
+	if v, err := strconv.ParseBool(os.Getenv(name)); err == nil {
+		return v
+	}
+	return def
+}
+
+func envDuration(name string, def time.Duration) time.Duration {
+	if v, err := time.ParseDuration(os.Getenv(name)); err == nil {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,5 @@
+module object-t.com/hackz-giganoto/gateway/transformer
+
+go 1.24.0
+
+require goa.design/clue v1.2.1
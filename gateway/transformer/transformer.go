@@ -0,0 +1,196 @@
+// Package transformer exchanges the opaque tokens of the requests for the
+// internal JWTs of the auth service before they reach the services, like the
+// token-transformer Kong plugin.
+package transformer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"goa.design/clue/log"
+)
+
+// expiryMargin is how long before its expiry a cached JWT is exchanged again,
+// so that it outlives the request.
+const expiryMargin = 30 * time.Second
+
+// Config configures the transformer.
+type Config struct {
+	// AuthURL is the base URL of the auth service.
+	AuthURL string
+	// CacheSize is the maximum number of cached JWTs, 0 disables the cache.
+	CacheSize int
+	// CacheTTL caps how long a JWT is cached. Revoking a token in auth takes
+	// up to CacheTTL to apply to the gateway.
+	CacheTTL time.Duration
+	// Timeout is the timeout of the requests to the auth service.
+	Timeout time.Duration
+}
+
+// Transformer exchanges the opaque tokens for internal JWTs, caching them by
+// token until shortly before they expire.
+type Transformer struct {
+	introspectURL string
+	client        *http.Client
+	cache         *cache
+	cacheTTL      time.Duration
+}
+
+// Errors of the token exchange.
+var (
+	errInvalidToken = errors.New("invalid token")
+	errRateLimited  = errors.New("rate limited")
+)
+
+var bearerPattern = regexp.MustCompile(`^[Bb]earer\s+(.+)$`)
+
+// New returns the transformer configured with cfg.
+func New(cfg Config) *Transformer {
+	return &Transformer{
+		introspectURL: strings.TrimSuffix(cfg.AuthURL, "/") + "/introspect",
+		client:        &http.Client{Timeout: cfg.Timeout},
+		cache:         newCache(cfg.CacheSize),
+		cacheTTL:      cfg.CacheTTL,
+	}
+}
+
+// Handler replaces the opaque bearer token of the requests with the internal
+// JWT before passing them to next. Requests without a valid token are
+// rejected.
+func (t *Transformer) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" {
+			writeError(w, r, http.StatusUnauthorized, "Authorization header missing")
+			return
+		}
+		match := bearerPattern.FindStringSubmatch(authHeader)
+		if match == nil {
+			writeError(w, r, http.StatusUnauthorized, "Invalid Authorization header format")
+			return
+		}
+
+		jwt, err := t.Exchange(r.Context(), match[1], clientIP(r))
+		switch {
+		case errors.Is(err, errInvalidToken):
+			writeError(w, r, http.StatusUnauthorized, "Invalid token")
+			return
+		case errors.Is(err, errRateLimited):
+			log.Print(r.Context(), log.KV{"transformer.handler", "introspection rate limited"})
+			writeError(w, r, http.StatusTooManyRequests, "Too many requests")
+			return
+		case err != nil:
+			log.Print(r.Context(), log.KV{"transformer.handler", "ERROR: token exchange failed"}, log.KV{"error", err.Error()})
+			writeError(w, r, http.StatusInternalServerError, "Internal Server Error")
+			return
+		}
+
+		r.Header.Set("Authorization", "Bearer "+jwt)
+		next.ServeHTTP(w, r)
+	})
+}
+
+type introspectResult struct {
+	JWT string `json:"jwt"`
+	Exp *int64 `json:"exp"`
+}
+
+// Exchange returns the internal JWT of the opaque token. The IP of the client
+// is forwarded since auth rate limits and locks out clients by IP.
+func (t *Transformer) Exchange(ctx context.Context, token, ip string) (string, error) {
+	// Only the hash of the token is kept in memory.
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	if jwt, ok := t.cache.get(key); ok {
+		return jwt, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"token": token})
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal failed: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.introspectURL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to build introspection request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if ip != "" {
+		req.Header.Set("X-Forwarded-For", ip)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("auth server request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return "", errRateLimited
+	case resp.StatusCode >= http.StatusInternalServerError:
+		// An outage of auth does not make the token invalid.
+		return "", fmt.Errorf("auth server responded with status %d", resp.StatusCode)
+	default:
+		return "", errInvalidToken
+	}
+
+	var res introspectResult
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", fmt.Errorf("failed to decode introspection response: %w", err)
+	}
+	if res.JWT == "" {
+		return "", errors.New("introspection response has no JWT")
+	}
+
+	expiresAt := time.Now().Add(t.cacheTTL)
+	if res.Exp != nil {
+		if exp := time.Unix(*res.Exp, 0).Add(-expiryMargin); exp.Before(expiresAt) {
+			expiresAt = exp
+		}
+	}
+	t.cache.put(key, res.JWT, expiresAt)
+
+	return res.JWT, nil
+}
+
+// clientIP returns the IP of the client of the request.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// grpcCodes are the gRPC status codes of the HTTP statuses of the errors.
+var grpcCodes = map[int]string{
+	http.StatusUnauthorized:        "16", // UNAUTHENTICATED
+	http.StatusTooManyRequests:     "8",  // RESOURCE_EXHAUSTED
+	http.StatusInternalServerError: "13", // INTERNAL
+}
+
+// writeError rejects the request, with a gRPC status for gRPC requests.
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Grpc-Status", grpcCodes[status])
+		w.Header().Set("Grpc-Message", message)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
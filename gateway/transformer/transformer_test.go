@@ -0,0 +1,230 @@
+package transformer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// authStandIn is an httptest stand-in of the introspection endpoint of auth.
+type authStandIn struct {
+	*httptest.Server
+	calls  atomic.Int64
+	path   atomic.Value
+	status int
+	exp    time.Time
+}
+
+func newAuthStandIn(t *testing.T, status int, exp time.Time) *authStandIn {
+	a := &authStandIn{status: status, exp: exp}
+	a.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.calls.Add(1)
+		a.path.Store(r.URL.Path)
+
+		var body struct {
+			Token string `json:"token"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if a.status != http.StatusOK {
+			w.WriteHeader(a.status)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jwt": "jwt-of-" + body.Token, "exp": a.exp.Unix()})
+	}))
+	t.Cleanup(a.Close)
+	return a
+}
+
+func newTestTransformer(authURL string) *Transformer {
+	return New(Config{AuthURL: authURL, CacheSize: 10, CacheTTL: time.Hour, Timeout: time.Second})
+}
+
+func cacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestExchangeCachesJWT(t *testing.T) {
+	auth := newAuthStandIn(t, http.StatusOK, time.Now().Add(time.Hour))
+	tr := newTestTransformer(auth.URL)
+
+	for i := range 3 {
+		jwt, err := tr.Exchange(context.Background(), `tok"en`, "")
+		if err != nil {
+			t.Fatalf("exchange %d: %v", i, err)
+		}
+		if jwt != `jwt-of-tok"en` {
+			t.Fatalf("exchange %d = %q, want the JWT of the token", i, jwt)
+		}
+	}
+	if got := auth.calls.Load(); got != 1 {
+		t.Errorf("auth called %d times, want 1 (cache hits)", got)
+	}
+
+	if _, err := tr.Exchange(context.Background(), "other", ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := auth.calls.Load(); got != 2 {
+		t.Errorf("auth called %d times, want 2 (cache miss of another token)", got)
+	}
+}
+
+func TestExchangeTTLCappedAtExpiry(t *testing.T) {
+	exp := time.Now().Add(time.Minute).Truncate(time.Second)
+	auth := newAuthStandIn(t, http.StatusOK, exp)
+	tr := newTestTransformer(auth.URL)
+
+	if _, err := tr.Exchange(context.Background(), "token", ""); err != nil {
+		t.Fatal(err)
+	}
+	elem, ok := tr.cache.entries[cacheKey("token")]
+	if !ok {
+		t.Fatal("JWT not cached")
+	}
+	if got, want := elem.Value.(*cacheEntry).expiresAt, exp.Add(-expiryMargin); !got.Equal(want) {
+		t.Errorf("cached until %v, want %v (exp minus the margin)", got, want)
+	}
+}
+
+func TestExchangeTTLCappedAtCacheTTL(t *testing.T) {
+	auth := newAuthStandIn(t, http.StatusOK, time.Now().Add(time.Hour))
+	tr := New(Config{AuthURL: auth.URL, CacheSize: 10, CacheTTL: time.Minute, Timeout: time.Second})
+
+	before := time.Now()
+	if _, err := tr.Exchange(context.Background(), "token", ""); err != nil {
+		t.Fatal(err)
+	}
+	expiresAt := tr.cache.entries[cacheKey("token")].Value.(*cacheEntry).expiresAt
+	if expiresAt.Before(before.Add(time.Minute)) || expiresAt.After(time.Now().Add(time.Minute)) {
+		t.Errorf("cached until %v, want about a minute from now", expiresAt)
+	}
+}
+
+func TestExchangeNotCachedCloseToExpiry(t *testing.T) {
+	auth := newAuthStandIn(t, http.StatusOK, time.Now().Add(expiryMargin/2))
+	tr := newTestTransformer(auth.URL)
+
+	for range 2 {
+		if _, err := tr.Exchange(context.Background(), "token", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := auth.calls.Load(); got != 2 {
+		t.Errorf("auth called %d times, want 2 (JWT expiring within the margin)", got)
+	}
+}
+
+func TestExchangeAuthErrors(t *testing.T) {
+	cases := []struct {
+		status  int
+		wantErr error
+	}{
+		{http.StatusUnauthorized, errInvalidToken},
+		{http.StatusBadRequest, errInvalidToken},
+		{http.StatusTooManyRequests, errRateLimited},
+		{http.StatusInternalServerError, nil},
+		{http.StatusBadGateway, nil},
+	}
+	for _, c := range cases {
+		t.Run(http.StatusText(c.status), func(t *testing.T) {
+			auth := newAuthStandIn(t, c.status, time.Time{})
+			tr := newTestTransformer(auth.URL)
+
+			_, err := tr.Exchange(context.Background(), "token", "")
+			if err == nil {
+				t.Fatal("exchange succeeded")
+			}
+			if c.wantErr != nil && !errors.Is(err, c.wantErr) {
+				t.Errorf("err = %v, want %v", err, c.wantErr)
+			}
+			if c.wantErr == nil && (errors.Is(err, errInvalidToken) || errors.Is(err, errRateLimited)) {
+				t.Errorf("err = %v, want an internal error", err)
+			}
+			if len(tr.cache.entries) != 0 {
+				t.Error("a failed exchange was cached")
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	cases := []struct {
+		name       string
+		authStatus int
+		header     string
+		grpc       bool
+		wantStatus int
+		wantGRPC   string
+	}{
+		{"valid token", http.StatusOK, "Bearer token", false, http.StatusOK, ""},
+		{"missing header", http.StatusOK, "", false, http.StatusUnauthorized, ""},
+		{"not a bearer token", http.StatusOK, "Basic dXNlcg==", false, http.StatusUnauthorized, ""},
+		{"invalid token", http.StatusUnauthorized, "Bearer token", false, http.StatusUnauthorized, ""},
+		{"rate limited", http.StatusTooManyRequests, "Bearer token", false, http.StatusTooManyRequests, ""},
+		{"auth outage", http.StatusServiceUnavailable, "Bearer token", false, http.StatusInternalServerError, ""},
+		{"grpc invalid token", http.StatusUnauthorized, "Bearer token", true, http.StatusOK, "16"},
+		{"grpc rate limited", http.StatusTooManyRequests, "Bearer token", true, http.StatusOK, "8"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			auth := newAuthStandIn(t, c.authStatus, time.Now().Add(time.Hour))
+			tr := newTestTransformer(auth.URL)
+
+			var forwarded string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				forwarded = r.Header.Get("Authorization")
+			})
+			req := httptest.NewRequest(http.MethodPost, "/chat.Chat/History", nil)
+			if c.header != "" {
+				req.Header.Set("Authorization", c.header)
+			}
+			if c.grpc {
+				req.Header.Set("Content-Type", "application/grpc")
+			}
+			rec := httptest.NewRecorder()
+			tr.Handler(next).ServeHTTP(rec, req)
+
+			if rec.Code != c.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, c.wantStatus)
+			}
+			if got := rec.Header().Get("Grpc-Status"); got != c.wantGRPC {
+				t.Errorf("grpc-status = %q, want %q", got, c.wantGRPC)
+			}
+			if c.wantStatus == http.StatusOK && !c.grpc {
+				if forwarded != "Bearer jwt-of-token" {
+					t.Errorf("forwarded Authorization = %q, want the JWT", forwarded)
+				}
+			} else if forwarded != "" {
+				t.Error("a rejected request reached the upstream")
+			}
+		})
+	}
+}
+
+func TestConfigurableAuthURL(t *testing.T) {
+	auth := newAuthStandIn(t, http.StatusOK, time.Now().Add(time.Hour))
+	for _, authURL := range []string{auth.URL + "/auth", auth.URL + "/auth/"} {
+		tr := newTestTransformer(authURL)
+		if _, err := tr.Exchange(context.Background(), "token", ""); err != nil {
+			t.Fatalf("%s: %v", authURL, err)
+		}
+		if got := auth.path.Load(); got != "/auth/introspect" {
+			t.Errorf("%s: introspected at %v, want /auth/introspect", authURL, got)
+		}
+	}
+
+	tr := newTestTransformer("http://127.0.0.1:1")
+	if _, err := tr.Exchange(context.Background(), "token", ""); err == nil || errors.Is(err, errInvalidToken) {
+		t.Errorf("unreachable auth: err = %v, want an internal error", err)
+	}
+}
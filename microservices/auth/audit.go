@@ -0,0 +1,86 @@
+package authapi
+
+import (
+	"context"
+	"time"
+
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// Types of the audit events.
+const (
+	auditStateCreated        = "state_created"
+	auditLoginSucceeded      = "login_succeeded"
+	auditLoginFailed         = "login_failed"
+	auditIntrospectionFailed = "introspection_failed"
+	auditTokenRevoked        = "token_revoked"
+	auditRoleGranted         = "role_granted"
+	auditRoleRevoked         = "role_revoked"
+	auditRateLimited         = "rate_limited"
+	auditLockedOut           = "locked_out"
+	auditIdentityLinked      = "identity_linked"
+	auditIdentityUnlinked    = "identity_unlinked"
+	auditMemberAdded         = "workspace_member_added"
+	auditMemberRemoved       = "workspace_member_removed"
+)
+
+// audit records the event in the audit log with the IP of the request and
+// the user of its token as actor. Failures are logged only, so that the audit
+// log does not take the auth service down.
+func (s *authsrvc) audit(ctx context.Context, entry *AuditEntry) {
+	entry.IP = contextClientInfo(ctx).IP
+	entry.CreatedAt = time.Now()
+	if token, ok := contextToken(ctx); ok && token.Entry.UserID != entry.UserID {
+		entry.ActorID = token.Entry.UserID
+	}
+
+	if err := s.store.AppendAudit(ctx, entry); err != nil {
+		log.Print(ctx, log.KV{"auth.audit", "ERROR: failed to append audit entry"}, log.KV{"type", entry.Type}, log.KV{"error", err.Error()})
+	}
+}
+
+// List the audit events, newest first
+func (s *authsrvc) ListAuditEvents(ctx context.Context, p *auth.ListAuditEventsPayload) (res []*auth.AuditEvent, err error) {
+	filter := AuditFilter{Limit: p.Limit}
+	if p.UserID != nil {
+		filter.UserID = *p.UserID
+	}
+	if p.Since != nil {
+		filter.Since = time.Unix(*p.Since, 0)
+	}
+	if p.Until != nil {
+		// Include the whole last second.
+		filter.Until = time.Unix(*p.Until+1, 0).Add(-time.Nanosecond)
+	}
+
+	entries, err := s.store.AuditEntries(ctx, filter)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.list_audit_events", "ERROR: failed to load audit entries"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	res = make([]*auth.AuditEvent, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, &auth.AuditEvent{
+			ID:        entry.ID,
+			Type:      entry.Type,
+			UserID:    optional(entry.UserID),
+			ActorID:   optional(entry.ActorID),
+			IP:        optional(entry.IP),
+			Provider:  optional(entry.Provider),
+			Reason:    optional(entry.Reason),
+			CreatedAt: entry.CreatedAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
+
+// optional returns nil for the empty string.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -4,73 +4,99 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"goa.design/clue/log"
-	"io"
-	"net/http"
-	"os"
-	"sync"
+	"sort"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"goa.design/goa/v3/security"
 	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/github"
 	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+	profilepb "object-t.com/hackz-giganoto/microservices/profile/gen/grpc/profile/pb"
 )
 
-// GitHub user profile response
-type GitHubUser struct {
-	ID    int64  `json:"id"`
-	Login string `json:"login"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
-}
+const (
+	stateTTL        = 10 * time.Minute
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
 
-// OAuth state entry
-type stateEntry struct {
-	CreatedAt time.Time
-	ExpiresAt time.Time
+// auth service implementation with OAuth identity providers
+type authsrvc struct {
+	providers map[string]Provider
+	clients   map[string]*ServiceClient
+	keys      *keyring
+	store     Store
+	profiles  profilepb.ProfileClient
+	limits    limits
+	jwts      *jwtCache
 }
 
-// Token storage entry
-type tokenEntry struct {
-	UserID    string
-	Login     string
-	CreatedAt time.Time
-	ExpiresAt time.Time
-}
+// NewAuth returns the auth service implementation keeping states and tokens
+// in store. The JWT signing keys are rotated until ctx is done, sealed with
+// JWT_KEY_ENCRYPTION_KEY which must be set.
+func NewAuth(ctx context.Context, store Store) (auth.Service, error) {
+	kek, err := loadKeyEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	keys := newKeyring(store, kek)
+	if err := keys.refresh(ctx); err != nil {
+		log.Print(ctx, log.KV{"auth.new_auth", "ERROR: failed to load signing keys"}, log.KV{"error", err.Error()})
+	}
+	go keys.run(ctx)
 
-// auth service implementation with GitHub OAuth
-type authsrvc struct {
-	oauthConfig *oauth2.Config
-	jwtSecret   []byte
-	states      map[string]stateEntry
-	tokens      map[string]tokenEntry
-	statesMutex sync.RWMutex
-	tokensMutex sync.RWMutex
+	providers := newProviders()
+	if len(providers) == 0 {
+		log.Print(ctx, log.KV{"auth.new_auth", "ERROR: no identity provider configured"})
+	}
+	if DevLoginEnabled() {
+		log.Print(ctx, log.KV{"auth.new_auth", "WARNING: development login enabled, anyone can log in as any user"})
+	}
+
+	profiles, err := newProfileClient()
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.new_auth", "ERROR: profiles will not be registered"}, log.KV{"error", err.Error()})
+	}
+
+	s := &authsrvc{
+		providers: providers,
+		clients:   loadServiceClients(ctx),
+		keys:      keys,
+		store:     store,
+		profiles:  profiles,
+		limits:    loadLimits(),
+		jwts:      newJWTCache(),
+	}
+	go s.watchRevocations(ctx)
+
+	return s, nil
 }
 
-// NewAuth returns the auth service implementation.
-func NewAuth() auth.Service {
-	jwtSecret := []byte(os.Getenv("JWT_SECRET"))
-	if len(jwtSecret) == 0 {
-		jwtSecret = []byte("secret") // Default for development
+// OAuth2Auth implements the authorization logic for service "auth" for the
+// "oauth2" security scheme.
+func (s *authsrvc) OAuth2Auth(ctx context.Context, token string, scheme *security.OAuth2Scheme) (context.Context, error) {
+	tokenEntry, err := s.store.GetToken(ctx, token)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.oauth2_auth", "ERROR: failed to load token"}, log.KV{"error", err.Error()})
+		return ctx, auth.InternalError("Internal server error")
+	}
+	if tokenEntry == nil || time.Now().After(tokenEntry.ExpiresAt) {
+		return ctx, auth.Unauthorized("Token is invalid or expired")
 	}
 
-	return &authsrvc{
-		oauthConfig: &oauth2.Config{
-			ClientID:     os.Getenv("GITHUB_CLIENT_ID"),
-			ClientSecret: os.Getenv("GITHUB_CLIENT_SECRET"),
-			RedirectURL:  os.Getenv("GITHUB_REDIRECT_URL"),
-			Scopes:       []string{"read:user", "user:email"},
-			Endpoint:     github.Endpoint,
-		},
-		jwtSecret: jwtSecret,
-		states:    make(map[string]stateEntry),
-		tokens:    make(map[string]tokenEntry),
+	tokenEntry.Scopes, _, err = s.heldScopes(ctx, tokenEntry.UserID, tokenEntry.Scopes)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.oauth2_auth", "ERROR: failed to load roles"}, log.KV{"error", err.Error()})
+		return ctx, auth.InternalError("Internal server error")
+	}
+	if err := scheme.Validate(tokenEntry.Scopes); err != nil {
+		return ctx, auth.Forbidden(err.Error())
 	}
+
+	return contextWithToken(ctx, authenticatedToken{Token: token, Entry: tokenEntry}), nil
 }
 
 // Generate random state for OAuth CSRF protection
@@ -80,176 +106,571 @@ func (s *authsrvc) generateState() string {
 	return hex.EncodeToString(bytes)
 }
 
-// Clean expired states and tokens
-func (s *authsrvc) cleanExpired() {
-	now := time.Now()
-
-	s.statesMutex.Lock()
-	for state, entry := range s.states {
-		if now.After(entry.ExpiresAt) {
-			delete(s.states, state)
-		}
-	}
-	s.statesMutex.Unlock()
-
-	s.tokensMutex.Lock()
-	for token, entry := range s.tokens {
-		if now.After(entry.ExpiresAt) {
-			delete(s.tokens, token)
-		}
+// provider returns the configured identity provider with the given name.
+func (s *authsrvc) provider(name string) (Provider, error) {
+	provider, ok := s.providers[name]
+	if !ok {
+		return nil, auth.UnknownProvider(fmt.Sprintf("Unknown identity provider %q", name))
 	}
-	s.tokensMutex.Unlock()
+	return provider, nil
 }
 
-// Get GitHub OAuth authorization URL with state parameter
-func (s *authsrvc) AuthURL(ctx context.Context) (res *auth.AuthURLResult, err error) {
-	s.cleanExpired()
+// Get the OAuth authorization URL of an identity provider with state parameter
+func (s *authsrvc) AuthURL(ctx context.Context, p *auth.AuthURLPayload) (res *auth.AuthURLResult, err error) {
+	if err := s.checkLockout(ctx, "auth_url"); err != nil {
+		return nil, err
+	}
+	if err := s.rateLimit(ctx, "auth_url", lockoutSubject(ctx), s.limits.loginPerIP); err != nil {
+		return nil, err
+	}
 
-	state := s.generateState()
+	provider, err := s.provider(p.Provider)
+	if err != nil {
+		return nil, err
+	}
 
-	// Store state with 10 minute expiration
-	s.statesMutex.Lock()
-	s.states[state] = stateEntry{
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(10 * time.Minute),
+	stateEntry := &StateEntry{}
+	if p.CodeChallenge != nil {
+		// Bind the state to the client holding the code verifier
+		stateEntry.CodeChallenge = *p.CodeChallenge
 	}
-	s.statesMutex.Unlock()
 
-	authURL := s.oauthConfig.AuthCodeURL(state, oauth2.AccessTypeOffline)
+	state, authURL, err := s.startLogin(ctx, provider, stateEntry)
+	if err != nil {
+		return nil, err
+	}
 
 	res = &auth.AuthURLResult{
 		AuthURL: authURL,
 		State:   state,
 	}
 
-	log.Info(ctx, log.KV{"auth.auth_url", fmt.Sprintf("generated state:  %s", state)})
+	log.Info(ctx, log.KV{"auth.auth_url", fmt.Sprintf("generated %s state:  %s", provider.Name(), state)})
 	return
 }
 
-// Fetch GitHub user profile
-func (s *authsrvc) fetchGitHubUser(ctx context.Context, token *oauth2.Token) (*GitHubUser, error) {
-	client := s.oauthConfig.Client(ctx, token)
-	resp, err := client.Get("https://api.github.com/user")
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user info: %w", err)
-	}
-	defer resp.Body.Close()
+// startLogin stores a new state for the login with the provider and returns
+// it with the URL of the login page of the provider.
+func (s *authsrvc) startLogin(ctx context.Context, provider Provider, stateEntry *StateEntry) (state, authURL string, err error) {
+	state = s.generateState()
+	stateEntry.Provider = provider.Name()
+	stateEntry.ProviderVerifier = oauth2.GenerateVerifier()
+	stateEntry.CreatedAt = time.Now()
 
-	body, err := io.ReadAll(resp.Body)
+	authURL, err = provider.AuthCodeURL(ctx, state, oauth2.AccessTypeOffline, oauth2.S256ChallengeOption(stateEntry.ProviderVerifier))
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		log.Print(ctx, log.KV{"auth.start_login", "ERROR: failed to build authorization URL"}, log.KV{"error", err.Error()})
+		return "", "", auth.ProviderError("Identity provider is unavailable")
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error: %s", string(body))
+	// Store state with 10 minute expiration
+	if err := s.store.SaveState(ctx, state, stateEntry, stateTTL); err != nil {
+		log.Print(ctx, log.KV{"auth.start_login", "ERROR: failed to store state"}, log.KV{"error", err.Error()})
+		return "", "", auth.InternalError("Failed to generate state")
 	}
 
-	var user GitHubUser
-	if err := json.Unmarshal(body, &user); err != nil {
-		return nil, fmt.Errorf("failed to parse user info: %w", err)
+	entry := &AuditEntry{Type: auditStateCreated, Provider: provider.Name()}
+	if stateEntry.DeviceCode != "" {
+		entry.Reason = "device_authorization"
 	}
+	s.audit(ctx, entry)
 
-	return &user, nil
+	return state, authURL, nil
 }
 
-// Handle GitHub OAuth callback and return opaque token
-func (s *authsrvc) OauthCallback(ctx context.Context, p *auth.OauthCallbackPayload) (res *auth.OauthCallbackResult, err error) {
-	s.cleanExpired()
+// Handle the OAuth callback of an identity provider and return opaque token
+func (s *authsrvc) OauthCallback(ctx context.Context, p *auth.OauthCallbackPayload) (res *auth.TokenResult, err error) {
+	if err := s.checkLockout(ctx, "oauth_callback"); err != nil {
+		return nil, err
+	}
+	if err := s.rateLimit(ctx, "oauth_callback", lockoutSubject(ctx), s.limits.loginPerIP); err != nil {
+		return nil, err
+	}
 
-	// Validate state
-	s.statesMutex.RLock()
-	stateEntry, exists := s.states[p.State]
-	s.statesMutex.RUnlock()
+	provider, err := s.provider(p.Provider)
+	if err != nil {
+		return nil, err
+	}
 
-	if !exists || time.Now().After(stateEntry.ExpiresAt) {
+	// Validate and remove used state
+	stateEntry, err := s.store.ConsumeState(ctx, p.State)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to load state"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if stateEntry == nil || stateEntry.Provider != provider.Name() {
 		log.Print(ctx, log.KV{"auth.oauth_callback", fmt.Sprintf("ERROR: invalid state: %s", p.State)})
+		s.audit(ctx, &AuditEntry{Type: auditLoginFailed, Provider: provider.Name(), Reason: "invalid_state"})
+		s.recordFailure(ctx, "oauth_callback", "invalid_state")
 		return nil, auth.InvalidState("Invalid or expired state parameter")
 	}
 
-	// Remove used state
-	s.statesMutex.Lock()
-	delete(s.states, p.State)
-	s.statesMutex.Unlock()
+	// Check the PKCE code verifier if the login was started with a challenge.
+	// Links always are, since they issue tokens of the user who started them.
+	if stateEntry.CodeChallenge != "" || stateEntry.LinkUserID != "" {
+		if p.CodeVerifier == nil || !verifyCodeChallenge(stateEntry.CodeChallenge, *p.CodeVerifier) {
+			log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: PKCE code verifier mismatch"})
+			s.audit(ctx, &AuditEntry{Type: auditLoginFailed, Provider: provider.Name(), Reason: "invalid_verifier"})
+			return nil, auth.InvalidVerifier("Invalid code verifier")
+		}
+	}
 
 	// Exchange code for token
-	token, err := s.oauthConfig.Exchange(ctx, p.Code)
+	token, err := provider.Exchange(ctx, p.Code, oauth2.VerifierOption(stateEntry.ProviderVerifier))
 	if err != nil {
 		log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to exchange code"}, log.KV{"error", err.Error()})
+		s.audit(ctx, &AuditEntry{Type: auditLoginFailed, Provider: provider.Name(), Reason: "invalid_code"})
 		return nil, auth.InvalidCode("Invalid authorization code")
 	}
 
-	// Fetch GitHub user profile
-	githubUser, err := s.fetchGitHubUser(ctx, token)
+	// Fetch the user from the identity provider
+	identity, err := provider.Identify(ctx, token)
 	if err != nil {
-		log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to fetch GitHub user"}, log.KV{"error", err.Error()})
-		return nil, auth.GithubError("Failed to fetch GitHub user profile")
+		log.Print(ctx, log.KV{"auth.oauth_callback", fmt.Sprintf("ERROR: failed to fetch %s user", provider.Name())}, log.KV{"error", err.Error()})
+		s.audit(ctx, &AuditEntry{Type: auditLoginFailed, Provider: provider.Name(), Reason: "provider_error"})
+		return nil, auth.ProviderError("Failed to fetch user profile from identity provider")
 	}
 
-	// Generate opaque token
-	opaqueToken := uuid.New().String()
+	uid := stateEntry.LinkUserID
+	if uid != "" {
+		if err := s.linkIdentity(ctx, provider, identity, uid); err != nil {
+			if err == errIdentityConflict {
+				log.Print(ctx, log.KV{"auth.oauth_callback", fmt.Sprintf("ERROR: %s identity %s cannot be linked to user %s", provider.Name(), identity.Login, uid)})
+				return nil, auth.IdentityConflict("The identity is linked to another user, or the user already linked one of the provider")
+			}
+			log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to link identity"}, log.KV{"error", err.Error()})
+			return nil, auth.InternalError("Internal server error")
+		}
+	} else if uid, err = s.resolveUser(ctx, provider, identity); err != nil {
+		log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to resolve user"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	if err := s.bootstrapAdmin(ctx, provider, identity, uid); err != nil {
+		log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to bootstrap admin"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	// A profile service outage must not prevent logins, the registration is
+	// retried on the next login.
+	if err := s.registerProfile(ctx, uid, "", identity); err != nil {
+		log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to register profile"}, log.KV{"user_id", uid}, log.KV{"error", err.Error()})
+	}
 
-	// Store token with 1 hour expiration
-	s.tokensMutex.Lock()
-	s.tokens[opaqueToken] = tokenEntry{
-		UserID:    fmt.Sprintf("%d", githubUser.ID),
-		Login:     githubUser.Login,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(10 * time.Hour),
+	if stateEntry.DeviceCode != "" {
+		if err := s.approveDevice(ctx, stateEntry.DeviceCode, uid, identity.Login); err != nil {
+			return nil, err
+		}
 	}
-	s.tokensMutex.Unlock()
 
-	res = &auth.OauthCallbackResult{
-		AccessToken: opaqueToken,
-		TokenType:   "Bearer",
-		ExpiresIn:   36000,
-		UserID:      fmt.Sprintf("%d", githubUser.ID),
+	// Generate opaque tokens starting a new token family
+	res, err = s.issueTokens(ctx, uid, identity.Login, uuid.New().String(), "")
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.oauth_callback", "ERROR: failed to store token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
 	}
 
-	log.Info(ctx, log.KV{"auth.oauth_callback", fmt.Sprintf("generated token for user %s (ID: %d)", githubUser.Login, githubUser.ID)})
+	s.audit(ctx, &AuditEntry{Type: auditLoginSucceeded, UserID: uid, Provider: provider.Name()})
+	log.Info(ctx, log.KV{"auth.oauth_callback", fmt.Sprintf("generated token for user %s (ID: %s)", identity.Login, uid)})
 	return
 }
 
+// Exchange a refresh token for a new access token and refresh token
+func (s *authsrvc) Refresh(ctx context.Context, p *auth.RefreshPayload) (res *auth.TokenResult, err error) {
+	refreshEntry, reused, err := s.store.UseRefreshToken(ctx, p.RefreshToken)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.refresh", "ERROR: failed to load refresh token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if refreshEntry == nil {
+		log.Print(ctx, log.KV{"auth.refresh", "ERROR: invalid refresh token"})
+		return nil, auth.InvalidToken("Refresh token is invalid or expired")
+	}
+
+	if reused {
+		// A rotated refresh token was replayed, it may have been stolen so
+		// every token of the family is revoked.
+		log.Print(ctx, log.KV{"auth.refresh", fmt.Sprintf("ERROR: refresh token reused, revoking family %s", refreshEntry.FamilyID)})
+		if err := s.revokeFamily(ctx, refreshEntry.FamilyID); err != nil {
+			log.Print(ctx, log.KV{"auth.refresh", "ERROR: failed to revoke token family"}, log.KV{"error", err.Error()})
+			return nil, auth.InternalError("Internal server error")
+		}
+		s.audit(ctx, &AuditEntry{Type: auditTokenRevoked, UserID: refreshEntry.UserID, Reason: "refresh_token_reused"})
+		return nil, auth.InvalidToken("Refresh token was already used")
+	}
+
+	res, err = s.issueTokens(ctx, refreshEntry.UserID, refreshEntry.Login, refreshEntry.FamilyID, refreshEntry.WorkspaceID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.refresh", "ERROR: failed to store token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	log.Info(ctx, log.KV{"auth.refresh", fmt.Sprintf("rotated tokens for user %s", refreshEntry.Login)})
+	return
+}
+
+// issueTokens stores a new access token and refresh token in the family,
+// acting in the workspace.
+func (s *authsrvc) issueTokens(ctx context.Context, userID, login, familyID, workspaceID string) (*auth.TokenResult, error) {
+	now := time.Now()
+	accessToken := uuid.New().String()
+	refreshToken := uuid.New().String()
+
+	// Scopes are derived from the current roles at every issuance, so role
+	// changes apply on the next refresh.
+	scopes, err := s.scopes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.SaveToken(ctx, accessToken, &TokenEntry{
+		UserID:       userID,
+		Login:        login,
+		FamilyID:     familyID,
+		WorkspaceID:  workspaceID,
+		RefreshToken: refreshToken,
+		Scopes:       scopes,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(accessTokenTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.SaveRefreshToken(ctx, refreshToken, &RefreshEntry{
+		UserID:      userID,
+		Login:       login,
+		FamilyID:    familyID,
+		WorkspaceID: workspaceID,
+		Scopes:      scopes,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(refreshTokenTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.saveSession(ctx, userID, login, familyID, now); err != nil {
+		return nil, err
+	}
+
+	return &auth.TokenResult{
+		AccessToken:      accessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(accessTokenTTL.Seconds()),
+		UserID:           userID,
+		RefreshToken:     refreshToken,
+		RefreshExpiresIn: int64(refreshTokenTTL.Seconds()),
+		WorkspaceID:      optional(workspaceID),
+	}, nil
+}
+
+// saveSession records the use of the session of the token family, starting
+// it with the device of the request on login.
+func (s *authsrvc) saveSession(ctx context.Context, userID, login, familyID string, now time.Time) error {
+	session, err := s.store.GetSession(ctx, familyID)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		info := contextClientInfo(ctx)
+		session = &SessionEntry{
+			ID:        familyID,
+			UserID:    userID,
+			Login:     login,
+			UserAgent: info.UserAgent,
+			IP:        info.IP,
+			CreatedAt: now,
+		}
+	}
+	session.LastUsedAt = now
+	session.ExpiresAt = now.Add(refreshTokenTTL)
+
+	return s.store.SaveSession(ctx, session)
+}
+
 // Introspect opaque token and return internal JWT token for Kong Gateway
 func (s *authsrvc) Introspect(ctx context.Context, p *auth.IntrospectPayload) (res *auth.IntrospectResult, err error) {
-	s.cleanExpired()
+	if err := s.checkLockout(ctx, "introspect"); err != nil {
+		return nil, err
+	}
+	if err := s.rateLimit(ctx, "introspect", lockoutSubject(ctx), s.limits.introspectPerIP); err != nil {
+		return nil, err
+	}
+	if err := s.rateLimit(ctx, "introspect", tokenSubject(p.Token), s.limits.introspectPerToken); err != nil {
+		return nil, err
+	}
+
+	// The JWT minted for the token is served until shortly before it
+	// expires, revocations evict it.
+	tokenHash := hashPersonalToken(p.Token)
+	if res := s.jwts.get(tokenHash); res != nil {
+		return res, nil
+	}
 
 	// Validate opaque token
-	s.tokensMutex.RLock()
-	tokenEntry, exists := s.tokens[p.Token]
-	s.tokensMutex.RUnlock()
+	tokenEntry, err := s.store.GetToken(ctx, p.Token)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to load token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if tokenEntry == nil || time.Now().After(tokenEntry.ExpiresAt) {
+		// Not a login token, it may be a personal access token or API key
+		return s.introspectPersonalToken(ctx, p.Token, tokenHash)
+	}
+
+	// Removing a member evicts its JWTs, so the membership is checked on
+	// every minting.
+	member, err := s.workspaceMember(ctx, tokenEntry.WorkspaceID, tokenEntry.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to load workspace membership"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if !member {
+		log.Print(ctx, log.KV{"auth.introspect", fmt.Sprintf("ERROR: user %s left workspace %s", tokenEntry.Login, tokenEntry.WorkspaceID)})
+		return nil, auth.InvalidToken("Token is invalid or expired")
+	}
+
+	if err := s.store.TouchSession(ctx, tokenEntry.FamilyID, time.Now()); err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to update session"}, log.KV{"error", err.Error()})
+	}
+
+	scopes, ownerID, err := s.heldScopes(ctx, tokenEntry.UserID, tokenEntry.Scopes)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to load roles"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	res, err = s.mintJWT(ctx, tokenEntry.UserID, tokenEntry.Login, tokenEntry.WorkspaceID, scopes, tokenEntry.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	s.jwts.put(tokenHash, tokenEntry.UserID, tokenEntry.FamilyID, ownerID, res, tokenEntry.ExpiresAt)
+
+	log.Info(ctx, log.KV{"auth.introspect", fmt.Sprintf("validated token for user %s", tokenEntry.Login)})
+	return
+}
 
-	if !exists || time.Now().After(tokenEntry.ExpiresAt) {
+// introspectPersonalToken mints the internal JWT of a personal access token
+// or bot API key with exactly the scopes granted to it.
+func (s *authsrvc) introspectPersonalToken(ctx context.Context, token, tokenHash string) (*auth.IntrospectResult, error) {
+	entry, err := s.personalToken(ctx, token)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to load personal access token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if entry == nil {
 		log.Print(ctx, log.KV{"auth.introspect", "ERROR: invalid token"})
+		s.audit(ctx, &AuditEntry{Type: auditIntrospectionFailed, Reason: "invalid_token"})
+		s.recordFailure(ctx, "introspect", "invalid_token")
 		return nil, auth.InvalidToken("Token is invalid or expired")
 	}
 
+	// Like login tokens, the token stops working when its user leaves the
+	// workspace it was created in.
+	member, err := s.workspaceMember(ctx, entry.WorkspaceID, entry.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to load workspace membership"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if !member {
+		log.Print(ctx, log.KV{"auth.introspect", fmt.Sprintf("ERROR: user %s left workspace %s", entry.Login, entry.WorkspaceID)})
+		return nil, auth.InvalidToken("Token is invalid or expired")
+	}
+
+	now := time.Now()
+	if err := s.store.TouchPersonalToken(ctx, entry.ID, now); err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to update personal access token"}, log.KV{"error", err.Error()})
+	}
+
+	// The JWT is short lived even if the token is not.
+	expiresAt := now.Add(accessTokenTTL)
+	if entry.ExpiresAt != nil && entry.ExpiresAt.Before(expiresAt) {
+		expiresAt = *entry.ExpiresAt
+	}
+
+	scopes, ownerID, err := s.heldScopes(ctx, entry.UserID, entry.Scopes)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to load roles"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	res, err := s.mintJWT(ctx, entry.UserID, entry.Login, entry.WorkspaceID, scopes, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	s.jwts.put(tokenHash, entry.UserID, "", ownerID, res, expiresAt)
+
+	log.Info(ctx, log.KV{"auth.introspect", fmt.Sprintf("validated personal access token %s of user %s", entry.ID, entry.Login)})
+	return res, nil
+}
+
+// mintJWT signs the internal JWT of the user, with the workspace_id claim
+// unless it acts in the default workspace.
+func (s *authsrvc) mintJWT(ctx context.Context, userID, login, workspaceID string, scopes []string, expiresAt time.Time) (*auth.IntrospectResult, error) {
 	// Create JWT claims
 	claims := jwt.MapClaims{
-		"sub":    tokenEntry.UserID,
-		"login":  tokenEntry.Login,
-		"scopes": []string{"api:read", "api:write"},
+		"sub":    userID,
+		"login":  login,
+		"scopes": scopes,
 		"iat":    time.Now().Unix(),
-		"exp":    tokenEntry.ExpiresAt.Unix(),
+		"exp":    expiresAt.Unix(),
+	}
+	if workspaceID != "" {
+		claims["workspace_id"] = workspaceID
 	}
 
 	log.Print(ctx, log.KV{"auth.introspect", "DEBUG: creating JWT for token validation"})
 
-	// Create and sign JWT
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	jwtString, err := jwtToken.SignedString(s.jwtSecret)
+	// Sign JWT with the current RS256 key
+	jwtString, err := s.keys.sign(ctx, claims)
 	if err != nil {
 		log.Print(ctx, log.KV{"auth.introspect", "ERROR: failed to sign JWT"}, log.KV{"error", err.Error()})
 		return nil, auth.InternalError("Failed to generate internal token")
 	}
 
-	exp := tokenEntry.ExpiresAt.Unix()
-	res = &auth.IntrospectResult{
+	exp := expiresAt.Unix()
+	return &auth.IntrospectResult{
 		JWT:    jwtString,
 		Active: true,
 		Exp:    &exp,
-		Scopes: []string{"api:read", "api:write"},
+		Scopes: scopes,
+	}, nil
+}
+
+// Return the public keys verifying the internal JWTs
+func (s *authsrvc) Jwks(ctx context.Context) (res *auth.JwksResult, err error) {
+	return &auth.JwksResult{Keys: s.keys.jwks()}, nil
+}
+
+// Revoke the access token of the request and its refresh token
+func (s *authsrvc) Logout(ctx context.Context, p *auth.LogoutPayload) (err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return auth.Unauthorized("Token is invalid or expired")
 	}
 
-	log.Info(ctx, log.KV{"auth.introspect", fmt.Sprintf("validated token for user %s", tokenEntry.Login)})
-	return
+	if err := s.revokeFamily(ctx, token.Entry.FamilyID); err != nil {
+		log.Print(ctx, log.KV{"auth.logout", "ERROR: failed to revoke token family"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditTokenRevoked, UserID: token.Entry.UserID, Reason: "logout"})
+	log.Info(ctx, log.KV{"auth.logout", fmt.Sprintf("logged out user %s", token.Entry.Login)})
+	return nil
+}
+
+// Revoke an access token or a refresh token (RFC 7009)
+func (s *authsrvc) Revoke(ctx context.Context, p *auth.RevokePayload) (err error) {
+	// The hint only decides which kind of token is looked up first.
+	revokers := []func(context.Context, string) (string, error){s.revokeAccessToken, s.revokeRefreshToken, s.revokePersonalToken}
+	if p.TokenTypeHint != nil && *p.TokenTypeHint == "refresh_token" {
+		revokers[0], revokers[1] = revokers[1], revokers[0]
+	}
+
+	for _, revoke := range revokers {
+		userID, err := revoke(ctx, p.Token)
+		if err != nil {
+			log.Print(ctx, log.KV{"auth.revoke", "ERROR: failed to revoke token"}, log.KV{"error", err.Error()})
+			return auth.InternalError("Internal server error")
+		}
+		if userID != "" {
+			s.audit(ctx, &AuditEntry{Type: auditTokenRevoked, UserID: userID, Reason: "revoke"})
+			return nil
+		}
+	}
+
+	// Unknown tokens are not an error as per RFC 7009.
+	return nil
+}
+
+// revokeAccessToken removes the access token and returns its user, empty if
+// the token does not exist.
+func (s *authsrvc) revokeAccessToken(ctx context.Context, token string) (string, error) {
+	tokenEntry, err := s.store.GetToken(ctx, token)
+	if err != nil || tokenEntry == nil {
+		return "", err
+	}
+	return tokenEntry.UserID, s.deleteToken(ctx, token)
+}
+
+// revokeRefreshToken removes the refresh token along with every token issued
+// from it and returns its user, empty if the token does not exist.
+func (s *authsrvc) revokeRefreshToken(ctx context.Context, token string) (string, error) {
+	refreshEntry, err := s.store.GetRefreshToken(ctx, token)
+	if err != nil || refreshEntry == nil {
+		return "", err
+	}
+	return refreshEntry.UserID, s.revokeFamily(ctx, refreshEntry.FamilyID)
+}
+
+// Revoke every token of a user
+func (s *authsrvc) RevokeUserSessions(ctx context.Context, p *auth.RevokeUserSessionsPayload) (err error) {
+	if err := s.revokeUser(ctx, p.UserID); err != nil {
+		log.Print(ctx, log.KV{"auth.revoke_user_sessions", "ERROR: failed to revoke user tokens"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditTokenRevoked, UserID: p.UserID, Reason: "revoke_user_sessions"})
+	if token, ok := contextToken(ctx); ok {
+		log.Info(ctx, log.KV{"auth.revoke_user_sessions", fmt.Sprintf("user %s revoked the sessions of user %s", token.Entry.Login, p.UserID)})
+	}
+	return nil
+}
+
+// List the active sessions of the current user
+func (s *authsrvc) ListSessions(ctx context.Context, p *auth.ListSessionsPayload) (res []*auth.Session, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	sessions, err := s.store.UserSessions(ctx, token.Entry.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.list_sessions", "ERROR: failed to load sessions"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+
+	res = make([]*auth.Session, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, &auth.Session{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IP:         session.IP,
+			CreatedAt:  session.CreatedAt.Unix(),
+			LastUsedAt: session.LastUsedAt.Unix(),
+			Current:    session.ID == token.Entry.FamilyID,
+		})
+	}
+
+	return res, nil
+}
+
+// Terminate a session of the current user
+func (s *authsrvc) DeleteSession(ctx context.Context, p *auth.DeleteSessionPayload) (err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return auth.Unauthorized("Token is invalid or expired")
+	}
+
+	session, err := s.store.GetSession(ctx, p.ID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.delete_session", "ERROR: failed to load session"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	if session == nil || session.UserID != token.Entry.UserID {
+		return auth.NotFound("Session not found")
+	}
+
+	if err := s.revokeFamily(ctx, session.ID); err != nil {
+		log.Print(ctx, log.KV{"auth.delete_session", "ERROR: failed to revoke token family"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditTokenRevoked, UserID: session.UserID, Reason: "session_terminated"})
+	log.Info(ctx, log.KV{"auth.delete_session", fmt.Sprintf("user %s terminated session %s", token.Entry.Login, session.ID)})
+	return nil
 }
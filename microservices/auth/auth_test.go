@@ -0,0 +1,127 @@
+package authapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// newTestService returns the auth service backed by the memory store, without
+// identity providers or profile service.
+func newTestService(t *testing.T) *authsrvc {
+	t.Helper()
+	store := NewMemoryStore()
+	keys := newKeyring(store, testKEK(t, 1))
+	if err := keys.refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return &authsrvc{
+		providers: map[string]Provider{"dev": &devProvider{}, "other": &namedProvider{name: "other"}},
+		clients:   map[string]*ServiceClient{},
+		keys:      keys,
+		store:     store,
+		jwts:      newJWTCache(),
+	}
+}
+
+// testKEK returns a key encryption key derived from the seed.
+func testKEK(t *testing.T, seed byte) cipher.AEAD {
+	t.Helper()
+	kek, err := newKeyEncryptionKey(bytes.Repeat([]byte{seed}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return kek
+}
+
+// devCallback completes the login started with state as the identity at the
+// development provider.
+func devCallback(ctx context.Context, s *authsrvc, state string, identity *Identity, verifier string) (*auth.TokenResult, error) {
+	return providerCallback(ctx, s, "dev", state, identity, verifier)
+}
+
+// namedProvider is the development provider under another name.
+type namedProvider struct {
+	devProvider
+	name string
+}
+
+func (p *namedProvider) Name() string {
+	return p.name
+}
+
+// providerCallback completes the login started with state as the identity at
+// the development provider of the name.
+func providerCallback(ctx context.Context, s *authsrvc, provider, state string, identity *Identity, verifier string) (*auth.TokenResult, error) {
+	code, err := encodeDevCode(identity)
+	if err != nil {
+		return nil, err
+	}
+	p := &auth.OauthCallbackPayload{Provider: provider, Code: code, State: state}
+	if verifier != "" {
+		p.CodeVerifier = &verifier
+	}
+	return s.OauthCallback(ctx, p)
+}
+
+// devLogin logs the identity in with the development provider.
+func devLogin(t *testing.T, s *authsrvc, identity *Identity) *auth.TokenResult {
+	t.Helper()
+	ctx := context.Background()
+	url, err := s.AuthURL(ctx, &auth.AuthURLPayload{Provider: "dev"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := devCallback(ctx, s, url.State, identity, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// tokenContext returns the context of a request authenticated by the access
+// token.
+func tokenContext(t *testing.T, s *authsrvc, accessToken string) context.Context {
+	t.Helper()
+	ctx, err := s.OAuth2Auth(context.Background(), accessToken, &security.OAuth2Scheme{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctx
+}
+
+// pkcePair returns a PKCE code verifier and its S256 challenge.
+func pkcePair(seed string) (verifier, challenge string) {
+	verifier = strings.Repeat(seed, 43)[:43]
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// errorName returns the name of the goa error, empty for other errors.
+func errorName(err error) string {
+	var namer goa.GoaErrorNamer
+	if errors.As(err, &namer) {
+		return namer.GoaErrorName()
+	}
+	return ""
+}
+
+// login issues the tokens of a new login of the user.
+func login(t *testing.T, s *authsrvc, userID, workspaceID string) (accessToken, refreshToken string) {
+	t.Helper()
+	res, err := s.issueTokens(context.Background(), userID, "login-"+userID, uuid.New().String(), workspaceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.AccessToken, res.RefreshToken
+}
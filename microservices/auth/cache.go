@@ -0,0 +1,160 @@
+package authapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+const (
+	// jwtCacheMargin is how long before its expiry a cached JWT is minted
+	// again, so that it outlives the gateway request.
+	jwtCacheMargin = 30 * time.Second
+	// jwtCacheSize caps the number of cached JWTs.
+	jwtCacheSize = 100000
+)
+
+// jwtCache keeps the internal JWTs minted per opaque token, keyed by the hash
+// of the token so that the secrets are not kept in memory. Revocations
+// published by any replica evict the JWTs of the revoked tokens.
+type jwtCache struct {
+	mu      sync.Mutex
+	entries map[string]*jwtCacheEntry
+}
+
+type jwtCacheEntry struct {
+	res      *auth.IntrospectResult
+	userID   string
+	familyID string
+	// ownerID is the user whose roles bound the scopes of the JWT.
+	ownerID string
+	// until is when the entry stops being served.
+	until time.Time
+}
+
+func newJWTCache() *jwtCache {
+	return &jwtCache{entries: make(map[string]*jwtCacheEntry)}
+}
+
+// get returns the JWT cached for the token hash, nil if none is fresh.
+func (c *jwtCache) get(tokenHash string) *auth.IntrospectResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[tokenHash]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.until) {
+		delete(c.entries, tokenHash)
+		return nil
+	}
+	return entry.res
+}
+
+// put caches the JWT of the token hash until shortly before it expires.
+func (c *jwtCache) put(tokenHash, userID, familyID, ownerID string, res *auth.IntrospectResult, expiresAt time.Time) {
+	until := expiresAt.Add(-jwtCacheMargin)
+	now := time.Now()
+	if !until.After(now) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= jwtCacheSize {
+		for hash, entry := range c.entries {
+			if now.After(entry.until) {
+				delete(c.entries, hash)
+			}
+		}
+		// Still full: drop arbitrary entries, they are only minted again.
+		for hash := range c.entries {
+			if len(c.entries) < jwtCacheSize {
+				break
+			}
+			delete(c.entries, hash)
+		}
+	}
+
+	c.entries[tokenHash] = &jwtCacheEntry{res: res, userID: userID, familyID: familyID, ownerID: ownerID, until: until}
+}
+
+// evict removes the JWTs of the revoked tokens.
+func (c *jwtCache) evict(revocation *Revocation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if revocation.TokenHash != "" {
+		delete(c.entries, revocation.TokenHash)
+	}
+	if revocation.FamilyID == "" && revocation.UserID == "" && revocation.OwnerID == "" {
+		return
+	}
+	for hash, entry := range c.entries {
+		// Revoking the user revokes its login tokens, not its personal
+		// access tokens, which belong to no family.
+		if (revocation.FamilyID != "" && entry.familyID == revocation.FamilyID) ||
+			(revocation.UserID != "" && entry.familyID != "" && entry.userID == revocation.UserID) ||
+			(revocation.OwnerID != "" && entry.ownerID == revocation.OwnerID) {
+			delete(c.entries, hash)
+		}
+	}
+}
+
+// watchRevocations evicts the JWTs revoked by any replica until ctx is done.
+func (s *authsrvc) watchRevocations(ctx context.Context) {
+	for revocation := range s.store.Revocations(ctx) {
+		s.jwts.evict(revocation)
+	}
+}
+
+// revoke evicts the revoked tokens from the JWT cache and notifies the other
+// replicas. The tokens are already revoked in the store, so a failure to
+// publish only leaves their JWTs cached until expiry and is logged.
+func (s *authsrvc) revoke(ctx context.Context, revocation *Revocation) {
+	s.jwts.evict(revocation)
+	if err := s.store.PublishRevocation(ctx, revocation); err != nil {
+		log.Print(ctx, log.KV{"auth.revoke", "ERROR: failed to publish revocation"}, log.KV{"error", err.Error()})
+	}
+}
+
+// revokeFamily revokes every token of the family.
+func (s *authsrvc) revokeFamily(ctx context.Context, familyID string) error {
+	if err := s.store.RevokeFamily(ctx, familyID); err != nil {
+		return err
+	}
+	s.revoke(ctx, &Revocation{FamilyID: familyID})
+	return nil
+}
+
+// revokeUser revokes every token of the user.
+func (s *authsrvc) revokeUser(ctx context.Context, userID string) error {
+	if err := s.store.RevokeUser(ctx, userID); err != nil {
+		return err
+	}
+	s.revoke(ctx, &Revocation{UserID: userID})
+	return nil
+}
+
+// deleteToken revokes the access token.
+func (s *authsrvc) deleteToken(ctx context.Context, token string) error {
+	if err := s.store.DeleteToken(ctx, token); err != nil {
+		return err
+	}
+	s.revoke(ctx, &Revocation{TokenHash: hashPersonalToken(token)})
+	return nil
+}
+
+// deletePersonalToken revokes the personal access token or API key.
+func (s *authsrvc) deletePersonalToken(ctx context.Context, entry *PersonalTokenEntry) error {
+	if err := s.store.DeletePersonalToken(ctx, entry); err != nil {
+		return err
+	}
+	s.revoke(ctx, &Revocation{TokenHash: entry.Hash})
+	return nil
+}
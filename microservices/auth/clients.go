@@ -0,0 +1,117 @@
+package authapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"goa.design/clue/log"
+	"goa.design/goa/v3/security"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// serviceTokenTTL is the lifetime of the service JWTs.
+const serviceTokenTTL = 5 * time.Minute
+
+// ServiceClient is a service registered for the client credentials grant.
+type ServiceClient struct {
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	Scopes       []string `json:"scopes"`
+}
+
+// loadServiceClients reads the registered service clients from
+// AUTH_SERVICE_CLIENTS, a JSON array of ServiceClient.
+func loadServiceClients(ctx context.Context) map[string]*ServiceClient {
+	clients := make(map[string]*ServiceClient)
+
+	clientsJSON := os.Getenv("AUTH_SERVICE_CLIENTS")
+	if clientsJSON == "" {
+		return clients
+	}
+
+	var list []*ServiceClient
+	if err := json.Unmarshal([]byte(clientsJSON), &list); err != nil {
+		log.Print(ctx, log.KV{"auth.service_clients", "ERROR: invalid AUTH_SERVICE_CLIENTS"}, log.KV{"error", err.Error()})
+		return clients
+	}
+	for _, client := range list {
+		if client.ClientID == "" || client.ClientSecret == "" {
+			log.Print(ctx, log.KV{"auth.service_clients", "ERROR: service client without ID or secret ignored"})
+			continue
+		}
+		clients[client.ClientID] = client
+	}
+
+	return clients
+}
+
+// BasicAuth implements the authorization logic for service "auth" for the
+// "client" security scheme.
+func (s *authsrvc) BasicAuth(ctx context.Context, user, pass string, scheme *security.BasicScheme) (context.Context, error) {
+	client, ok := s.clients[user]
+	if !ok || !secretEqual(client.ClientSecret, pass) {
+		log.Print(ctx, log.KV{"auth.basic_auth", fmt.Sprintf("ERROR: invalid credentials for client %q", user)})
+		return ctx, auth.Unauthorized("Invalid client credentials")
+	}
+
+	return contextWithClient(ctx, client), nil
+}
+
+// secretEqual compares the secrets in constant time.
+func secretEqual(expected, actual string) bool {
+	e := sha256.Sum256([]byte(expected))
+	a := sha256.Sum256([]byte(actual))
+	return subtle.ConstantTimeCompare(e[:], a[:]) == 1
+}
+
+// serviceToken signs a service JWT of the client.
+func (s *authsrvc) serviceToken(ctx context.Context, clientID string, scopes []string) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"sub":       "service:" + clientID,
+		"client_id": clientID,
+		"scopes":    scopes,
+		"iat":       now.Unix(),
+		"exp":       now.Add(serviceTokenTTL).Unix(),
+	}
+	return s.keys.sign(ctx, claims)
+}
+
+// Exchange the credentials of a service client for a short lived service JWT
+func (s *authsrvc) ClientToken(ctx context.Context, p *auth.ClientTokenPayload) (res *auth.ClientTokenResult, err error) {
+	client, ok := contextClient(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Invalid client credentials")
+	}
+
+	scopes := client.Scopes
+	if p.Scope != nil {
+		scopes = dedupScopes(strings.Fields(*p.Scope))
+		if !grantable(scopes, client.Scopes) {
+			return nil, auth.InvalidScope("The client may not be granted the requested scopes")
+		}
+	}
+
+	jwtString, err := s.serviceToken(ctx, client.ClientID, scopes)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.client_token", "ERROR: failed to sign JWT"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	res = &auth.ClientTokenResult{
+		AccessToken: jwtString,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(serviceTokenTTL.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}
+
+	log.Info(ctx, log.KV{"auth.client_token", fmt.Sprintf("issued service token for client %s", client.ClientID)})
+	return res, nil
+}
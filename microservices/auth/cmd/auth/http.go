@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"mime"
 	"net/http"
 	"net/url"
 	"sync"
@@ -11,6 +13,7 @@ import (
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
 	goahttp "goa.design/goa/v3/http"
+	authapi "object-t.com/hackz-giganoto/microservices/auth"
 	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
 	authsvr "object-t.com/hackz-giganoto/microservices/auth/gen/http/auth/server"
 )
@@ -24,7 +27,7 @@ func handleHTTPServer(ctx context.Context, u *url.URL, authEndpoints *auth.Endpo
 	// Other encodings can be used by providing the corresponding functions,
 	// see goa.design/implement/encoding.
 	var (
-		dec = goahttp.RequestDecoder
+		dec = requestDecoder
 		enc = goahttp.ResponseEncoder
 	)
 
@@ -55,8 +58,15 @@ func handleHTTPServer(ctx context.Context, u *url.URL, authEndpoints *auth.Endpo
 
 	// Configure the mux.
 	authsvr.Mount(mux, authServer)
+	if authapi.DevLoginEnabled() {
+		devLogin := authapi.DevLoginHandler()
+		mux.Handle("GET", "/auth/dev/login", devLogin.ServeHTTP)
+		mux.Handle("POST", "/auth/dev/login", devLogin.ServeHTTP)
+		log.Printf(ctx, "HTTP development login form mounted on /auth/dev/login")
+	}
 
 	var handler http.Handler = mux
+	handler = authapi.ClientInfoMiddleware(handler)
 	handler = otelhttp.NewHandler(handler, "auth-service")
 
 	if dbg {
@@ -96,6 +106,38 @@ func handleHTTPServer(ctx context.Context, u *url.URL, authEndpoints *auth.Endpo
 	}()
 }
 
+// requestDecoder decodes the form encoded bodies the OAuth specifications
+// use (RFC 6749, RFC 7009 and RFC 7662) in addition to the encodings of goa.
+func requestDecoder(r *http.Request) goahttp.Decoder {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/x-www-form-urlencoded" {
+		return formDecoder{r: r}
+	}
+	return goahttp.RequestDecoder(r)
+}
+
+// formDecoder decodes a form into the request body type through JSON, the
+// OAuth request fields being strings.
+type formDecoder struct {
+	r *http.Request
+}
+
+func (d formDecoder) Decode(v any) error {
+	if err := d.r.ParseForm(); err != nil {
+		return err
+	}
+
+	fields := make(map[string]string, len(d.r.PostForm))
+	for name := range d.r.PostForm {
+		fields[name] = d.r.PostForm.Get(name)
+	}
+	fieldsJSON, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(fieldsJSON, v)
+}
+
 // errorHandler returns a function that writes and logs the given error.
 // The function also writes and logs the error unique ID so that it's possible
 // to correlate.
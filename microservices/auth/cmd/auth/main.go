@@ -13,6 +13,7 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/redis/go-redis/v9"
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
 	authapi "object-t.com/hackz-giganoto/microservices/auth"
@@ -78,7 +79,10 @@ func main() {
 		authSvc auth.Service
 	)
 	{
-		authSvc = authapi.NewAuth()
+		authSvc, err = authapi.NewAuth(ctx, newStore())
+		if err != nil {
+			log.Fatalf(ctx, err, "failed to initialize auth")
+		}
 	}
 
 	// Wrap the services in endpoints that can be invoked from other services
@@ -147,3 +151,22 @@ func main() {
 	wg.Wait()
 	log.Printf(ctx, "exited")
 }
+
+// newStore returns the auth store selected by AUTH_STORE, Redis unless it is
+// set to "memory".
+func newStore() authapi.Store {
+	if os.Getenv("AUTH_STORE") == "memory" {
+		return authapi.NewMemoryStore()
+	}
+
+	redisAddr := os.Getenv("REDIS_ADDR")
+	if redisAddr == "" {
+		redisAddr = "localhost:6379"
+	}
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: redisAddr,
+	})
+
+	return authapi.NewRedisStore(redisClient)
+}
@@ -0,0 +1,147 @@
+package authapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+
+	"goa.design/clue/log"
+)
+
+type ctxValue int
+
+const (
+	ctxValueToken ctxValue = iota
+	ctxValueClientInfo
+	ctxValueClient
+)
+
+// authenticatedToken is the opaque access token authenticating a request.
+type authenticatedToken struct {
+	Token string
+	Entry *TokenEntry
+}
+
+func contextWithToken(ctx context.Context, token authenticatedToken) context.Context {
+	return context.WithValue(ctx, ctxValueToken, token)
+}
+
+func contextToken(ctx context.Context) (token authenticatedToken, ok bool) {
+	token, ok = ctx.Value(ctxValueToken).(authenticatedToken)
+	return
+}
+
+func contextWithClient(ctx context.Context, client *ServiceClient) context.Context {
+	return context.WithValue(ctx, ctxValueClient, client)
+}
+
+// contextClient returns the service client authenticated by the request.
+func contextClient(ctx context.Context) (client *ServiceClient, ok bool) {
+	client, ok = ctx.Value(ctxValueClient).(*ServiceClient)
+	return
+}
+
+// clientInfo describes the device a request comes from.
+type clientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+// defaultTrustedProxies are the networks of Kong in docker compose and k8s.
+const defaultTrustedProxies = "127.0.0.0/8,::1/128,10.0.0.0/8,172.16.0.0/12,192.168.0.0/16,fc00::/7"
+
+// ClientInfoMiddleware records the user agent and the client IP of the
+// request in its context. The forwarding headers are only read from the
+// proxies of AUTH_TRUSTED_PROXIES, a comma separated list of CIDRs.
+func ClientInfoMiddleware(h http.Handler) http.Handler {
+	cidrs := os.Getenv("AUTH_TRUSTED_PROXIES")
+	if cidrs == "" {
+		cidrs = defaultTrustedProxies
+	}
+	trusted := parseTrustedProxies(cidrs)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := clientInfo{
+			UserAgent: r.UserAgent(),
+			IP:        clientIP(r, trusted),
+		}
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxValueClientInfo, info)))
+	})
+}
+
+func parseTrustedProxies(cidrs string) []netip.Prefix {
+	var trusted []netip.Prefix
+	for _, cidr := range strings.Split(cidrs, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			log.Print(context.Background(), log.KV{"auth.trusted_proxies", fmt.Sprintf("ERROR: invalid trusted proxy %q ignored", cidr)}, log.KV{"error", err.Error()})
+			continue
+		}
+		trusted = append(trusted, prefix)
+	}
+	return trusted
+}
+
+// clientIP returns the IP of the client of the request. Proxies append the
+// address of their peer to X-Forwarded-For, so only the hops on its right
+// were added by trusted proxies and the client is the rightmost untrusted
+// hop. The leftmost hops are set by the client and never used.
+func clientIP(r *http.Request, trusted []netip.Prefix) string {
+	peer := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		peer = host
+	}
+	if !isTrusted(peer, trusted) {
+		return peer
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	nearest := ""
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if nearest == "" {
+			nearest = hop
+		}
+		if !isTrusted(hop, trusted) {
+			return hop
+		}
+	}
+	// Every hop is a trusted proxy: the client is inside the trusted networks
+	// and identified by the hop added by the nearest proxy.
+	if nearest != "" {
+		return nearest
+	}
+	if ip := r.Header.Get("X-Real-IP"); ip != "" {
+		return ip
+	}
+	return peer
+}
+
+func isTrusted(ip string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func contextClientInfo(ctx context.Context) (info clientInfo) {
+	info, _ = ctx.Value(ctxValueClientInfo).(clientInfo)
+	return
+}
@@ -0,0 +1,56 @@
+package authapi
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	trusted := parseTrustedProxies(defaultTrustedProxies)
+	cases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		want       string
+	}{
+		{"direct client", "203.0.113.7:1234", nil, "", "203.0.113.7"},
+		{"direct client spoofing headers", "203.0.113.7:1234", []string{"198.51.100.1"}, "198.51.100.2", "203.0.113.7"},
+		{"behind kong", "172.18.0.5:1234", []string{"203.0.113.7"}, "", "203.0.113.7"},
+		{"spoofed leftmost hop", "172.18.0.5:1234", []string{"198.51.100.1, 203.0.113.7"}, "", "203.0.113.7"},
+		{"spoofed private hop", "172.18.0.5:1234", []string{"10.0.0.9, 203.0.113.7"}, "", "203.0.113.7"},
+		{"several trusted proxies", "172.18.0.5:1234", []string{"198.51.100.1, 203.0.113.7, 10.0.0.3"}, "", "203.0.113.7"},
+		{"repeated header", "172.18.0.5:1234", []string{"198.51.100.1", "203.0.113.7"}, "", "203.0.113.7"},
+		{"client in trusted network", "172.18.0.5:1234", []string{"10.0.0.9, 10.0.0.3"}, "", "10.0.0.3"},
+		{"real ip from trusted proxy", "172.18.0.5:1234", nil, "203.0.113.7", "203.0.113.7"},
+		{"trusted proxy without headers", "172.18.0.5:1234", nil, "", "172.18.0.5"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/introspect", nil)
+			r.RemoteAddr = c.remoteAddr
+			for _, v := range c.forwarded {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+			if c.realIP != "" {
+				r.Header.Set("X-Real-IP", c.realIP)
+			}
+			if got := clientIP(r, trusted); got != c.want {
+				t.Errorf("clientIP = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	trusted := parseTrustedProxies(" 10.0.0.0/8 , invalid,,192.168.1.1/32")
+	if len(trusted) != 2 {
+		t.Fatalf("parsed %d prefixes, want 2", len(trusted))
+	}
+	if !isTrusted("192.168.1.1", trusted) || isTrusted("192.168.1.2", trusted) {
+		t.Error("192.168.1.1/32 not applied")
+	}
+	if !isTrusted("::ffff:10.1.2.3", trusted) {
+		t.Error("IPv4-mapped address not trusted")
+	}
+}
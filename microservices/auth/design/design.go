@@ -2,6 +2,172 @@ package design
 
 import . "goa.design/goa/v3/dsl"
 
+var OAuth2Auth = OAuth2Security("oauth2", func() {
+	Description("Opaque access token issued by the auth service")
+	AuthorizationCodeFlow("/auth/{provider}", "/auth/{provider}/callback", "/auth/token/refresh")
+	Scope("api:read", "Read access to API resources")
+	Scope("api:write", "Write access to API resources")
+	Scope("api:moderate", "Moderator access")
+	Scope("api:admin", "Administrator access")
+})
+
+var ClientAuth = BasicAuthSecurity("client", func() {
+	Description("Client ID and secret of a registered service client")
+})
+
+var TokenResult = Type("TokenResult", func() {
+	Description("Opaque access token with the refresh token renewing it")
+
+	Field(1, "access_token", String, "Opaque access token")
+	Field(2, "token_type", String, "Token type (Bearer)")
+	Field(3, "expires_in", Int64, "Token expiration in seconds")
+	Field(4, "user_id", String, "Internal user ID")
+	Field(5, "refresh_token", String, "Opaque refresh token")
+	Field(6, "refresh_expires_in", Int64, "Refresh token expiration in seconds")
+	Field(7, "workspace_id", String, "Workspace the tokens act in, absent for the default workspace")
+	Required("access_token", "token_type", "expires_in", "user_id", "refresh_token", "refresh_expires_in")
+})
+
+var TokenIntrospection = Type("TokenIntrospection", func() {
+	Description("Token introspection response (RFC 7662), only active is set for inactive tokens")
+
+	Field(1, "active", Boolean, "Whether the token is active")
+	Field(2, "scope", String, "Space separated scopes of the token")
+	Field(3, "client_id", String, "Client the token was presented to, which introspects it")
+	Field(4, "username", String, "Login of the user of the token")
+	Field(5, "token_type", String, "Token type (Bearer)")
+	Field(6, "exp", Int64, "Token expiration timestamp")
+	Field(7, "iat", Int64, "Token issuance timestamp")
+	Field(8, "sub", String, "User ID of the token")
+	Required("active")
+})
+
+var Session = Type("Session", func() {
+	Description("Login session of a user with the device it was started from")
+
+	Field(1, "id", String, "Session ID")
+	Field(2, "user_agent", String, "User agent of the device")
+	Field(3, "ip", String, "IP address of the device")
+	Field(4, "created_at", Int64, "Login timestamp")
+	Field(5, "last_used_at", Int64, "Last use timestamp")
+	Field(6, "current", Boolean, "Whether the session is the one of the request")
+	Required("id", "user_agent", "ip", "created_at", "last_used_at", "current")
+})
+
+var LinkedIdentity = Type("LinkedIdentity", func() {
+	Description("Account of an identity provider linked to a user")
+
+	Field(1, "provider", String, "Identity provider")
+	Field(2, "login", String, "Login of the account at the provider")
+	Field(3, "email", String, "Email address of the account")
+	Field(4, "linked_at", Int64, "Link timestamp")
+	Required("provider", "login", "linked_at")
+})
+
+var Workspace = Type("Workspace", func() {
+	Description("Workspace isolating the chats and profiles of a team")
+
+	Field(1, "id", String, "Workspace ID")
+	Field(2, "name", String, "Workspace name")
+	Field(3, "created_by", String, "User who created the workspace")
+	Field(4, "created_at", Int64, "Creation timestamp")
+	Required("id", "name", "created_by", "created_at")
+})
+
+var JWK = Type("JWK", func() {
+	Description("Public key verifying the internal JWT tokens (RFC 7517)")
+
+	Field(1, "kty", String, "Key type")
+	Field(2, "kid", String, "Key ID")
+	Field(3, "use", String, "Public key use")
+	Field(4, "alg", String, "Signing algorithm")
+	Field(5, "n", String, "RSA modulus")
+	Field(6, "e", String, "RSA public exponent")
+	Required("kty", "kid", "use", "alg", "n", "e")
+})
+
+var PersonalAccessToken = Type("PersonalAccessToken", func() {
+	Description("Named long lived token granting a subset of the scopes of its owner")
+
+	Field(1, "id", String, "Token ID")
+	Field(2, "name", String, "Token name")
+	Field(3, "scopes", ArrayOf(String), "Granted scopes")
+	Field(4, "created_at", Int64, "Creation timestamp")
+	Field(5, "expires_at", Int64, "Expiration timestamp, unset if the token never expires")
+	Field(6, "last_used_at", Int64, "Last use timestamp, unset if the token was never used")
+	Field(7, "workspace_id", String, "Workspace the token acts in, absent for the default workspace")
+	Required("id", "name", "scopes", "created_at")
+})
+
+var CreatedToken = Type("CreatedToken", func() {
+	Description("Personal access token with its secret, only returned on creation")
+
+	Field(1, "token", String, "Secret token to send as bearer token")
+	Field(2, "id", String, "Token ID")
+	Field(3, "name", String, "Token name")
+	Field(4, "scopes", ArrayOf(String), "Granted scopes")
+	Field(5, "created_at", Int64, "Creation timestamp")
+	Field(6, "expires_at", Int64, "Expiration timestamp, unset if the token never expires")
+	Field(7, "workspace_id", String, "Workspace the token acts in, absent for the default workspace")
+	Required("token", "id", "name", "scopes", "created_at")
+})
+
+var Bot = Type("Bot", func() {
+	Description("Bot identity authenticating with an API key")
+
+	Field(1, "id", String, "Bot ID")
+	Field(2, "name", String, "Bot name")
+	Field(3, "user_id", String, "User ID of the bot in the other services")
+	Field(4, "scopes", ArrayOf(String), "Scopes granted to the API key")
+	Field(5, "created_at", Int64, "Creation timestamp")
+	Required("id", "name", "user_id", "scopes", "created_at")
+})
+
+var CreatedBot = Type("CreatedBot", func() {
+	Description("Bot with its API key, only returned on creation")
+
+	Field(1, "api_key", String, "Secret API key to send as bearer token")
+	Field(2, "id", String, "Bot ID")
+	Field(3, "name", String, "Bot name")
+	Field(4, "user_id", String, "User ID of the bot in the other services")
+	Field(5, "scopes", ArrayOf(String), "Scopes granted to the API key")
+	Field(6, "created_at", Int64, "Creation timestamp")
+	Required("api_key", "id", "name", "user_id", "scopes", "created_at")
+})
+
+var UserRoles = Type("UserRoles", func() {
+	Description("Roles of a user with the scopes they grant")
+
+	Field(1, "user_id", String, "User ID")
+	Field(2, "roles", ArrayOf(String), "Roles of the user, every user has the user role")
+	Field(3, "scopes", ArrayOf(String), "Scopes granted by the roles")
+	Required("user_id", "roles", "scopes")
+})
+
+var AuditEvent = Type("AuditEvent", func() {
+	Description("Security relevant event of the auth service")
+
+	Field(1, "id", String, "Event ID")
+	Field(2, "type", String, "Event type", func() {
+		Enum("state_created", "login_succeeded", "login_failed", "introspection_failed", "token_revoked", "role_granted", "role_revoked", "rate_limited", "locked_out", "identity_linked", "identity_unlinked", "workspace_member_added", "workspace_member_removed")
+	})
+	Field(3, "user_id", String, "User the event is about")
+	Field(4, "actor_id", String, "User who performed the action, if not the user itself")
+	Field(5, "ip", String, "IP address of the request")
+	Field(6, "provider", String, "Identity provider")
+	Field(7, "reason", String, "Reason of a failure or detail of the event")
+	Field(8, "created_at", Int64, "Event timestamp")
+	Required("id", "type", "created_at")
+})
+
+// tokenScopes is the attribute listing the scopes granted to a token.
+var tokenScopes = func() {
+	Elem(func() {
+		Enum("api:read", "api:write", "api:moderate", "api:admin")
+	})
+	MinLength(1)
+}
+
 var _ = Service("auth", func() {
 	Description("Authentication service that converts opaque tokens to internal JWT tokens for Kong Gateway")
 
@@ -26,64 +192,978 @@ var _ = Service("auth", func() {
 		})
 
 		Error("invalid_token", String, "Token is invalid or expired")
+		Error("too_many_requests", String, "Rate limit exceeded or client locked out")
 		Error("internal_error", String, "Internal server error")
 
 		HTTP(func() {
 			POST("/introspect")
 			Response(StatusOK)
 			Response("invalid_token", StatusUnauthorized)
+			Response("too_many_requests", StatusTooManyRequests)
 			Response("internal_error", StatusInternalServerError)
 		})
 	})
 
 	Method("auth_url", func() {
-		Description("Get GitHub OAuth authorization URL with state parameter")
+		Description("Get the OAuth authorization URL of an identity provider with state parameter")
+
+		Payload(func() {
+			Field(1, "provider", String, "Identity provider (github, gitlab, google, oidc)")
+			Field(2, "code_challenge", String, "PKCE code challenge of the client (RFC 7636)", func() {
+				Pattern("^[A-Za-z0-9_-]{43}$")
+			})
+			Field(3, "code_challenge_method", String, "PKCE code challenge method", func() {
+				Enum("S256")
+				Default("S256")
+			})
+			Required("provider")
+		})
 
 		Result(func() {
-			Field(1, "auth_url", String, "GitHub OAuth authorization URL")
+			Field(1, "auth_url", String, "OAuth authorization URL of the identity provider")
 			Field(2, "state", String, "OAuth state parameter for CSRF protection")
 			Required("auth_url", "state")
 		})
 
+		Error("unknown_provider", String, "Identity provider is not configured")
+		Error("provider_error", String, "Identity provider error")
+		Error("too_many_requests", String, "Rate limit exceeded or client locked out")
 		Error("internal_error", String, "Internal server error")
 
 		HTTP(func() {
-			GET("/auth/github")
+			GET("/auth/{provider}")
+			Param("code_challenge")
+			Param("code_challenge_method")
 			Response(StatusOK)
+			Response("unknown_provider", StatusNotFound)
+			Response("provider_error", StatusBadGateway)
+			Response("too_many_requests", StatusTooManyRequests)
 			Response("internal_error", StatusInternalServerError)
 		})
 	})
 
 	Method("oauth_callback", func() {
-		Description("Handle GitHub OAuth callback and return opaque token")
+		Description("Handle the OAuth callback of an identity provider and return opaque token")
 
 		Payload(func() {
-			Field(1, "code", String, "Authorization code from GitHub")
-			Field(2, "state", String, "OAuth state parameter for validation")
-			Required("code", "state")
+			Field(1, "provider", String, "Identity provider (github, gitlab, google, oidc)")
+			Field(2, "code", String, "Authorization code from the identity provider")
+			Field(3, "state", String, "OAuth state parameter for validation")
+			Field(4, "code_verifier", String, "PKCE code verifier, required when auth_url was given a code challenge", func() {
+				Pattern("^[A-Za-z0-9._~-]{43,128}$")
+			})
+			Required("provider", "code", "state")
 		})
 
-		Result(func() {
-			Field(1, "access_token", String, "Opaque access token")
-			Field(2, "token_type", String, "Token type (Bearer)")
-			Field(3, "expires_in", Int64, "Token expiration in seconds")
-			Field(4, "user_id", String, "GitHub user ID")
-			Required("access_token", "token_type", "expires_in", "user_id")
-		})
+		Result(TokenResult)
 
+		Error("unknown_provider", String, "Identity provider is not configured")
 		Error("invalid_state", String, "Invalid or expired state parameter")
 		Error("invalid_code", String, "Invalid authorization code")
-		Error("github_error", String, "GitHub API error")
+		Error("invalid_verifier", String, "PKCE code verifier is missing or does not match the code challenge")
+		Error("provider_error", String, "Identity provider error")
+		Error("identity_conflict", String, "The identity is linked to another user, or the user already linked one of the provider")
+		Error("too_many_requests", String, "Rate limit exceeded or client locked out")
 		Error("internal_error", String, "Internal server error")
 
 		HTTP(func() {
-			GET("/auth/github/callback")
+			GET("/auth/{provider}/callback")
 			Param("code")
 			Param("state")
+			Param("code_verifier")
 			Response(StatusOK)
+			Response("unknown_provider", StatusNotFound)
 			Response("invalid_state", StatusBadRequest)
 			Response("invalid_code", StatusBadRequest)
-			Response("github_error", StatusBadGateway)
+			Response("invalid_verifier", StatusBadRequest)
+			Response("provider_error", StatusBadGateway)
+			Response("identity_conflict", StatusConflict)
+			Response("too_many_requests", StatusTooManyRequests)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("refresh", func() {
+		Description("Exchange a refresh token for a new access token and refresh token")
+
+		Payload(func() {
+			Field(1, "refresh_token", String, "Opaque refresh token")
+			Required("refresh_token")
+		})
+
+		Result(TokenResult)
+
+		Error("invalid_token", String, "Refresh token is invalid, expired or already used")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/token/refresh")
+			Response(StatusOK)
+			Response("invalid_token", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("logout", func() {
+		Description("Revoke the access token of the request and its refresh token")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Required("token")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/logout")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("revoke", func() {
+		Description("Revoke an access token or a refresh token (RFC 7009)")
+
+		Payload(func() {
+			Field(1, "token", String, "Token to revoke")
+			Field(2, "token_type_hint", String, "Type of the token", func() {
+				Enum("access_token", "refresh_token")
+			})
+			Required("token")
+		})
+
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/revoke")
+			Response(StatusOK)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("revoke_user_sessions", func() {
+		Description("Revoke every token of a user")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "user_id", String, "User whose sessions are revoked")
+			Required("token", "user_id")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/users/{user_id}/revoke")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("list_sessions", func() {
+		Description("List the active sessions of the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Required("token")
+		})
+
+		Result(ArrayOf(Session))
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/sessions")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("delete_session", func() {
+		Description("Terminate a session of the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "id", String, "Session ID")
+			Required("token", "id")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("not_found", String, "Session not found")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			DELETE("/auth/sessions/{id}")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("link_identity", func() {
+		Description("Get the OAuth authorization URL linking an identity of the provider to the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "provider", String, "Identity provider (github, gitlab, google, oidc)")
+			Field(2, "code_challenge", String, "PKCE code challenge of the client (RFC 7636), its verifier is required by the callback", func() {
+				Pattern("^[A-Za-z0-9_-]{43}$")
+			})
+			Field(3, "code_challenge_method", String, "PKCE code challenge method", func() {
+				Enum("S256")
+				Default("S256")
+			})
+			Required("token", "provider", "code_challenge")
+		})
+
+		Result(func() {
+			Field(1, "auth_url", String, "OAuth authorization URL of the identity provider")
+			Field(2, "state", String, "OAuth state parameter for CSRF protection")
+			Required("auth_url", "state")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("unknown_provider", String, "Identity provider is not configured")
+		Error("provider_error", String, "Identity provider error")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/identities/{provider}")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("unknown_provider", StatusNotFound)
+			Response("provider_error", StatusBadGateway)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("list_identities", func() {
+		Description("List the identities linked to the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Required("token")
+		})
+
+		Result(ArrayOf(LinkedIdentity))
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/identities")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("unlink_identity", func() {
+		Description("Unlink the identity of the provider from the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "provider", String, "Identity provider")
+			Required("token", "provider")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("not_found", String, "No identity of the provider is linked")
+		Error("last_identity", String, "The last identity of a user cannot be unlinked")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			DELETE("/auth/identities/{provider}")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("last_identity", StatusConflict)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("create_workspace", func() {
+		Description("Create a workspace with the current user as its first member")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "name", String, "Workspace name", func() {
+				MinLength(1)
+				MaxLength(100)
+			})
+			Required("token", "name")
+		})
+
+		Result(Workspace)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/workspaces")
+			Response(StatusCreated)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("list_workspaces", func() {
+		Description("List the workspaces of the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Required("token")
+		})
+
+		Result(ArrayOf(Workspace))
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/workspaces")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("add_workspace_member", func() {
+		Description("Add a user to a workspace created by the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "workspace_id", String, "Workspace ID")
+			Field(2, "user_id", String, "User to add")
+			Required("token", "workspace_id", "user_id")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Only the creator of the workspace may add members")
+		Error("not_found", String, "Workspace or user not found")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/workspaces/{workspace_id}/members")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("remove_workspace_member", func() {
+		Description("Remove a user from a workspace, either by its creator or by the user leaving it")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "workspace_id", String, "Workspace ID")
+			Field(2, "user_id", String, "User to remove")
+			Required("token", "workspace_id", "user_id")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "The user may not remove this member")
+		Error("not_found", String, "Workspace or member not found")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			DELETE("/auth/workspaces/{workspace_id}/members/{user_id}")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("switch_workspace", func() {
+		Description("Issue tokens of the current session acting in a workspace of the current user, revoking the presented ones")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "workspace_id", String, "Workspace ID")
+			Required("token", "workspace_id")
+		})
+
+		Result(TokenResult)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("not_found", String, "Workspace not found")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/workspaces/{workspace_id}/token")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("switch_default_workspace", func() {
+		Description("Issue tokens of the current session acting in the default workspace, revoking the presented ones")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Required("token")
+		})
+
+		Result(TokenResult)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/token/default-workspace")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("jwks", func() {
+		Description("Public keys verifying the internal JWT tokens")
+
+		Result(func() {
+			Field(1, "keys", ArrayOf(JWK), "Published keys")
+			Required("keys")
+		})
+
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/.well-known/jwks.json")
+			Response(StatusOK)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("create_token", func() {
+		Description("Create a personal access token for the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "name", String, "Token name", func() {
+				MinLength(1)
+				MaxLength(100)
+			})
+			Field(2, "scopes", ArrayOf(String), "Scopes to grant, a subset of the scopes of the user", tokenScopes)
+			Field(3, "expires_in", Int64, "Token lifetime in seconds, the token never expires if unset", func() {
+				Minimum(1)
+			})
+			Required("token", "name", "scopes")
+		})
+
+		Result(CreatedToken)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "The user does not hold the requested scopes")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/tokens")
+			Response(StatusCreated)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("list_tokens", func() {
+		Description("List the personal access tokens of the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Required("token")
+		})
+
+		Result(ArrayOf(PersonalAccessToken))
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/tokens")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("delete_token", func() {
+		Description("Revoke a personal access token of the current user")
+
+		Security(OAuth2Auth)
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "id", String, "Token ID")
+			Required("token", "id")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("not_found", String, "Token not found")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			DELETE("/auth/tokens/{id}")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("create_bot", func() {
+		Description("Create a bot identity with an API key")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "name", String, "Bot name", func() {
+				MinLength(1)
+				MaxLength(100)
+			})
+			Field(2, "scopes", ArrayOf(String), "Scopes to grant to the API key", tokenScopes)
+			Required("token", "name", "scopes")
+		})
+
+		Result(CreatedBot)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/bots")
+			Response(StatusCreated)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("list_bots", func() {
+		Description("List the bot identities")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Required("token")
+		})
+
+		Result(ArrayOf(Bot))
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/bots")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("delete_bot", func() {
+		Description("Delete a bot identity and revoke its API key")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "id", String, "Bot ID")
+			Required("token", "id")
+		})
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("not_found", String, "Bot not found")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			DELETE("/auth/bots/{id}")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("client_token", func() {
+		Description("Exchange the credentials of a service client for a short lived service JWT (client credentials grant)")
+
+		Security(ClientAuth)
+
+		Payload(func() {
+			Username("client_id", String, "Client ID")
+			Password("client_secret", String, "Client secret")
+			Field(1, "grant_type", String, "OAuth grant type", func() {
+				Enum("client_credentials")
+			})
+			Field(2, "scope", String, "Space separated scopes to grant, every scope of the client if unset")
+			Required("client_id", "client_secret", "grant_type")
+		})
+
+		Result(func() {
+			Field(1, "access_token", String, "Service JWT")
+			Field(2, "token_type", String, "Token type (Bearer)")
+			Field(3, "expires_in", Int64, "Token expiration in seconds")
+			Field(4, "scope", String, "Space separated granted scopes")
+			Required("access_token", "token_type", "expires_in", "scope")
+		})
+
+		Error("unauthorized", String, "Client authentication failed")
+		Error("invalid_scope", String, "The client may not be granted the requested scopes")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/token")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("invalid_scope", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("token_introspect", func() {
+		Description("Introspect an access token, refresh token or personal access token as per RFC 7662, for resource servers authenticated with their client credentials")
+
+		Security(ClientAuth)
+
+		Payload(func() {
+			Username("client_id", String, "Client ID")
+			Password("client_secret", String, "Client secret")
+			Field(1, "token", String, "Token to introspect")
+			Field(2, "token_type_hint", String, "Type of the token", func() {
+				Enum("access_token", "refresh_token")
+			})
+			Required("client_id", "client_secret", "token")
+		})
+
+		Result(TokenIntrospection)
+
+		Error("unauthorized", String, "Client authentication failed")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/token/introspect")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("get_user_roles", func() {
+		Description("Get the roles of a user")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "user_id", String, "User ID")
+			Required("token", "user_id")
+		})
+
+		Result(UserRoles)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/users/{user_id}/roles")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("grant_role", func() {
+		Description("Grant a role to a user, it applies to the tokens issued from then on")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "user_id", String, "User ID")
+			Field(2, "role", String, "Role to grant", func() {
+				Enum("moderator", "admin")
+			})
+			Required("token", "user_id", "role")
+		})
+
+		Result(UserRoles)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/users/{user_id}/roles")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("revoke_role", func() {
+		Description("Revoke a role of a user, it applies to the tokens issued from then on")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "user_id", String, "User ID")
+			Field(2, "role", String, "Role to revoke", func() {
+				Enum("moderator", "admin")
+			})
+			Required("token", "user_id", "role")
+		})
+
+		Result(UserRoles)
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			DELETE("/auth/users/{user_id}/roles/{role}")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("device_authorization", func() {
+		Description("Start a device authorization (RFC 8628) for a client without a browser")
+
+		Payload(func() {
+			Field(1, "provider", String, "Identity provider the user logs in with", func() {
+				Default("github")
+			})
+			Field(2, "client_id", String, "Client requesting the authorization, shown to the user on the consent page", func() {
+				MaxLength(64)
+			})
+		})
+
+		Result(func() {
+			Field(1, "device_code", String, "Device verification code the client polls with")
+			Field(2, "user_code", String, "Code the user enters on the verification page")
+			Field(3, "verification_uri", String, "Verification page")
+			Field(4, "verification_uri_complete", String, "Verification page with the user code filled in")
+			Field(5, "expires_in", Int64, "Lifetime of the codes in seconds")
+			Field(6, "interval", Int64, "Minimum polling interval in seconds")
+			Required("device_code", "user_code", "verification_uri", "verification_uri_complete", "expires_in", "interval")
+		})
+
+		Error("unknown_provider", String, "Identity provider is not configured")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/device/code")
+			Response(StatusOK)
+			Response("unknown_provider", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("device_verify", func() {
+		Description("Consent page of a device authorization, showing the user code and the requesting client")
+
+		Payload(func() {
+			Field(1, "user_code", String, "User code displayed by the device")
+			Required("user_code")
+		})
+
+		Result(func() {
+			Field(1, "page", String, "Consent page")
+			Field(2, "consent", String, "Consent token the confirmation presents back")
+			Required("page", "consent")
+		})
+
+		Error("invalid_user_code", String, "User code is invalid or expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/device")
+			Param("user_code")
+			Response(StatusOK, func() {
+				ContentType("text/html")
+				Body("page")
+				Cookie("consent:device_consent")
+				CookieMaxAge(600)
+				CookiePath("/auth/device")
+				CookieHTTPOnly()
+				CookieSameSite(CookieSameSiteStrict)
+			})
+			Response("invalid_user_code", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("device_confirm", func() {
+		Description("Confirm a device authorization from its consent page, redirects the user to the login page of the identity provider")
+
+		Payload(func() {
+			Field(1, "user_code", String, "User code displayed by the device")
+			Field(2, "consent", String, "Consent token of the consent page")
+			Field(3, "consent_cookie", String, "Consent token set by the consent page")
+			Required("user_code", "consent", "consent_cookie")
+		})
+
+		Result(func() {
+			Field(1, "location", String, "Login page of the identity provider")
+			Required("location")
+		})
+
+		Error("invalid_user_code", String, "User code is invalid or expired")
+		Error("invalid_consent", String, "The confirmation does not come from the consent page")
+		Error("unknown_provider", String, "Identity provider is not configured")
+		Error("provider_error", String, "Identity provider error")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/device/confirm")
+			Param("user_code")
+			Param("consent")
+			Cookie("consent_cookie:device_consent")
+			Response(StatusFound, func() {
+				Header("location:Location")
+			})
+			Response("invalid_user_code", StatusBadRequest)
+			Response("invalid_consent", StatusForbidden)
+			Response("unknown_provider", StatusNotFound)
+			Response("provider_error", StatusBadGateway)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("device_token", func() {
+		Description("Poll for the tokens of a device authorization")
+
+		Payload(func() {
+			Field(1, "grant_type", String, "OAuth grant type", func() {
+				Enum("urn:ietf:params:oauth:grant-type:device_code")
+			})
+			Field(2, "device_code", String, "Device verification code")
+			Required("grant_type", "device_code")
+		})
+
+		Result(TokenResult)
+
+		Error("authorization_pending", String, "The user has not completed the verification yet")
+		Error("slow_down", String, "The client polls too often, the interval was increased by 5 seconds")
+		Error("expired_token", String, "The device code expired")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			POST("/auth/device/token")
+			Response(StatusOK)
+			Response("authorization_pending", StatusBadRequest)
+			Response("slow_down", StatusBadRequest)
+			Response("expired_token", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	Method("list_audit_events", func() {
+		Description("List the audit events, newest first")
+
+		Security(OAuth2Auth, func() {
+			Scope("api:admin")
+		})
+
+		Payload(func() {
+			AccessToken("token", String, "Opaque access token")
+			Field(1, "user_id", String, "Only list the events about this user")
+			Field(2, "since", Int64, "Only list the events from this timestamp")
+			Field(3, "until", Int64, "Only list the events up to this timestamp")
+			Field(4, "limit", Int, "Maximum number of events", func() {
+				Minimum(1)
+				Maximum(1000)
+				Default(100)
+			})
+			Required("token")
+		})
+
+		Result(ArrayOf(AuditEvent))
+
+		Error("unauthorized", String, "Token is invalid or expired")
+		Error("forbidden", String, "Token lacks the required scope")
+		Error("internal_error", String, "Internal server error")
+
+		HTTP(func() {
+			GET("/auth/audit")
+			Param("user_id")
+			Param("since")
+			Param("until")
+			Param("limit")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
 			Response("internal_error", StatusInternalServerError)
 		})
 	})
@@ -0,0 +1,284 @@
+package authapi
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"math/big"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+const (
+	deviceCodeTTL      = 10 * time.Minute
+	devicePollInterval = 5 * time.Second
+	// userCodeAlphabet has no vowels nor ambiguous characters (RFC 8628
+	// section 6.1).
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// consentPage asks the user to check the user code before logging in, so that
+// a verification link sent by someone else does not approve their device
+// unnoticed (RFC 8628 section 5.4).
+var consentPage = template.Must(template.New("consent").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Authorize device</title></head>
+<body>
+<h1>Authorize device</h1>
+<p>{{.ClientID}} asks to sign in to your account with {{.Provider}}.</p>
+<p>Check that your device displays the code <strong>{{.UserCode}}</strong>.
+Only continue if you started this sign-in yourself, otherwise close this page.</p>
+<form method="get" action="/auth/device/confirm">
+<input type="hidden" name="user_code" value="{{.UserCode}}">
+<input type="hidden" name="consent" value="{{.Consent}}">
+<button type="submit">Continue</button>
+</form>
+</body>
+</html>
+`))
+
+// Start a device authorization (RFC 8628) for a client without a browser
+func (s *authsrvc) DeviceAuthorization(ctx context.Context, p *auth.DeviceAuthorizationPayload) (res *auth.DeviceAuthorizationResult, err error) {
+	provider, err := s.provider(p.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	userCode, err := generateUserCode()
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.device_authorization", "ERROR: failed to generate user code"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	now := time.Now()
+	entry := &DeviceEntry{
+		DeviceCode: uuid.New().String(),
+		UserCode:   userCode,
+		Provider:   provider.Name(),
+		Interval:   devicePollInterval,
+		ExpiresAt:  now.Add(deviceCodeTTL),
+	}
+	if p.ClientID != nil {
+		entry.ClientID = *p.ClientID
+	}
+	if err := s.store.SaveDevice(ctx, entry); err != nil {
+		log.Print(ctx, log.KV{"auth.device_authorization", "ERROR: failed to store device authorization"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	verificationURI := publicURL() + "/auth/device"
+	res = &auth.DeviceAuthorizationResult{
+		DeviceCode:              entry.DeviceCode,
+		UserCode:                formatUserCode(userCode),
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?user_code=" + url.QueryEscape(formatUserCode(userCode)),
+		ExpiresIn:               int64(deviceCodeTTL.Seconds()),
+		Interval:                int64(devicePollInterval.Seconds()),
+	}
+
+	log.Info(ctx, log.KV{"auth.device_authorization", fmt.Sprintf("started device authorization with %s", provider.Name())})
+	return res, nil
+}
+
+// Consent page of a device authorization, showing the user code and the
+// requesting client
+func (s *authsrvc) DeviceVerify(ctx context.Context, p *auth.DeviceVerifyPayload) (res *auth.DeviceVerifyResult, err error) {
+	entry, err := s.pendingDevice(ctx, "auth.device_verify", p.UserCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// The consent is set both as cookie and in the form, the confirmation
+	// only proceeds if they match so that it comes from this page.
+	consent, err := generateConsent()
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.device_verify", "ERROR: failed to generate consent"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	clientID := entry.ClientID
+	if clientID == "" {
+		clientID = "an unnamed client"
+	}
+	var page strings.Builder
+	err = consentPage.Execute(&page, map[string]string{
+		"UserCode": formatUserCode(entry.UserCode),
+		"ClientID": clientID,
+		"Provider": entry.Provider,
+		"Consent":  consent,
+	})
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.device_verify", "ERROR: failed to render consent page"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	return &auth.DeviceVerifyResult{Page: page.String(), Consent: consent}, nil
+}
+
+// Confirm a device authorization from its consent page, redirects the user to
+// the login page of the identity provider
+func (s *authsrvc) DeviceConfirm(ctx context.Context, p *auth.DeviceConfirmPayload) (res *auth.DeviceConfirmResult, err error) {
+	if subtle.ConstantTimeCompare([]byte(p.Consent), []byte(p.ConsentCookie)) != 1 {
+		log.Print(ctx, log.KV{"auth.device_confirm", "ERROR: consent does not match the consent page"})
+		return nil, auth.InvalidConsent("The confirmation does not come from the consent page")
+	}
+
+	entry, err := s.pendingDevice(ctx, "auth.device_confirm", p.UserCode)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := s.provider(entry.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The provider callback approves the device once the user logged in.
+	_, authURL, err := s.startLogin(ctx, provider, &StateEntry{DeviceCode: entry.DeviceCode})
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.DeviceConfirmResult{Location: authURL}, nil
+}
+
+// pendingDevice returns the device authorization of the user code if it is
+// still waiting for its approval.
+func (s *authsrvc) pendingDevice(ctx context.Context, op, userCode string) (*DeviceEntry, error) {
+	entry, err := s.store.DeviceByUserCode(ctx, normalizeUserCode(userCode))
+	if err != nil {
+		log.Print(ctx, log.KV{op, "ERROR: failed to load device authorization"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if entry == nil || entry.Approved {
+		return nil, auth.InvalidUserCode("User code is invalid or expired")
+	}
+	return entry, nil
+}
+
+// approveDevice grants the device authorization to the user.
+func (s *authsrvc) approveDevice(ctx context.Context, deviceCode, userID, login string) error {
+	entry, err := s.store.GetDevice(ctx, deviceCode)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.approve_device", "ERROR: failed to load device authorization"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	if entry == nil {
+		return auth.InvalidState("Device authorization expired")
+	}
+
+	approved, err := s.store.ApproveDevice(ctx, entry, userID, login)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.approve_device", "ERROR: failed to store device authorization"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	if !approved {
+		return auth.InvalidState("Device authorization expired")
+	}
+
+	log.Info(ctx, log.KV{"auth.approve_device", fmt.Sprintf("user %s approved a device authorization", login)})
+	return nil
+}
+
+// Poll for the tokens of a device authorization
+func (s *authsrvc) DeviceToken(ctx context.Context, p *auth.DeviceTokenPayload) (res *auth.TokenResult, err error) {
+	entry, err := s.store.GetDevice(ctx, p.DeviceCode)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.device_token", "ERROR: failed to load device authorization"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if entry == nil {
+		return nil, auth.ExpiredToken("The device code expired")
+	}
+
+	now := time.Now()
+	if now.Sub(entry.LastPolledAt) < entry.Interval {
+		entry.Interval += 5 * time.Second
+		entry.LastPolledAt = now
+		if err := s.store.PollDevice(ctx, entry); err != nil {
+			log.Print(ctx, log.KV{"auth.device_token", "ERROR: failed to store device authorization"}, log.KV{"error", err.Error()})
+			return nil, auth.InternalError("Internal server error")
+		}
+		return nil, auth.SlowDown(fmt.Sprintf("Poll at most every %d seconds", int64(entry.Interval.Seconds())))
+	}
+
+	if !entry.Approved {
+		entry.LastPolledAt = now
+		if err := s.store.PollDevice(ctx, entry); err != nil {
+			log.Print(ctx, log.KV{"auth.device_token", "ERROR: failed to store device authorization"}, log.KV{"error", err.Error()})
+			return nil, auth.InternalError("Internal server error")
+		}
+		return nil, auth.AuthorizationPending("The user has not completed the verification yet")
+	}
+
+	consumed, err := s.store.ConsumeDevice(ctx, entry)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.device_token", "ERROR: failed to consume device authorization"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if !consumed {
+		// Another poll already got the tokens.
+		return nil, auth.ExpiredToken("The device code expired")
+	}
+
+	res, err = s.issueTokens(ctx, entry.UserID, entry.Login, uuid.New().String(), "")
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.device_token", "ERROR: failed to store token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	log.Info(ctx, log.KV{"auth.device_token", fmt.Sprintf("generated device token for user %s", entry.Login)})
+	return res, nil
+}
+
+// publicURL returns the URL users reach auth at through the gateway.
+func publicURL() string {
+	if u := os.Getenv("AUTH_PUBLIC_URL"); u != "" {
+		return strings.TrimSuffix(u, "/")
+	}
+	return "http://localhost:9000"
+}
+
+// generateConsent returns a random consent token.
+func generateConsent() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func generateUserCode() (string, error) {
+	code := make([]byte, userCodeLength)
+	max := big.NewInt(int64(len(userCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = userCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// formatUserCode splits the user code in two halves for readability.
+func formatUserCode(code string) string {
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
+
+// normalizeUserCode reverts the formatting the user may have typed.
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
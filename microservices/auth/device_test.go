@@ -0,0 +1,101 @@
+package authapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// deviceAuthorization starts a device authorization with the dev provider.
+func deviceAuthorization(t *testing.T, s *authsrvc) *auth.DeviceAuthorizationResult {
+	t.Helper()
+	res, err := s.DeviceAuthorization(context.Background(), &auth.DeviceAuthorizationPayload{Provider: "dev", ClientID: ptr("chat-cli")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestDeviceVerifyRequiresConsent(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	device := deviceAuthorization(t, s)
+
+	page, err := s.DeviceVerify(ctx, &auth.DeviceVerifyPayload{UserCode: device.UserCode})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The page shows what the user consents to, but starts no login.
+	for _, want := range []string{device.UserCode, "chat-cli", page.Consent} {
+		if !strings.Contains(page.Page, want) {
+			t.Errorf("consent page lacks %q", want)
+		}
+	}
+
+	cases := []struct {
+		name          string
+		consent       string
+		consentCookie string
+	}{
+		{"no cookie", page.Consent, ""},
+		{"other cookie", page.Consent, "other"},
+		{"no consent", "", page.Consent},
+	}
+	for _, c := range cases {
+		_, err := s.DeviceConfirm(ctx, &auth.DeviceConfirmPayload{UserCode: device.UserCode, Consent: c.consent, ConsentCookie: c.consentCookie})
+		if errorName(err) != "invalid_consent" {
+			t.Errorf("%s: err = %v, want invalid_consent", c.name, err)
+		}
+	}
+
+	res, err := s.DeviceConfirm(ctx, &auth.DeviceConfirmPayload{UserCode: device.UserCode, Consent: page.Consent, ConsentCookie: page.Consent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if queryParam(t, res.Location, "state") == "" {
+		t.Errorf("location %s starts no login", res.Location)
+	}
+
+	if _, err := s.DeviceVerify(ctx, &auth.DeviceVerifyPayload{UserCode: "BCDF-GHJK"}); errorName(err) != "invalid_user_code" {
+		t.Errorf("unknown user code: err = %v, want invalid_user_code", err)
+	}
+}
+
+func TestDevicePollKeepsApproval(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	device := deviceAuthorization(t, s)
+
+	// A poll reads the entry before the approval and records itself after.
+	polled, err := s.store.GetDevice(ctx, device.DeviceCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.approveDevice(ctx, device.DeviceCode, "user", "login-user"); err != nil {
+		t.Fatal(err)
+	}
+	polled.LastPolledAt = time.Now().Add(-time.Minute)
+	if err := s.store.PollDevice(ctx, polled); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := s.DeviceToken(ctx, &auth.DeviceTokenPayload{GrantType: "urn:ietf:params:oauth:grant-type:device_code", DeviceCode: device.DeviceCode})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.UserID != "user" {
+		t.Errorf("tokens of user %q, want user", res.UserID)
+	}
+
+	// A device is approved once.
+	if err := s.approveDevice(ctx, device.DeviceCode, "other", "login-other"); errorName(err) != "invalid_state" {
+		t.Errorf("second approval: err = %v, want invalid_state", err)
+	}
+}
@@ -0,0 +1,147 @@
+package authapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"golang.org/x/oauth2"
+)
+
+// devLoginPath is the login form of the development provider.
+const devLoginPath = "/auth/dev/login"
+
+// DevLoginEnabled reports whether AUTH_DEV_LOGIN enables the development
+// provider. It lets anyone log in as any user, so it must never be enabled
+// outside of local development and tests.
+func DevLoginEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("AUTH_DEV_LOGIN"))
+	return enabled
+}
+
+// devProvider is the development identity provider. Its login form lets the
+// user pick any user ID and login, and its authorization code is the chosen
+// identity itself, so that logging in needs no network access.
+type devProvider struct {
+	redirectURL string
+}
+
+func newDevProvider() *devProvider {
+	redirectURL := os.Getenv("DEV_REDIRECT_URL")
+	if redirectURL == "" {
+		redirectURL = publicURL() + "/auth/dev/callback"
+	}
+	return &devProvider{redirectURL: redirectURL}
+}
+
+func (p *devProvider) Name() string {
+	return "dev"
+}
+
+func (p *devProvider) AuthCodeURL(ctx context.Context, state string, opts ...oauth2.AuthCodeOption) (string, error) {
+	return publicURL() + devLoginPath + "?" + url.Values{"state": {state}}.Encode(), nil
+}
+
+func (p *devProvider) Exchange(ctx context.Context, code string, opts ...oauth2.AuthCodeOption) (*oauth2.Token, error) {
+	if _, err := decodeDevCode(code); err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{AccessToken: code, TokenType: "Bearer"}, nil
+}
+
+func (p *devProvider) Identify(ctx context.Context, token *oauth2.Token) (*Identity, error) {
+	return decodeDevCode(token.AccessToken)
+}
+
+func encodeDevCode(identity *Identity) (string, error) {
+	identityJSON, err := json.Marshal(identity)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(identityJSON), nil
+}
+
+func decodeDevCode(code string) (*Identity, error) {
+	identityJSON, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid code: %w", err)
+	}
+
+	var identity Identity
+	if err := json.Unmarshal(identityJSON, &identity); err != nil {
+		return nil, fmt.Errorf("invalid code: %w", err)
+	}
+	if identity.ID == "" || identity.Login == "" {
+		return nil, errors.New("invalid code: missing user ID or login")
+	}
+
+	return &identity, nil
+}
+
+var devLoginForm = template.Must(template.New("dev-login").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Development login</title></head>
+<body>
+<h1>Development login</h1>
+<p>Log in as any user. This provider is for local development only.</p>
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="state" value="{{.State}}">
+<p><label>User ID <input name="id" value="1" required></label></p>
+<p><label>Login <input name="login" value="dev" required></label></p>
+<p><label>Name <input name="name"></label></p>
+<p><label>Email <input name="email" type="email"></label></p>
+<p><button type="submit">Log in</button></p>
+</form>
+</body>
+</html>
+`))
+
+// DevLoginHandler serves the login form of the development provider. The
+// form redirects to the provider callback with the chosen identity as code.
+func DevLoginHandler() http.Handler {
+	provider := newDevProvider()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			devLoginForm.Execute(w, map[string]string{
+				"Action": publicURL() + devLoginPath,
+				"State":  r.URL.Query().Get("state"),
+			})
+		case http.MethodPost:
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, "invalid form", http.StatusBadRequest)
+				return
+			}
+			identity := &Identity{
+				ID:    r.PostForm.Get("id"),
+				Login: r.PostForm.Get("login"),
+				Name:  r.PostForm.Get("name"),
+				Email: r.PostForm.Get("email"),
+			}
+			if identity.ID == "" || identity.Login == "" {
+				http.Error(w, "user ID and login are required", http.StatusBadRequest)
+				return
+			}
+
+			code, err := encodeDevCode(identity)
+			if err != nil {
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			query := url.Values{"code": {code}, "state": {r.PostForm.Get("state")}}
+			http.Redirect(w, r, provider.redirectURL+"?"+query.Encode(), http.StatusFound)
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
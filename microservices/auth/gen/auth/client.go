@@ -15,23 +15,88 @@ import (
 
 // Client is the "auth" service client.
 type Client struct {
-	IntrospectEndpoint    goa.Endpoint
-	AuthURLEndpoint       goa.Endpoint
-	OauthCallbackEndpoint goa.Endpoint
+	IntrospectEndpoint             goa.Endpoint
+	AuthURLEndpoint                goa.Endpoint
+	OauthCallbackEndpoint          goa.Endpoint
+	RefreshEndpoint                goa.Endpoint
+	LogoutEndpoint                 goa.Endpoint
+	RevokeEndpoint                 goa.Endpoint
+	RevokeUserSessionsEndpoint     goa.Endpoint
+	ListSessionsEndpoint           goa.Endpoint
+	DeleteSessionEndpoint          goa.Endpoint
+	LinkIdentityEndpoint           goa.Endpoint
+	ListIdentitiesEndpoint         goa.Endpoint
+	UnlinkIdentityEndpoint         goa.Endpoint
+	CreateWorkspaceEndpoint        goa.Endpoint
+	ListWorkspacesEndpoint         goa.Endpoint
+	AddWorkspaceMemberEndpoint     goa.Endpoint
+	RemoveWorkspaceMemberEndpoint  goa.Endpoint
+	SwitchWorkspaceEndpoint        goa.Endpoint
+	SwitchDefaultWorkspaceEndpoint goa.Endpoint
+	JwksEndpoint                   goa.Endpoint
+	CreateTokenEndpoint            goa.Endpoint
+	ListTokensEndpoint             goa.Endpoint
+	DeleteTokenEndpoint            goa.Endpoint
+	CreateBotEndpoint              goa.Endpoint
+	ListBotsEndpoint               goa.Endpoint
+	DeleteBotEndpoint              goa.Endpoint
+	ClientTokenEndpoint            goa.Endpoint
+	TokenIntrospectEndpoint        goa.Endpoint
+	GetUserRolesEndpoint           goa.Endpoint
+	GrantRoleEndpoint              goa.Endpoint
+	RevokeRoleEndpoint             goa.Endpoint
+	DeviceAuthorizationEndpoint    goa.Endpoint
+	DeviceVerifyEndpoint           goa.Endpoint
+	DeviceConfirmEndpoint          goa.Endpoint
+	DeviceTokenEndpoint            goa.Endpoint
+	ListAuditEventsEndpoint        goa.Endpoint
 }
 
 // NewClient initializes a "auth" service client given the endpoints.
-func NewClient(introspect, authURL, oauthCallback goa.Endpoint) *Client {
+func NewClient(introspect, authURL, oauthCallback, refresh, logout, revoke, revokeUserSessions, listSessions, deleteSession, linkIdentity, listIdentities, unlinkIdentity, createWorkspace, listWorkspaces, addWorkspaceMember, removeWorkspaceMember, switchWorkspace, switchDefaultWorkspace, jwks, createToken, listTokens, deleteToken, createBot, listBots, deleteBot, clientToken, tokenIntrospect, getUserRoles, grantRole, revokeRole, deviceAuthorization, deviceVerify, deviceConfirm, deviceToken, listAuditEvents goa.Endpoint) *Client {
 	return &Client{
-		IntrospectEndpoint:    introspect,
-		AuthURLEndpoint:       authURL,
-		OauthCallbackEndpoint: oauthCallback,
+		IntrospectEndpoint:             introspect,
+		AuthURLEndpoint:                authURL,
+		OauthCallbackEndpoint:          oauthCallback,
+		RefreshEndpoint:                refresh,
+		LogoutEndpoint:                 logout,
+		RevokeEndpoint:                 revoke,
+		RevokeUserSessionsEndpoint:     revokeUserSessions,
+		ListSessionsEndpoint:           listSessions,
+		DeleteSessionEndpoint:          deleteSession,
+		LinkIdentityEndpoint:           linkIdentity,
+		ListIdentitiesEndpoint:         listIdentities,
+		UnlinkIdentityEndpoint:         unlinkIdentity,
+		CreateWorkspaceEndpoint:        createWorkspace,
+		ListWorkspacesEndpoint:         listWorkspaces,
+		AddWorkspaceMemberEndpoint:     addWorkspaceMember,
+		RemoveWorkspaceMemberEndpoint:  removeWorkspaceMember,
+		SwitchWorkspaceEndpoint:        switchWorkspace,
+		SwitchDefaultWorkspaceEndpoint: switchDefaultWorkspace,
+		JwksEndpoint:                   jwks,
+		CreateTokenEndpoint:            createToken,
+		ListTokensEndpoint:             listTokens,
+		DeleteTokenEndpoint:            deleteToken,
+		CreateBotEndpoint:              createBot,
+		ListBotsEndpoint:               listBots,
+		DeleteBotEndpoint:              deleteBot,
+		ClientTokenEndpoint:            clientToken,
+		TokenIntrospectEndpoint:        tokenIntrospect,
+		GetUserRolesEndpoint:           getUserRoles,
+		GrantRoleEndpoint:              grantRole,
+		RevokeRoleEndpoint:             revokeRole,
+		DeviceAuthorizationEndpoint:    deviceAuthorization,
+		DeviceVerifyEndpoint:           deviceVerify,
+		DeviceConfirmEndpoint:          deviceConfirm,
+		DeviceTokenEndpoint:            deviceToken,
+		ListAuditEventsEndpoint:        listAuditEvents,
 	}
 }
 
 // Introspect calls the "introspect" endpoint of the "auth" service.
 // Introspect may return the following errors:
 //   - "invalid_token" (type InvalidToken)
+//   - "too_many_requests" (type TooManyRequests)
 //   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) Introspect(ctx context.Context, p *IntrospectPayload) (res *IntrospectResult, err error) {
@@ -45,11 +110,14 @@ func (c *Client) Introspect(ctx context.Context, p *IntrospectPayload) (res *Int
 
 // AuthURL calls the "auth_url" endpoint of the "auth" service.
 // AuthURL may return the following errors:
+//   - "unknown_provider" (type UnknownProvider)
+//   - "provider_error" (type ProviderError)
+//   - "too_many_requests" (type TooManyRequests)
 //   - "internal_error" (type InternalError)
 //   - error: internal error
-func (c *Client) AuthURL(ctx context.Context) (res *AuthURLResult, err error) {
+func (c *Client) AuthURL(ctx context.Context, p *AuthURLPayload) (res *AuthURLResult, err error) {
 	var ires any
-	ires, err = c.AuthURLEndpoint(ctx, nil)
+	ires, err = c.AuthURLEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
@@ -58,16 +126,462 @@ func (c *Client) AuthURL(ctx context.Context) (res *AuthURLResult, err error) {
 
 // OauthCallback calls the "oauth_callback" endpoint of the "auth" service.
 // OauthCallback may return the following errors:
+//   - "unknown_provider" (type UnknownProvider)
 //   - "invalid_state" (type InvalidState)
 //   - "invalid_code" (type InvalidCode)
-//   - "github_error" (type GithubError)
+//   - "invalid_verifier" (type InvalidVerifier)
+//   - "provider_error" (type ProviderError)
+//   - "identity_conflict" (type IdentityConflict)
+//   - "too_many_requests" (type TooManyRequests)
 //   - "internal_error" (type InternalError)
 //   - error: internal error
-func (c *Client) OauthCallback(ctx context.Context, p *OauthCallbackPayload) (res *OauthCallbackResult, err error) {
+func (c *Client) OauthCallback(ctx context.Context, p *OauthCallbackPayload) (res *TokenResult, err error) {
 	var ires any
 	ires, err = c.OauthCallbackEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.(*OauthCallbackResult), nil
+	return ires.(*TokenResult), nil
+}
+
+// Refresh calls the "refresh" endpoint of the "auth" service.
+// Refresh may return the following errors:
+//   - "invalid_token" (type InvalidToken)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Refresh(ctx context.Context, p *RefreshPayload) (res *TokenResult, err error) {
+	var ires any
+	ires, err = c.RefreshEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TokenResult), nil
+}
+
+// Logout calls the "logout" endpoint of the "auth" service.
+// Logout may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Logout(ctx context.Context, p *LogoutPayload) (err error) {
+	_, err = c.LogoutEndpoint(ctx, p)
+	return
+}
+
+// Revoke calls the "revoke" endpoint of the "auth" service.
+// Revoke may return the following errors:
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Revoke(ctx context.Context, p *RevokePayload) (err error) {
+	_, err = c.RevokeEndpoint(ctx, p)
+	return
+}
+
+// RevokeUserSessions calls the "revoke_user_sessions" endpoint of the "auth"
+// service.
+// RevokeUserSessions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) RevokeUserSessions(ctx context.Context, p *RevokeUserSessionsPayload) (err error) {
+	_, err = c.RevokeUserSessionsEndpoint(ctx, p)
+	return
+}
+
+// ListSessions calls the "list_sessions" endpoint of the "auth" service.
+// ListSessions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ListSessions(ctx context.Context, p *ListSessionsPayload) (res []*Session, err error) {
+	var ires any
+	ires, err = c.ListSessionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Session), nil
+}
+
+// DeleteSession calls the "delete_session" endpoint of the "auth" service.
+// DeleteSession may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeleteSession(ctx context.Context, p *DeleteSessionPayload) (err error) {
+	_, err = c.DeleteSessionEndpoint(ctx, p)
+	return
+}
+
+// LinkIdentity calls the "link_identity" endpoint of the "auth" service.
+// LinkIdentity may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "unknown_provider" (type UnknownProvider)
+//   - "provider_error" (type ProviderError)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) LinkIdentity(ctx context.Context, p *LinkIdentityPayload) (res *LinkIdentityResult, err error) {
+	var ires any
+	ires, err = c.LinkIdentityEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*LinkIdentityResult), nil
+}
+
+// ListIdentities calls the "list_identities" endpoint of the "auth" service.
+// ListIdentities may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ListIdentities(ctx context.Context, p *ListIdentitiesPayload) (res []*LinkedIdentity, err error) {
+	var ires any
+	ires, err = c.ListIdentitiesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*LinkedIdentity), nil
+}
+
+// UnlinkIdentity calls the "unlink_identity" endpoint of the "auth" service.
+// UnlinkIdentity may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "last_identity" (type LastIdentity)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) UnlinkIdentity(ctx context.Context, p *UnlinkIdentityPayload) (err error) {
+	_, err = c.UnlinkIdentityEndpoint(ctx, p)
+	return
+}
+
+// CreateWorkspace calls the "create_workspace" endpoint of the "auth" service.
+// CreateWorkspace may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) CreateWorkspace(ctx context.Context, p *CreateWorkspacePayload) (res *Workspace, err error) {
+	var ires any
+	ires, err = c.CreateWorkspaceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Workspace), nil
+}
+
+// ListWorkspaces calls the "list_workspaces" endpoint of the "auth" service.
+// ListWorkspaces may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ListWorkspaces(ctx context.Context, p *ListWorkspacesPayload) (res []*Workspace, err error) {
+	var ires any
+	ires, err = c.ListWorkspacesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Workspace), nil
+}
+
+// AddWorkspaceMember calls the "add_workspace_member" endpoint of the "auth"
+// service.
+// AddWorkspaceMember may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) AddWorkspaceMember(ctx context.Context, p *AddWorkspaceMemberPayload) (err error) {
+	_, err = c.AddWorkspaceMemberEndpoint(ctx, p)
+	return
+}
+
+// RemoveWorkspaceMember calls the "remove_workspace_member" endpoint of the
+// "auth" service.
+// RemoveWorkspaceMember may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) RemoveWorkspaceMember(ctx context.Context, p *RemoveWorkspaceMemberPayload) (err error) {
+	_, err = c.RemoveWorkspaceMemberEndpoint(ctx, p)
+	return
+}
+
+// SwitchWorkspace calls the "switch_workspace" endpoint of the "auth" service.
+// SwitchWorkspace may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) SwitchWorkspace(ctx context.Context, p *SwitchWorkspacePayload) (res *TokenResult, err error) {
+	var ires any
+	ires, err = c.SwitchWorkspaceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TokenResult), nil
+}
+
+// SwitchDefaultWorkspace calls the "switch_default_workspace" endpoint of the
+// "auth" service.
+// SwitchDefaultWorkspace may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) SwitchDefaultWorkspace(ctx context.Context, p *SwitchDefaultWorkspacePayload) (res *TokenResult, err error) {
+	var ires any
+	ires, err = c.SwitchDefaultWorkspaceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TokenResult), nil
+}
+
+// Jwks calls the "jwks" endpoint of the "auth" service.
+// Jwks may return the following errors:
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Jwks(ctx context.Context) (res *JwksResult, err error) {
+	var ires any
+	ires, err = c.JwksEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*JwksResult), nil
+}
+
+// CreateToken calls the "create_token" endpoint of the "auth" service.
+// CreateToken may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) CreateToken(ctx context.Context, p *CreateTokenPayload) (res *CreatedToken, err error) {
+	var ires any
+	ires, err = c.CreateTokenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CreatedToken), nil
+}
+
+// ListTokens calls the "list_tokens" endpoint of the "auth" service.
+// ListTokens may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ListTokens(ctx context.Context, p *ListTokensPayload) (res []*PersonalAccessToken, err error) {
+	var ires any
+	ires, err = c.ListTokensEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*PersonalAccessToken), nil
+}
+
+// DeleteToken calls the "delete_token" endpoint of the "auth" service.
+// DeleteToken may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeleteToken(ctx context.Context, p *DeleteTokenPayload) (err error) {
+	_, err = c.DeleteTokenEndpoint(ctx, p)
+	return
+}
+
+// CreateBot calls the "create_bot" endpoint of the "auth" service.
+// CreateBot may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) CreateBot(ctx context.Context, p *CreateBotPayload) (res *CreatedBot, err error) {
+	var ires any
+	ires, err = c.CreateBotEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CreatedBot), nil
+}
+
+// ListBots calls the "list_bots" endpoint of the "auth" service.
+// ListBots may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ListBots(ctx context.Context, p *ListBotsPayload) (res []*Bot, err error) {
+	var ires any
+	ires, err = c.ListBotsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Bot), nil
+}
+
+// DeleteBot calls the "delete_bot" endpoint of the "auth" service.
+// DeleteBot may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeleteBot(ctx context.Context, p *DeleteBotPayload) (err error) {
+	_, err = c.DeleteBotEndpoint(ctx, p)
+	return
+}
+
+// ClientToken calls the "client_token" endpoint of the "auth" service.
+// ClientToken may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "invalid_scope" (type InvalidScope)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ClientToken(ctx context.Context, p *ClientTokenPayload) (res *ClientTokenResult, err error) {
+	var ires any
+	ires, err = c.ClientTokenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ClientTokenResult), nil
+}
+
+// TokenIntrospect calls the "token_introspect" endpoint of the "auth" service.
+// TokenIntrospect may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) TokenIntrospect(ctx context.Context, p *TokenIntrospectPayload) (res *TokenIntrospection, err error) {
+	var ires any
+	ires, err = c.TokenIntrospectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TokenIntrospection), nil
+}
+
+// GetUserRoles calls the "get_user_roles" endpoint of the "auth" service.
+// GetUserRoles may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GetUserRoles(ctx context.Context, p *GetUserRolesPayload) (res *UserRoles, err error) {
+	var ires any
+	ires, err = c.GetUserRolesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserRoles), nil
+}
+
+// GrantRole calls the "grant_role" endpoint of the "auth" service.
+// GrantRole may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GrantRole(ctx context.Context, p *GrantRolePayload) (res *UserRoles, err error) {
+	var ires any
+	ires, err = c.GrantRoleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserRoles), nil
+}
+
+// RevokeRole calls the "revoke_role" endpoint of the "auth" service.
+// RevokeRole may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) RevokeRole(ctx context.Context, p *RevokeRolePayload) (res *UserRoles, err error) {
+	var ires any
+	ires, err = c.RevokeRoleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserRoles), nil
+}
+
+// DeviceAuthorization calls the "device_authorization" endpoint of the "auth"
+// service.
+// DeviceAuthorization may return the following errors:
+//   - "unknown_provider" (type UnknownProvider)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeviceAuthorization(ctx context.Context, p *DeviceAuthorizationPayload) (res *DeviceAuthorizationResult, err error) {
+	var ires any
+	ires, err = c.DeviceAuthorizationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeviceAuthorizationResult), nil
+}
+
+// DeviceVerify calls the "device_verify" endpoint of the "auth" service.
+// DeviceVerify may return the following errors:
+//   - "invalid_user_code" (type InvalidUserCode)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeviceVerify(ctx context.Context, p *DeviceVerifyPayload) (res *DeviceVerifyResult, err error) {
+	var ires any
+	ires, err = c.DeviceVerifyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeviceVerifyResult), nil
+}
+
+// DeviceConfirm calls the "device_confirm" endpoint of the "auth" service.
+// DeviceConfirm may return the following errors:
+//   - "invalid_user_code" (type InvalidUserCode)
+//   - "invalid_consent" (type InvalidConsent)
+//   - "unknown_provider" (type UnknownProvider)
+//   - "provider_error" (type ProviderError)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeviceConfirm(ctx context.Context, p *DeviceConfirmPayload) (res *DeviceConfirmResult, err error) {
+	var ires any
+	ires, err = c.DeviceConfirmEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeviceConfirmResult), nil
+}
+
+// DeviceToken calls the "device_token" endpoint of the "auth" service.
+// DeviceToken may return the following errors:
+//   - "authorization_pending" (type AuthorizationPending)
+//   - "slow_down" (type SlowDown)
+//   - "expired_token" (type ExpiredToken)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeviceToken(ctx context.Context, p *DeviceTokenPayload) (res *TokenResult, err error) {
+	var ires any
+	ires, err = c.DeviceTokenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TokenResult), nil
+}
+
+// ListAuditEvents calls the "list_audit_events" endpoint of the "auth" service.
+// ListAuditEvents may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "forbidden" (type Forbidden)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ListAuditEvents(ctx context.Context, p *ListAuditEventsPayload) (res []*AuditEvent, err error) {
+	var ires any
+	ires, err = c.ListAuditEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*AuditEvent), nil
 }
@@ -11,21 +11,88 @@ import (
 	"context"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Endpoints wraps the "auth" service endpoints.
 type Endpoints struct {
-	Introspect    goa.Endpoint
-	AuthURL       goa.Endpoint
-	OauthCallback goa.Endpoint
+	Introspect             goa.Endpoint
+	AuthURL                goa.Endpoint
+	OauthCallback          goa.Endpoint
+	Refresh                goa.Endpoint
+	Logout                 goa.Endpoint
+	Revoke                 goa.Endpoint
+	RevokeUserSessions     goa.Endpoint
+	ListSessions           goa.Endpoint
+	DeleteSession          goa.Endpoint
+	LinkIdentity           goa.Endpoint
+	ListIdentities         goa.Endpoint
+	UnlinkIdentity         goa.Endpoint
+	CreateWorkspace        goa.Endpoint
+	ListWorkspaces         goa.Endpoint
+	AddWorkspaceMember     goa.Endpoint
+	RemoveWorkspaceMember  goa.Endpoint
+	SwitchWorkspace        goa.Endpoint
+	SwitchDefaultWorkspace goa.Endpoint
+	Jwks                   goa.Endpoint
+	CreateToken            goa.Endpoint
+	ListTokens             goa.Endpoint
+	DeleteToken            goa.Endpoint
+	CreateBot              goa.Endpoint
+	ListBots               goa.Endpoint
+	DeleteBot              goa.Endpoint
+	ClientToken            goa.Endpoint
+	TokenIntrospect        goa.Endpoint
+	GetUserRoles           goa.Endpoint
+	GrantRole              goa.Endpoint
+	RevokeRole             goa.Endpoint
+	DeviceAuthorization    goa.Endpoint
+	DeviceVerify           goa.Endpoint
+	DeviceConfirm          goa.Endpoint
+	DeviceToken            goa.Endpoint
+	ListAuditEvents        goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "auth" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
 	return &Endpoints{
-		Introspect:    NewIntrospectEndpoint(s),
-		AuthURL:       NewAuthURLEndpoint(s),
-		OauthCallback: NewOauthCallbackEndpoint(s),
+		Introspect:             NewIntrospectEndpoint(s),
+		AuthURL:                NewAuthURLEndpoint(s),
+		OauthCallback:          NewOauthCallbackEndpoint(s),
+		Refresh:                NewRefreshEndpoint(s),
+		Logout:                 NewLogoutEndpoint(s, a.OAuth2Auth),
+		Revoke:                 NewRevokeEndpoint(s),
+		RevokeUserSessions:     NewRevokeUserSessionsEndpoint(s, a.OAuth2Auth),
+		ListSessions:           NewListSessionsEndpoint(s, a.OAuth2Auth),
+		DeleteSession:          NewDeleteSessionEndpoint(s, a.OAuth2Auth),
+		LinkIdentity:           NewLinkIdentityEndpoint(s, a.OAuth2Auth),
+		ListIdentities:         NewListIdentitiesEndpoint(s, a.OAuth2Auth),
+		UnlinkIdentity:         NewUnlinkIdentityEndpoint(s, a.OAuth2Auth),
+		CreateWorkspace:        NewCreateWorkspaceEndpoint(s, a.OAuth2Auth),
+		ListWorkspaces:         NewListWorkspacesEndpoint(s, a.OAuth2Auth),
+		AddWorkspaceMember:     NewAddWorkspaceMemberEndpoint(s, a.OAuth2Auth),
+		RemoveWorkspaceMember:  NewRemoveWorkspaceMemberEndpoint(s, a.OAuth2Auth),
+		SwitchWorkspace:        NewSwitchWorkspaceEndpoint(s, a.OAuth2Auth),
+		SwitchDefaultWorkspace: NewSwitchDefaultWorkspaceEndpoint(s, a.OAuth2Auth),
+		Jwks:                   NewJwksEndpoint(s),
+		CreateToken:            NewCreateTokenEndpoint(s, a.OAuth2Auth),
+		ListTokens:             NewListTokensEndpoint(s, a.OAuth2Auth),
+		DeleteToken:            NewDeleteTokenEndpoint(s, a.OAuth2Auth),
+		CreateBot:              NewCreateBotEndpoint(s, a.OAuth2Auth),
+		ListBots:               NewListBotsEndpoint(s, a.OAuth2Auth),
+		DeleteBot:              NewDeleteBotEndpoint(s, a.OAuth2Auth),
+		ClientToken:            NewClientTokenEndpoint(s, a.BasicAuth),
+		TokenIntrospect:        NewTokenIntrospectEndpoint(s, a.BasicAuth),
+		GetUserRoles:           NewGetUserRolesEndpoint(s, a.OAuth2Auth),
+		GrantRole:              NewGrantRoleEndpoint(s, a.OAuth2Auth),
+		RevokeRole:             NewRevokeRoleEndpoint(s, a.OAuth2Auth),
+		DeviceAuthorization:    NewDeviceAuthorizationEndpoint(s),
+		DeviceVerify:           NewDeviceVerifyEndpoint(s),
+		DeviceConfirm:          NewDeviceConfirmEndpoint(s),
+		DeviceToken:            NewDeviceTokenEndpoint(s),
+		ListAuditEvents:        NewListAuditEventsEndpoint(s, a.OAuth2Auth),
 	}
 }
 
@@ -34,6 +101,38 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Introspect = m(e.Introspect)
 	e.AuthURL = m(e.AuthURL)
 	e.OauthCallback = m(e.OauthCallback)
+	e.Refresh = m(e.Refresh)
+	e.Logout = m(e.Logout)
+	e.Revoke = m(e.Revoke)
+	e.RevokeUserSessions = m(e.RevokeUserSessions)
+	e.ListSessions = m(e.ListSessions)
+	e.DeleteSession = m(e.DeleteSession)
+	e.LinkIdentity = m(e.LinkIdentity)
+	e.ListIdentities = m(e.ListIdentities)
+	e.UnlinkIdentity = m(e.UnlinkIdentity)
+	e.CreateWorkspace = m(e.CreateWorkspace)
+	e.ListWorkspaces = m(e.ListWorkspaces)
+	e.AddWorkspaceMember = m(e.AddWorkspaceMember)
+	e.RemoveWorkspaceMember = m(e.RemoveWorkspaceMember)
+	e.SwitchWorkspace = m(e.SwitchWorkspace)
+	e.SwitchDefaultWorkspace = m(e.SwitchDefaultWorkspace)
+	e.Jwks = m(e.Jwks)
+	e.CreateToken = m(e.CreateToken)
+	e.ListTokens = m(e.ListTokens)
+	e.DeleteToken = m(e.DeleteToken)
+	e.CreateBot = m(e.CreateBot)
+	e.ListBots = m(e.ListBots)
+	e.DeleteBot = m(e.DeleteBot)
+	e.ClientToken = m(e.ClientToken)
+	e.TokenIntrospect = m(e.TokenIntrospect)
+	e.GetUserRoles = m(e.GetUserRoles)
+	e.GrantRole = m(e.GrantRole)
+	e.RevokeRole = m(e.RevokeRole)
+	e.DeviceAuthorization = m(e.DeviceAuthorization)
+	e.DeviceVerify = m(e.DeviceVerify)
+	e.DeviceConfirm = m(e.DeviceConfirm)
+	e.DeviceToken = m(e.DeviceToken)
+	e.ListAuditEvents = m(e.ListAuditEvents)
 }
 
 // NewIntrospectEndpoint returns an endpoint function that calls the method
@@ -49,7 +148,8 @@ func NewIntrospectEndpoint(s Service) goa.Endpoint {
 // "auth_url" of service "auth".
 func NewAuthURLEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		return s.AuthURL(ctx)
+		p := req.(*AuthURLPayload)
+		return s.AuthURL(ctx, p)
 	}
 }
 
@@ -61,3 +161,724 @@ func NewOauthCallbackEndpoint(s Service) goa.Endpoint {
 		return s.OauthCallback(ctx, p)
 	}
 }
+
+// NewRefreshEndpoint returns an endpoint function that calls the method
+// "refresh" of service "auth".
+func NewRefreshEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RefreshPayload)
+		return s.Refresh(ctx, p)
+	}
+}
+
+// NewLogoutEndpoint returns an endpoint function that calls the method
+// "logout" of service "auth".
+func NewLogoutEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*LogoutPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Logout(ctx, p)
+	}
+}
+
+// NewRevokeEndpoint returns an endpoint function that calls the method
+// "revoke" of service "auth".
+func NewRevokeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokePayload)
+		return nil, s.Revoke(ctx, p)
+	}
+}
+
+// NewRevokeUserSessionsEndpoint returns an endpoint function that calls the
+// method "revoke_user_sessions" of service "auth".
+func NewRevokeUserSessionsEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeUserSessionsPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RevokeUserSessions(ctx, p)
+	}
+}
+
+// NewListSessionsEndpoint returns an endpoint function that calls the method
+// "list_sessions" of service "auth".
+func NewListSessionsEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListSessionsPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListSessions(ctx, p)
+	}
+}
+
+// NewDeleteSessionEndpoint returns an endpoint function that calls the method
+// "delete_session" of service "auth".
+func NewDeleteSessionEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteSessionPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteSession(ctx, p)
+	}
+}
+
+// NewLinkIdentityEndpoint returns an endpoint function that calls the method
+// "link_identity" of service "auth".
+func NewLinkIdentityEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*LinkIdentityPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.LinkIdentity(ctx, p)
+	}
+}
+
+// NewListIdentitiesEndpoint returns an endpoint function that calls the method
+// "list_identities" of service "auth".
+func NewListIdentitiesEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListIdentitiesPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListIdentities(ctx, p)
+	}
+}
+
+// NewUnlinkIdentityEndpoint returns an endpoint function that calls the method
+// "unlink_identity" of service "auth".
+func NewUnlinkIdentityEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UnlinkIdentityPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.UnlinkIdentity(ctx, p)
+	}
+}
+
+// NewCreateWorkspaceEndpoint returns an endpoint function that calls the
+// method "create_workspace" of service "auth".
+func NewCreateWorkspaceEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateWorkspacePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateWorkspace(ctx, p)
+	}
+}
+
+// NewListWorkspacesEndpoint returns an endpoint function that calls the method
+// "list_workspaces" of service "auth".
+func NewListWorkspacesEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWorkspacesPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWorkspaces(ctx, p)
+	}
+}
+
+// NewAddWorkspaceMemberEndpoint returns an endpoint function that calls the
+// method "add_workspace_member" of service "auth".
+func NewAddWorkspaceMemberEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddWorkspaceMemberPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.AddWorkspaceMember(ctx, p)
+	}
+}
+
+// NewRemoveWorkspaceMemberEndpoint returns an endpoint function that calls the
+// method "remove_workspace_member" of service "auth".
+func NewRemoveWorkspaceMemberEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RemoveWorkspaceMemberPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RemoveWorkspaceMember(ctx, p)
+	}
+}
+
+// NewSwitchWorkspaceEndpoint returns an endpoint function that calls the
+// method "switch_workspace" of service "auth".
+func NewSwitchWorkspaceEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SwitchWorkspacePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.SwitchWorkspace(ctx, p)
+	}
+}
+
+// NewSwitchDefaultWorkspaceEndpoint returns an endpoint function that calls
+// the method "switch_default_workspace" of service "auth".
+func NewSwitchDefaultWorkspaceEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SwitchDefaultWorkspacePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.SwitchDefaultWorkspace(ctx, p)
+	}
+}
+
+// NewJwksEndpoint returns an endpoint function that calls the method "jwks" of
+// service "auth".
+func NewJwksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.Jwks(ctx)
+	}
+}
+
+// NewCreateTokenEndpoint returns an endpoint function that calls the method
+// "create_token" of service "auth".
+func NewCreateTokenEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateTokenPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateToken(ctx, p)
+	}
+}
+
+// NewListTokensEndpoint returns an endpoint function that calls the method
+// "list_tokens" of service "auth".
+func NewListTokensEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListTokensPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListTokens(ctx, p)
+	}
+}
+
+// NewDeleteTokenEndpoint returns an endpoint function that calls the method
+// "delete_token" of service "auth".
+func NewDeleteTokenEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteTokenPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteToken(ctx, p)
+	}
+}
+
+// NewCreateBotEndpoint returns an endpoint function that calls the method
+// "create_bot" of service "auth".
+func NewCreateBotEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateBotPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateBot(ctx, p)
+	}
+}
+
+// NewListBotsEndpoint returns an endpoint function that calls the method
+// "list_bots" of service "auth".
+func NewListBotsEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListBotsPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListBots(ctx, p)
+	}
+}
+
+// NewDeleteBotEndpoint returns an endpoint function that calls the method
+// "delete_bot" of service "auth".
+func NewDeleteBotEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteBotPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteBot(ctx, p)
+	}
+}
+
+// NewClientTokenEndpoint returns an endpoint function that calls the method
+// "client_token" of service "auth".
+func NewClientTokenEndpoint(s Service, authBasicFn security.AuthBasicFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ClientTokenPayload)
+		var err error
+		sc := security.BasicScheme{
+			Name:           "client",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authBasicFn(ctx, p.ClientID, p.ClientSecret, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ClientToken(ctx, p)
+	}
+}
+
+// NewTokenIntrospectEndpoint returns an endpoint function that calls the
+// method "token_introspect" of service "auth".
+func NewTokenIntrospectEndpoint(s Service, authBasicFn security.AuthBasicFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TokenIntrospectPayload)
+		var err error
+		sc := security.BasicScheme{
+			Name:           "client",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authBasicFn(ctx, p.ClientID, p.ClientSecret, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.TokenIntrospect(ctx, p)
+	}
+}
+
+// NewGetUserRolesEndpoint returns an endpoint function that calls the method
+// "get_user_roles" of service "auth".
+func NewGetUserRolesEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetUserRolesPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetUserRoles(ctx, p)
+	}
+}
+
+// NewGrantRoleEndpoint returns an endpoint function that calls the method
+// "grant_role" of service "auth".
+func NewGrantRoleEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GrantRolePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GrantRole(ctx, p)
+	}
+}
+
+// NewRevokeRoleEndpoint returns an endpoint function that calls the method
+// "revoke_role" of service "auth".
+func NewRevokeRoleEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeRolePayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RevokeRole(ctx, p)
+	}
+}
+
+// NewDeviceAuthorizationEndpoint returns an endpoint function that calls the
+// method "device_authorization" of service "auth".
+func NewDeviceAuthorizationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeviceAuthorizationPayload)
+		return s.DeviceAuthorization(ctx, p)
+	}
+}
+
+// NewDeviceVerifyEndpoint returns an endpoint function that calls the method
+// "device_verify" of service "auth".
+func NewDeviceVerifyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeviceVerifyPayload)
+		return s.DeviceVerify(ctx, p)
+	}
+}
+
+// NewDeviceConfirmEndpoint returns an endpoint function that calls the method
+// "device_confirm" of service "auth".
+func NewDeviceConfirmEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeviceConfirmPayload)
+		return s.DeviceConfirm(ctx, p)
+	}
+}
+
+// NewDeviceTokenEndpoint returns an endpoint function that calls the method
+// "device_token" of service "auth".
+func NewDeviceTokenEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeviceTokenPayload)
+		return s.DeviceToken(ctx, p)
+	}
+}
+
+// NewListAuditEventsEndpoint returns an endpoint function that calls the
+// method "list_audit_events" of service "auth".
+func NewListAuditEventsEndpoint(s Service, authOAuth2Fn security.AuthOAuth2Func) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAuditEventsPayload)
+		var err error
+		sc := security.OAuth2Scheme{
+			Name:           "oauth2",
+			Scopes:         []string{"api:read", "api:write", "api:moderate", "api:admin"},
+			RequiredScopes: []string{"api:admin"},
+			Flows: []*security.OAuthFlow{
+				&security.OAuthFlow{
+					Type:             "authorization_code",
+					AuthorizationURL: "/auth/{provider}",
+					TokenURL:         "/auth/{provider}/callback",
+					RefreshURL:       "/auth/token/refresh",
+				},
+			},
+		}
+		ctx, err = authOAuth2Fn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListAuditEvents(ctx, p)
+	}
+}
@@ -9,6 +9,8 @@ package auth
 
 import (
 	"context"
+
+	"goa.design/goa/v3/security"
 )
 
 // Authentication service that converts opaque tokens to internal JWT tokens
@@ -16,10 +18,90 @@ import (
 type Service interface {
 	// Introspect opaque token and return internal JWT token for Kong Gateway
 	Introspect(context.Context, *IntrospectPayload) (res *IntrospectResult, err error)
-	// Get GitHub OAuth authorization URL with state parameter
-	AuthURL(context.Context) (res *AuthURLResult, err error)
-	// Handle GitHub OAuth callback and return opaque token
-	OauthCallback(context.Context, *OauthCallbackPayload) (res *OauthCallbackResult, err error)
+	// Get the OAuth authorization URL of an identity provider with state parameter
+	AuthURL(context.Context, *AuthURLPayload) (res *AuthURLResult, err error)
+	// Handle the OAuth callback of an identity provider and return opaque token
+	OauthCallback(context.Context, *OauthCallbackPayload) (res *TokenResult, err error)
+	// Exchange a refresh token for a new access token and refresh token
+	Refresh(context.Context, *RefreshPayload) (res *TokenResult, err error)
+	// Revoke the access token of the request and its refresh token
+	Logout(context.Context, *LogoutPayload) (err error)
+	// Revoke an access token or a refresh token (RFC 7009)
+	Revoke(context.Context, *RevokePayload) (err error)
+	// Revoke every token of a user
+	RevokeUserSessions(context.Context, *RevokeUserSessionsPayload) (err error)
+	// List the active sessions of the current user
+	ListSessions(context.Context, *ListSessionsPayload) (res []*Session, err error)
+	// Terminate a session of the current user
+	DeleteSession(context.Context, *DeleteSessionPayload) (err error)
+	// Get the OAuth authorization URL linking an identity of the provider to the
+	// current user
+	LinkIdentity(context.Context, *LinkIdentityPayload) (res *LinkIdentityResult, err error)
+	// List the identities linked to the current user
+	ListIdentities(context.Context, *ListIdentitiesPayload) (res []*LinkedIdentity, err error)
+	// Unlink the identity of the provider from the current user
+	UnlinkIdentity(context.Context, *UnlinkIdentityPayload) (err error)
+	// Create a workspace with the current user as its first member
+	CreateWorkspace(context.Context, *CreateWorkspacePayload) (res *Workspace, err error)
+	// List the workspaces of the current user
+	ListWorkspaces(context.Context, *ListWorkspacesPayload) (res []*Workspace, err error)
+	// Add a user to a workspace created by the current user
+	AddWorkspaceMember(context.Context, *AddWorkspaceMemberPayload) (err error)
+	// Remove a user from a workspace, either by its creator or by the user leaving
+	// it
+	RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberPayload) (err error)
+	// Issue tokens of the current session acting in a workspace of the current
+	// user, revoking the presented ones
+	SwitchWorkspace(context.Context, *SwitchWorkspacePayload) (res *TokenResult, err error)
+	// Issue tokens of the current session acting in the default workspace,
+	// revoking the presented ones
+	SwitchDefaultWorkspace(context.Context, *SwitchDefaultWorkspacePayload) (res *TokenResult, err error)
+	// Public keys verifying the internal JWT tokens
+	Jwks(context.Context) (res *JwksResult, err error)
+	// Create a personal access token for the current user
+	CreateToken(context.Context, *CreateTokenPayload) (res *CreatedToken, err error)
+	// List the personal access tokens of the current user
+	ListTokens(context.Context, *ListTokensPayload) (res []*PersonalAccessToken, err error)
+	// Revoke a personal access token of the current user
+	DeleteToken(context.Context, *DeleteTokenPayload) (err error)
+	// Create a bot identity with an API key
+	CreateBot(context.Context, *CreateBotPayload) (res *CreatedBot, err error)
+	// List the bot identities
+	ListBots(context.Context, *ListBotsPayload) (res []*Bot, err error)
+	// Delete a bot identity and revoke its API key
+	DeleteBot(context.Context, *DeleteBotPayload) (err error)
+	// Exchange the credentials of a service client for a short lived service JWT
+	// (client credentials grant)
+	ClientToken(context.Context, *ClientTokenPayload) (res *ClientTokenResult, err error)
+	// Introspect an access token, refresh token or personal access token as per
+	// RFC 7662, for resource servers authenticated with their client credentials
+	TokenIntrospect(context.Context, *TokenIntrospectPayload) (res *TokenIntrospection, err error)
+	// Get the roles of a user
+	GetUserRoles(context.Context, *GetUserRolesPayload) (res *UserRoles, err error)
+	// Grant a role to a user, it applies to the tokens issued from then on
+	GrantRole(context.Context, *GrantRolePayload) (res *UserRoles, err error)
+	// Revoke a role of a user, it applies to the tokens issued from then on
+	RevokeRole(context.Context, *RevokeRolePayload) (res *UserRoles, err error)
+	// Start a device authorization (RFC 8628) for a client without a browser
+	DeviceAuthorization(context.Context, *DeviceAuthorizationPayload) (res *DeviceAuthorizationResult, err error)
+	// Consent page of a device authorization, showing the user code and the
+	// requesting client
+	DeviceVerify(context.Context, *DeviceVerifyPayload) (res *DeviceVerifyResult, err error)
+	// Confirm a device authorization from its consent page, redirects the user to
+	// the login page of the identity provider
+	DeviceConfirm(context.Context, *DeviceConfirmPayload) (res *DeviceConfirmResult, err error)
+	// Poll for the tokens of a device authorization
+	DeviceToken(context.Context, *DeviceTokenPayload) (res *TokenResult, err error)
+	// List the audit events, newest first
+	ListAuditEvents(context.Context, *ListAuditEventsPayload) (res []*AuditEvent, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// OAuth2Auth implements the authorization logic for the OAuth2 security scheme.
+	OAuth2Auth(ctx context.Context, token string, schema *security.OAuth2Scheme) (context.Context, error)
+	// BasicAuth implements the authorization logic for the Basic security scheme.
+	BasicAuth(ctx context.Context, user, pass string, schema *security.BasicScheme) (context.Context, error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -36,16 +118,276 @@ const ServiceName = "auth"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"introspect", "auth_url", "oauth_callback"}
+var MethodNames = [35]string{"introspect", "auth_url", "oauth_callback", "refresh", "logout", "revoke", "revoke_user_sessions", "list_sessions", "delete_session", "link_identity", "list_identities", "unlink_identity", "create_workspace", "list_workspaces", "add_workspace_member", "remove_workspace_member", "switch_workspace", "switch_default_workspace", "jwks", "create_token", "list_tokens", "delete_token", "create_bot", "list_bots", "delete_bot", "client_token", "token_introspect", "get_user_roles", "grant_role", "revoke_role", "device_authorization", "device_verify", "device_confirm", "device_token", "list_audit_events"}
+
+// AddWorkspaceMemberPayload is the payload type of the auth service
+// add_workspace_member method.
+type AddWorkspaceMemberPayload struct {
+	// Opaque access token
+	Token string
+	// Workspace ID
+	WorkspaceID string
+	// User to add
+	UserID string
+}
+
+// Security relevant event of the auth service
+type AuditEvent struct {
+	// Event ID
+	ID string
+	// Event type
+	Type string
+	// User the event is about
+	UserID *string
+	// User who performed the action, if not the user itself
+	ActorID *string
+	// IP address of the request
+	IP *string
+	// Identity provider
+	Provider *string
+	// Reason of a failure or detail of the event
+	Reason *string
+	// Event timestamp
+	CreatedAt int64
+}
+
+// AuthURLPayload is the payload type of the auth service auth_url method.
+type AuthURLPayload struct {
+	// Identity provider (github, gitlab, google, oidc)
+	Provider string
+	// PKCE code challenge of the client (RFC 7636)
+	CodeChallenge *string
+	// PKCE code challenge method
+	CodeChallengeMethod string
+}
 
 // AuthURLResult is the result type of the auth service auth_url method.
 type AuthURLResult struct {
-	// GitHub OAuth authorization URL
+	// OAuth authorization URL of the identity provider
 	AuthURL string
 	// OAuth state parameter for CSRF protection
 	State string
 }
 
+// Bot identity authenticating with an API key
+type Bot struct {
+	// Bot ID
+	ID string
+	// Bot name
+	Name string
+	// User ID of the bot in the other services
+	UserID string
+	// Scopes granted to the API key
+	Scopes []string
+	// Creation timestamp
+	CreatedAt int64
+}
+
+// ClientTokenPayload is the payload type of the auth service client_token
+// method.
+type ClientTokenPayload struct {
+	// Client ID
+	ClientID string
+	// Client secret
+	ClientSecret string
+	// OAuth grant type
+	GrantType string
+	// Space separated scopes to grant, every scope of the client if unset
+	Scope *string
+}
+
+// ClientTokenResult is the result type of the auth service client_token method.
+type ClientTokenResult struct {
+	// Service JWT
+	AccessToken string
+	// Token type (Bearer)
+	TokenType string
+	// Token expiration in seconds
+	ExpiresIn int64
+	// Space separated granted scopes
+	Scope string
+}
+
+// CreateBotPayload is the payload type of the auth service create_bot method.
+type CreateBotPayload struct {
+	// Opaque access token
+	Token string
+	// Bot name
+	Name string
+	// Scopes to grant to the API key
+	Scopes []string
+}
+
+// CreateTokenPayload is the payload type of the auth service create_token
+// method.
+type CreateTokenPayload struct {
+	// Opaque access token
+	Token string
+	// Token name
+	Name string
+	// Scopes to grant, a subset of the scopes of the user
+	Scopes []string
+	// Token lifetime in seconds, the token never expires if unset
+	ExpiresIn *int64
+}
+
+// CreateWorkspacePayload is the payload type of the auth service
+// create_workspace method.
+type CreateWorkspacePayload struct {
+	// Opaque access token
+	Token string
+	// Workspace name
+	Name string
+}
+
+// CreatedBot is the result type of the auth service create_bot method.
+type CreatedBot struct {
+	// Secret API key to send as bearer token
+	APIKey string
+	// Bot ID
+	ID string
+	// Bot name
+	Name string
+	// User ID of the bot in the other services
+	UserID string
+	// Scopes granted to the API key
+	Scopes []string
+	// Creation timestamp
+	CreatedAt int64
+}
+
+// CreatedToken is the result type of the auth service create_token method.
+type CreatedToken struct {
+	// Secret token to send as bearer token
+	Token string
+	// Token ID
+	ID string
+	// Token name
+	Name string
+	// Granted scopes
+	Scopes []string
+	// Creation timestamp
+	CreatedAt int64
+	// Expiration timestamp, unset if the token never expires
+	ExpiresAt *int64
+	// Workspace the token acts in, absent for the default workspace
+	WorkspaceID *string
+}
+
+// DeleteBotPayload is the payload type of the auth service delete_bot method.
+type DeleteBotPayload struct {
+	// Opaque access token
+	Token string
+	// Bot ID
+	ID string
+}
+
+// DeleteSessionPayload is the payload type of the auth service delete_session
+// method.
+type DeleteSessionPayload struct {
+	// Opaque access token
+	Token string
+	// Session ID
+	ID string
+}
+
+// DeleteTokenPayload is the payload type of the auth service delete_token
+// method.
+type DeleteTokenPayload struct {
+	// Opaque access token
+	Token string
+	// Token ID
+	ID string
+}
+
+// DeviceAuthorizationPayload is the payload type of the auth service
+// device_authorization method.
+type DeviceAuthorizationPayload struct {
+	// Identity provider the user logs in with
+	Provider string
+	// Client requesting the authorization, shown to the user on the consent page
+	ClientID *string
+}
+
+// DeviceAuthorizationResult is the result type of the auth service
+// device_authorization method.
+type DeviceAuthorizationResult struct {
+	// Device verification code the client polls with
+	DeviceCode string
+	// Code the user enters on the verification page
+	UserCode string
+	// Verification page
+	VerificationURI string
+	// Verification page with the user code filled in
+	VerificationURIComplete string
+	// Lifetime of the codes in seconds
+	ExpiresIn int64
+	// Minimum polling interval in seconds
+	Interval int64
+}
+
+// DeviceConfirmPayload is the payload type of the auth service device_confirm
+// method.
+type DeviceConfirmPayload struct {
+	// User code displayed by the device
+	UserCode string
+	// Consent token of the consent page
+	Consent string
+	// Consent token set by the consent page
+	ConsentCookie string
+}
+
+// DeviceConfirmResult is the result type of the auth service device_confirm
+// method.
+type DeviceConfirmResult struct {
+	// Login page of the identity provider
+	Location string
+}
+
+// DeviceTokenPayload is the payload type of the auth service device_token
+// method.
+type DeviceTokenPayload struct {
+	// OAuth grant type
+	GrantType string
+	// Device verification code
+	DeviceCode string
+}
+
+// DeviceVerifyPayload is the payload type of the auth service device_verify
+// method.
+type DeviceVerifyPayload struct {
+	// User code displayed by the device
+	UserCode string
+}
+
+// DeviceVerifyResult is the result type of the auth service device_verify
+// method.
+type DeviceVerifyResult struct {
+	// Consent page
+	Page string
+	// Consent token the confirmation presents back
+	Consent string
+}
+
+// GetUserRolesPayload is the payload type of the auth service get_user_roles
+// method.
+type GetUserRolesPayload struct {
+	// Opaque access token
+	Token string
+	// User ID
+	UserID string
+}
+
+// GrantRolePayload is the payload type of the auth service grant_role method.
+type GrantRolePayload struct {
+	// Opaque access token
+	Token string
+	// User ID
+	UserID string
+	// Role to grant
+	Role string
+}
+
 // IntrospectPayload is the payload type of the auth service introspect method.
 type IntrospectPayload struct {
 	// Opaque token to introspect
@@ -64,30 +406,319 @@ type IntrospectResult struct {
 	Scopes []string
 }
 
+// Public key verifying the internal JWT tokens (RFC 7517)
+type JWK struct {
+	// Key type
+	Kty string
+	// Key ID
+	Kid string
+	// Public key use
+	Use string
+	// Signing algorithm
+	Alg string
+	// RSA modulus
+	N string
+	// RSA public exponent
+	E string
+}
+
+// JwksResult is the result type of the auth service jwks method.
+type JwksResult struct {
+	// Published keys
+	Keys []*JWK
+}
+
+// LinkIdentityPayload is the payload type of the auth service link_identity
+// method.
+type LinkIdentityPayload struct {
+	// Opaque access token
+	Token string
+	// Identity provider (github, gitlab, google, oidc)
+	Provider string
+	// PKCE code challenge of the client (RFC 7636), its verifier is required by
+	// the callback
+	CodeChallenge string
+	// PKCE code challenge method
+	CodeChallengeMethod string
+}
+
+// LinkIdentityResult is the result type of the auth service link_identity
+// method.
+type LinkIdentityResult struct {
+	// OAuth authorization URL of the identity provider
+	AuthURL string
+	// OAuth state parameter for CSRF protection
+	State string
+}
+
+// Account of an identity provider linked to a user
+type LinkedIdentity struct {
+	// Identity provider
+	Provider string
+	// Login of the account at the provider
+	Login string
+	// Email address of the account
+	Email *string
+	// Link timestamp
+	LinkedAt int64
+}
+
+// ListAuditEventsPayload is the payload type of the auth service
+// list_audit_events method.
+type ListAuditEventsPayload struct {
+	// Opaque access token
+	Token string
+	// Only list the events about this user
+	UserID *string
+	// Only list the events from this timestamp
+	Since *int64
+	// Only list the events up to this timestamp
+	Until *int64
+	// Maximum number of events
+	Limit int
+}
+
+// ListBotsPayload is the payload type of the auth service list_bots method.
+type ListBotsPayload struct {
+	// Opaque access token
+	Token string
+}
+
+// ListIdentitiesPayload is the payload type of the auth service
+// list_identities method.
+type ListIdentitiesPayload struct {
+	// Opaque access token
+	Token string
+}
+
+// ListSessionsPayload is the payload type of the auth service list_sessions
+// method.
+type ListSessionsPayload struct {
+	// Opaque access token
+	Token string
+}
+
+// ListTokensPayload is the payload type of the auth service list_tokens method.
+type ListTokensPayload struct {
+	// Opaque access token
+	Token string
+}
+
+// ListWorkspacesPayload is the payload type of the auth service
+// list_workspaces method.
+type ListWorkspacesPayload struct {
+	// Opaque access token
+	Token string
+}
+
+// LogoutPayload is the payload type of the auth service logout method.
+type LogoutPayload struct {
+	// Opaque access token
+	Token string
+}
+
 // OauthCallbackPayload is the payload type of the auth service oauth_callback
 // method.
 type OauthCallbackPayload struct {
-	// Authorization code from GitHub
+	// Identity provider (github, gitlab, google, oidc)
+	Provider string
+	// Authorization code from the identity provider
 	Code string
 	// OAuth state parameter for validation
 	State string
+	// PKCE code verifier, required when auth_url was given a code challenge
+	CodeVerifier *string
+}
+
+// Named long lived token granting a subset of the scopes of its owner
+type PersonalAccessToken struct {
+	// Token ID
+	ID string
+	// Token name
+	Name string
+	// Granted scopes
+	Scopes []string
+	// Creation timestamp
+	CreatedAt int64
+	// Expiration timestamp, unset if the token never expires
+	ExpiresAt *int64
+	// Last use timestamp, unset if the token was never used
+	LastUsedAt *int64
+	// Workspace the token acts in, absent for the default workspace
+	WorkspaceID *string
 }
 
-// OauthCallbackResult is the result type of the auth service oauth_callback
+// RefreshPayload is the payload type of the auth service refresh method.
+type RefreshPayload struct {
+	// Opaque refresh token
+	RefreshToken string
+}
+
+// RemoveWorkspaceMemberPayload is the payload type of the auth service
+// remove_workspace_member method.
+type RemoveWorkspaceMemberPayload struct {
+	// Opaque access token
+	Token string
+	// Workspace ID
+	WorkspaceID string
+	// User to remove
+	UserID string
+}
+
+// RevokePayload is the payload type of the auth service revoke method.
+type RevokePayload struct {
+	// Token to revoke
+	Token string
+	// Type of the token
+	TokenTypeHint *string
+}
+
+// RevokeRolePayload is the payload type of the auth service revoke_role method.
+type RevokeRolePayload struct {
+	// Opaque access token
+	Token string
+	// User ID
+	UserID string
+	// Role to revoke
+	Role string
+}
+
+// RevokeUserSessionsPayload is the payload type of the auth service
+// revoke_user_sessions method.
+type RevokeUserSessionsPayload struct {
+	// Opaque access token
+	Token string
+	// User whose sessions are revoked
+	UserID string
+}
+
+// Login session of a user with the device it was started from
+type Session struct {
+	// Session ID
+	ID string
+	// User agent of the device
+	UserAgent string
+	// IP address of the device
+	IP string
+	// Login timestamp
+	CreatedAt int64
+	// Last use timestamp
+	LastUsedAt int64
+	// Whether the session is the one of the request
+	Current bool
+}
+
+// SwitchDefaultWorkspacePayload is the payload type of the auth service
+// switch_default_workspace method.
+type SwitchDefaultWorkspacePayload struct {
+	// Opaque access token
+	Token string
+}
+
+// SwitchWorkspacePayload is the payload type of the auth service
+// switch_workspace method.
+type SwitchWorkspacePayload struct {
+	// Opaque access token
+	Token string
+	// Workspace ID
+	WorkspaceID string
+}
+
+// TokenIntrospectPayload is the payload type of the auth service
+// token_introspect method.
+type TokenIntrospectPayload struct {
+	// Client ID
+	ClientID string
+	// Client secret
+	ClientSecret string
+	// Token to introspect
+	Token string
+	// Type of the token
+	TokenTypeHint *string
+}
+
+// TokenIntrospection is the result type of the auth service token_introspect
 // method.
-type OauthCallbackResult struct {
+type TokenIntrospection struct {
+	// Whether the token is active
+	Active bool
+	// Space separated scopes of the token
+	Scope *string
+	// Client the token was presented to, which introspects it
+	ClientID *string
+	// Login of the user of the token
+	Username *string
+	// Token type (Bearer)
+	TokenType *string
+	// Token expiration timestamp
+	Exp *int64
+	// Token issuance timestamp
+	Iat *int64
+	// User ID of the token
+	Sub *string
+}
+
+// TokenResult is the result type of the auth service oauth_callback method.
+type TokenResult struct {
 	// Opaque access token
 	AccessToken string
 	// Token type (Bearer)
 	TokenType string
 	// Token expiration in seconds
 	ExpiresIn int64
-	// GitHub user ID
+	// Internal user ID
+	UserID string
+	// Opaque refresh token
+	RefreshToken string
+	// Refresh token expiration in seconds
+	RefreshExpiresIn int64
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string
+}
+
+// UnlinkIdentityPayload is the payload type of the auth service
+// unlink_identity method.
+type UnlinkIdentityPayload struct {
+	// Opaque access token
+	Token string
+	// Identity provider
+	Provider string
+}
+
+// UserRoles is the result type of the auth service get_user_roles method.
+type UserRoles struct {
+	// User ID
 	UserID string
+	// Roles of the user, every user has the user role
+	Roles []string
+	// Scopes granted by the roles
+	Scopes []string
 }
 
-// GitHub API error
-type GithubError string
+// Workspace is the result type of the auth service create_workspace method.
+type Workspace struct {
+	// Workspace ID
+	ID string
+	// Workspace name
+	Name string
+	// User who created the workspace
+	CreatedBy string
+	// Creation timestamp
+	CreatedAt int64
+}
+
+// The user has not completed the verification yet
+type AuthorizationPending string
+
+// The device code expired
+type ExpiredToken string
+
+// Token lacks the required scope
+type Forbidden string
+
+// The identity is linked to another user, or the user already linked one of
+// the provider
+type IdentityConflict string
 
 // Internal server error
 type InternalError string
@@ -95,27 +726,111 @@ type InternalError string
 // Invalid authorization code
 type InvalidCode string
 
+// The confirmation does not come from the consent page
+type InvalidConsent string
+
+// The client may not be granted the requested scopes
+type InvalidScope string
+
 // Invalid or expired state parameter
 type InvalidState string
 
 // Token is invalid or expired
 type InvalidToken string
 
+// User code is invalid or expired
+type InvalidUserCode string
+
+// PKCE code verifier is missing or does not match the code challenge
+type InvalidVerifier string
+
+// The last identity of a user cannot be unlinked
+type LastIdentity string
+
+// Session not found
+type NotFound string
+
+// Identity provider error
+type ProviderError string
+
+// The client polls too often, the interval was increased by 5 seconds
+type SlowDown string
+
+// Rate limit exceeded or client locked out
+type TooManyRequests string
+
+// Token is invalid or expired
+type Unauthorized string
+
+// Identity provider is not configured
+type UnknownProvider string
+
 // Error returns an error description.
-func (e GithubError) Error() string {
-	return "GitHub API error"
+func (e AuthorizationPending) Error() string {
+	return "The user has not completed the verification yet"
 }
 
-// ErrorName returns "github_error".
+// ErrorName returns "authorization_pending".
 //
 // Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
-func (e GithubError) ErrorName() string {
+func (e AuthorizationPending) ErrorName() string {
 	return e.GoaErrorName()
 }
 
-// GoaErrorName returns "github_error".
-func (e GithubError) GoaErrorName() string {
-	return "github_error"
+// GoaErrorName returns "authorization_pending".
+func (e AuthorizationPending) GoaErrorName() string {
+	return "authorization_pending"
+}
+
+// Error returns an error description.
+func (e ExpiredToken) Error() string {
+	return "The device code expired"
+}
+
+// ErrorName returns "expired_token".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e ExpiredToken) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "expired_token".
+func (e ExpiredToken) GoaErrorName() string {
+	return "expired_token"
+}
+
+// Error returns an error description.
+func (e Forbidden) Error() string {
+	return "Token lacks the required scope"
+}
+
+// ErrorName returns "forbidden".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e Forbidden) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "forbidden".
+func (e Forbidden) GoaErrorName() string {
+	return "forbidden"
+}
+
+// Error returns an error description.
+func (e IdentityConflict) Error() string {
+	return "The identity is linked to another user, or the user already linked one of the provider"
+}
+
+// ErrorName returns "identity_conflict".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e IdentityConflict) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "identity_conflict".
+func (e IdentityConflict) GoaErrorName() string {
+	return "identity_conflict"
 }
 
 // Error returns an error description.
@@ -152,6 +867,40 @@ func (e InvalidCode) GoaErrorName() string {
 	return "invalid_code"
 }
 
+// Error returns an error description.
+func (e InvalidConsent) Error() string {
+	return "The confirmation does not come from the consent page"
+}
+
+// ErrorName returns "invalid_consent".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InvalidConsent) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "invalid_consent".
+func (e InvalidConsent) GoaErrorName() string {
+	return "invalid_consent"
+}
+
+// Error returns an error description.
+func (e InvalidScope) Error() string {
+	return "The client may not be granted the requested scopes"
+}
+
+// ErrorName returns "invalid_scope".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InvalidScope) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "invalid_scope".
+func (e InvalidScope) GoaErrorName() string {
+	return "invalid_scope"
+}
+
 // Error returns an error description.
 func (e InvalidState) Error() string {
 	return "Invalid or expired state parameter"
@@ -185,3 +934,156 @@ func (e InvalidToken) ErrorName() string {
 func (e InvalidToken) GoaErrorName() string {
 	return "invalid_token"
 }
+
+// Error returns an error description.
+func (e InvalidUserCode) Error() string {
+	return "User code is invalid or expired"
+}
+
+// ErrorName returns "invalid_user_code".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InvalidUserCode) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "invalid_user_code".
+func (e InvalidUserCode) GoaErrorName() string {
+	return "invalid_user_code"
+}
+
+// Error returns an error description.
+func (e InvalidVerifier) Error() string {
+	return "PKCE code verifier is missing or does not match the code challenge"
+}
+
+// ErrorName returns "invalid_verifier".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InvalidVerifier) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "invalid_verifier".
+func (e InvalidVerifier) GoaErrorName() string {
+	return "invalid_verifier"
+}
+
+// Error returns an error description.
+func (e LastIdentity) Error() string {
+	return "The last identity of a user cannot be unlinked"
+}
+
+// ErrorName returns "last_identity".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e LastIdentity) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "last_identity".
+func (e LastIdentity) GoaErrorName() string {
+	return "last_identity"
+}
+
+// Error returns an error description.
+func (e NotFound) Error() string {
+	return "Session not found"
+}
+
+// ErrorName returns "not_found".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "not_found".
+func (e NotFound) GoaErrorName() string {
+	return "not_found"
+}
+
+// Error returns an error description.
+func (e ProviderError) Error() string {
+	return "Identity provider error"
+}
+
+// ErrorName returns "provider_error".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e ProviderError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "provider_error".
+func (e ProviderError) GoaErrorName() string {
+	return "provider_error"
+}
+
+// Error returns an error description.
+func (e SlowDown) Error() string {
+	return "The client polls too often, the interval was increased by 5 seconds"
+}
+
+// ErrorName returns "slow_down".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e SlowDown) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "slow_down".
+func (e SlowDown) GoaErrorName() string {
+	return "slow_down"
+}
+
+// Error returns an error description.
+func (e TooManyRequests) Error() string {
+	return "Rate limit exceeded or client locked out"
+}
+
+// ErrorName returns "too_many_requests".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e TooManyRequests) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "too_many_requests".
+func (e TooManyRequests) GoaErrorName() string {
+	return "too_many_requests"
+}
+
+// Error returns an error description.
+func (e Unauthorized) Error() string {
+	return "Token is invalid or expired"
+}
+
+// ErrorName returns "unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "unauthorized".
+func (e Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
+
+// Error returns an error description.
+func (e UnknownProvider) Error() string {
+	return "Identity provider is not configured"
+}
+
+// ErrorName returns "unknown_provider".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e UnknownProvider) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "unknown_provider".
+func (e UnknownProvider) GoaErrorName() string {
+	return "unknown_provider"
+}
@@ -10,7 +10,10 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
+	goa "goa.design/goa/v3/pkg"
 	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
 )
 
@@ -22,7 +25,7 @@ func BuildIntrospectPayload(authIntrospectBody string) (*auth.IntrospectPayload,
 	{
 		err = json.Unmarshal([]byte(authIntrospectBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Recusandae non cum perspiciatis error.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Doloremque omnis unde magnam quos magni.\"\n   }'")
 		}
 	}
 	v := &auth.IntrospectPayload{
@@ -32,9 +35,52 @@ func BuildIntrospectPayload(authIntrospectBody string) (*auth.IntrospectPayload,
 	return v, nil
 }
 
+// BuildAuthURLPayload builds the payload for the auth auth_url endpoint from
+// CLI flags.
+func BuildAuthURLPayload(authAuthURLProvider string, authAuthURLCodeChallenge string, authAuthURLCodeChallengeMethod string) (*auth.AuthURLPayload, error) {
+	var err error
+	var provider string
+	{
+		provider = authAuthURLProvider
+	}
+	var codeChallenge *string
+	{
+		if authAuthURLCodeChallenge != "" {
+			codeChallenge = &authAuthURLCodeChallenge
+			err = goa.MergeErrors(err, goa.ValidatePattern("code_challenge", *codeChallenge, "^[A-Za-z0-9_-]{43}$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var codeChallengeMethod string
+	{
+		if authAuthURLCodeChallengeMethod != "" {
+			codeChallengeMethod = authAuthURLCodeChallengeMethod
+			if !(codeChallengeMethod == "S256") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("code_challenge_method", codeChallengeMethod, []any{"S256"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &auth.AuthURLPayload{}
+	v.Provider = provider
+	v.CodeChallenge = codeChallenge
+	v.CodeChallengeMethod = codeChallengeMethod
+
+	return v, nil
+}
+
 // BuildOauthCallbackPayload builds the payload for the auth oauth_callback
 // endpoint from CLI flags.
-func BuildOauthCallbackPayload(authOauthCallbackCode string, authOauthCallbackState string) (*auth.OauthCallbackPayload, error) {
+func BuildOauthCallbackPayload(authOauthCallbackProvider string, authOauthCallbackCode string, authOauthCallbackState string, authOauthCallbackCodeVerifier string) (*auth.OauthCallbackPayload, error) {
+	var err error
+	var provider string
+	{
+		provider = authOauthCallbackProvider
+	}
 	var code string
 	{
 		code = authOauthCallbackCode
@@ -43,9 +89,802 @@ func BuildOauthCallbackPayload(authOauthCallbackCode string, authOauthCallbackSt
 	{
 		state = authOauthCallbackState
 	}
+	var codeVerifier *string
+	{
+		if authOauthCallbackCodeVerifier != "" {
+			codeVerifier = &authOauthCallbackCodeVerifier
+			err = goa.MergeErrors(err, goa.ValidatePattern("code_verifier", *codeVerifier, "^[A-Za-z0-9._~-]{43,128}$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	v := &auth.OauthCallbackPayload{}
+	v.Provider = provider
 	v.Code = code
 	v.State = state
+	v.CodeVerifier = codeVerifier
+
+	return v, nil
+}
+
+// BuildRefreshPayload builds the payload for the auth refresh endpoint from
+// CLI flags.
+func BuildRefreshPayload(authRefreshBody string) (*auth.RefreshPayload, error) {
+	var err error
+	var body RefreshRequestBody
+	{
+		err = json.Unmarshal([]byte(authRefreshBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"refresh_token\": \"Provident sed qui dolore illo eligendi autem.\"\n   }'")
+		}
+	}
+	v := &auth.RefreshPayload{
+		RefreshToken: body.RefreshToken,
+	}
+
+	return v, nil
+}
+
+// BuildLogoutPayload builds the payload for the auth logout endpoint from CLI
+// flags.
+func BuildLogoutPayload(authLogoutToken string) (*auth.LogoutPayload, error) {
+	var token string
+	{
+		token = authLogoutToken
+	}
+	v := &auth.LogoutPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokePayload builds the payload for the auth revoke endpoint from CLI
+// flags.
+func BuildRevokePayload(authRevokeBody string) (*auth.RevokePayload, error) {
+	var err error
+	var body RevokeRequestBody
+	{
+		err = json.Unmarshal([]byte(authRevokeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Modi molestias.\",\n      \"token_type_hint\": \"refresh_token\"\n   }'")
+		}
+		if body.TokenTypeHint != nil {
+			if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &auth.RevokePayload{
+		Token:         body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+
+	return v, nil
+}
+
+// BuildRevokeUserSessionsPayload builds the payload for the auth
+// revoke_user_sessions endpoint from CLI flags.
+func BuildRevokeUserSessionsPayload(authRevokeUserSessionsUserID string, authRevokeUserSessionsToken string) (*auth.RevokeUserSessionsPayload, error) {
+	var userID string
+	{
+		userID = authRevokeUserSessionsUserID
+	}
+	var token string
+	{
+		token = authRevokeUserSessionsToken
+	}
+	v := &auth.RevokeUserSessionsPayload{}
+	v.UserID = userID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListSessionsPayload builds the payload for the auth list_sessions
+// endpoint from CLI flags.
+func BuildListSessionsPayload(authListSessionsToken string) (*auth.ListSessionsPayload, error) {
+	var token string
+	{
+		token = authListSessionsToken
+	}
+	v := &auth.ListSessionsPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteSessionPayload builds the payload for the auth delete_session
+// endpoint from CLI flags.
+func BuildDeleteSessionPayload(authDeleteSessionID string, authDeleteSessionToken string) (*auth.DeleteSessionPayload, error) {
+	var id string
+	{
+		id = authDeleteSessionID
+	}
+	var token string
+	{
+		token = authDeleteSessionToken
+	}
+	v := &auth.DeleteSessionPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildLinkIdentityPayload builds the payload for the auth link_identity
+// endpoint from CLI flags.
+func BuildLinkIdentityPayload(authLinkIdentityBody string, authLinkIdentityProvider string, authLinkIdentityToken string) (*auth.LinkIdentityPayload, error) {
+	var err error
+	var body LinkIdentityRequestBody
+	{
+		err = json.Unmarshal([]byte(authLinkIdentityBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"code_challenge\": \"-qFPlHkRkh09mEn8_ATOw3qnC0QOZMISMkxhuZLU0Kf\",\n      \"code_challenge_method\": \"S256\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.code_challenge", body.CodeChallenge, "^[A-Za-z0-9_-]{43}$"))
+		if !(body.CodeChallengeMethod == "S256") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code_challenge_method", body.CodeChallengeMethod, []any{"S256"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var provider string
+	{
+		provider = authLinkIdentityProvider
+	}
+	var token string
+	{
+		token = authLinkIdentityToken
+	}
+	v := &auth.LinkIdentityPayload{
+		CodeChallenge:       body.CodeChallenge,
+		CodeChallengeMethod: body.CodeChallengeMethod,
+	}
+	{
+		var zero string
+		if v.CodeChallengeMethod == zero {
+			v.CodeChallengeMethod = "S256"
+		}
+	}
+	v.Provider = provider
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListIdentitiesPayload builds the payload for the auth list_identities
+// endpoint from CLI flags.
+func BuildListIdentitiesPayload(authListIdentitiesToken string) (*auth.ListIdentitiesPayload, error) {
+	var token string
+	{
+		token = authListIdentitiesToken
+	}
+	v := &auth.ListIdentitiesPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUnlinkIdentityPayload builds the payload for the auth unlink_identity
+// endpoint from CLI flags.
+func BuildUnlinkIdentityPayload(authUnlinkIdentityProvider string, authUnlinkIdentityToken string) (*auth.UnlinkIdentityPayload, error) {
+	var provider string
+	{
+		provider = authUnlinkIdentityProvider
+	}
+	var token string
+	{
+		token = authUnlinkIdentityToken
+	}
+	v := &auth.UnlinkIdentityPayload{}
+	v.Provider = provider
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateWorkspacePayload builds the payload for the auth create_workspace
+// endpoint from CLI flags.
+func BuildCreateWorkspacePayload(authCreateWorkspaceBody string, authCreateWorkspaceToken string) (*auth.CreateWorkspacePayload, error) {
+	var err error
+	var body CreateWorkspaceRequestBody
+	{
+		err = json.Unmarshal([]byte(authCreateWorkspaceBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"2i\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = authCreateWorkspaceToken
+	}
+	v := &auth.CreateWorkspacePayload{
+		Name: body.Name,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListWorkspacesPayload builds the payload for the auth list_workspaces
+// endpoint from CLI flags.
+func BuildListWorkspacesPayload(authListWorkspacesToken string) (*auth.ListWorkspacesPayload, error) {
+	var token string
+	{
+		token = authListWorkspacesToken
+	}
+	v := &auth.ListWorkspacesPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAddWorkspaceMemberPayload builds the payload for the auth
+// add_workspace_member endpoint from CLI flags.
+func BuildAddWorkspaceMemberPayload(authAddWorkspaceMemberBody string, authAddWorkspaceMemberWorkspaceID string, authAddWorkspaceMemberToken string) (*auth.AddWorkspaceMemberPayload, error) {
+	var err error
+	var body AddWorkspaceMemberRequestBody
+	{
+		err = json.Unmarshal([]byte(authAddWorkspaceMemberBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": \"Sint doloremque unde earum amet dicta blanditiis.\"\n   }'")
+		}
+	}
+	var workspaceID string
+	{
+		workspaceID = authAddWorkspaceMemberWorkspaceID
+	}
+	var token string
+	{
+		token = authAddWorkspaceMemberToken
+	}
+	v := &auth.AddWorkspaceMemberPayload{
+		UserID: body.UserID,
+	}
+	v.WorkspaceID = workspaceID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRemoveWorkspaceMemberPayload builds the payload for the auth
+// remove_workspace_member endpoint from CLI flags.
+func BuildRemoveWorkspaceMemberPayload(authRemoveWorkspaceMemberWorkspaceID string, authRemoveWorkspaceMemberUserID string, authRemoveWorkspaceMemberToken string) (*auth.RemoveWorkspaceMemberPayload, error) {
+	var workspaceID string
+	{
+		workspaceID = authRemoveWorkspaceMemberWorkspaceID
+	}
+	var userID string
+	{
+		userID = authRemoveWorkspaceMemberUserID
+	}
+	var token string
+	{
+		token = authRemoveWorkspaceMemberToken
+	}
+	v := &auth.RemoveWorkspaceMemberPayload{}
+	v.WorkspaceID = workspaceID
+	v.UserID = userID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSwitchWorkspacePayload builds the payload for the auth switch_workspace
+// endpoint from CLI flags.
+func BuildSwitchWorkspacePayload(authSwitchWorkspaceWorkspaceID string, authSwitchWorkspaceToken string) (*auth.SwitchWorkspacePayload, error) {
+	var workspaceID string
+	{
+		workspaceID = authSwitchWorkspaceWorkspaceID
+	}
+	var token string
+	{
+		token = authSwitchWorkspaceToken
+	}
+	v := &auth.SwitchWorkspacePayload{}
+	v.WorkspaceID = workspaceID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSwitchDefaultWorkspacePayload builds the payload for the auth
+// switch_default_workspace endpoint from CLI flags.
+func BuildSwitchDefaultWorkspacePayload(authSwitchDefaultWorkspaceToken string) (*auth.SwitchDefaultWorkspacePayload, error) {
+	var token string
+	{
+		token = authSwitchDefaultWorkspaceToken
+	}
+	v := &auth.SwitchDefaultWorkspacePayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateTokenPayload builds the payload for the auth create_token
+// endpoint from CLI flags.
+func BuildCreateTokenPayload(authCreateTokenBody string, authCreateTokenToken string) (*auth.CreateTokenPayload, error) {
+	var err error
+	var body CreateTokenRequestBody
+	{
+		err = json.Unmarshal([]byte(authCreateTokenBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"expires_in\": 4819743648855942566,\n      \"name\": \"6r\",\n      \"scopes\": [\n         \"api:admin\",\n         \"api:read\"\n      ]\n   }'")
+		}
+		if body.Scopes == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 100, false))
+		}
+		if len(body.Scopes) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scopes", body.Scopes, len(body.Scopes), 1, true))
+		}
+		for _, e := range body.Scopes {
+			if !(e == "api:read" || e == "api:write" || e == "api:moderate" || e == "api:admin") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scopes[*]", e, []any{"api:read", "api:write", "api:moderate", "api:admin"}))
+			}
+		}
+		if body.ExpiresIn != nil {
+			if *body.ExpiresIn < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.expires_in", *body.ExpiresIn, 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = authCreateTokenToken
+	}
+	v := &auth.CreateTokenPayload{
+		Name:      body.Name,
+		ExpiresIn: body.ExpiresIn,
+	}
+	if body.Scopes != nil {
+		v.Scopes = make([]string, len(body.Scopes))
+		for i, val := range body.Scopes {
+			v.Scopes[i] = val
+		}
+	} else {
+		v.Scopes = []string{}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListTokensPayload builds the payload for the auth list_tokens endpoint
+// from CLI flags.
+func BuildListTokensPayload(authListTokensToken string) (*auth.ListTokensPayload, error) {
+	var token string
+	{
+		token = authListTokensToken
+	}
+	v := &auth.ListTokensPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteTokenPayload builds the payload for the auth delete_token
+// endpoint from CLI flags.
+func BuildDeleteTokenPayload(authDeleteTokenID string, authDeleteTokenToken string) (*auth.DeleteTokenPayload, error) {
+	var id string
+	{
+		id = authDeleteTokenID
+	}
+	var token string
+	{
+		token = authDeleteTokenToken
+	}
+	v := &auth.DeleteTokenPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateBotPayload builds the payload for the auth create_bot endpoint
+// from CLI flags.
+func BuildCreateBotPayload(authCreateBotBody string, authCreateBotToken string) (*auth.CreateBotPayload, error) {
+	var err error
+	var body CreateBotRequestBody
+	{
+		err = json.Unmarshal([]byte(authCreateBotBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"tke\",\n      \"scopes\": [\n         \"api:write\",\n         \"api:moderate\",\n         \"api:admin\"\n      ]\n   }'")
+		}
+		if body.Scopes == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 100, false))
+		}
+		if len(body.Scopes) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scopes", body.Scopes, len(body.Scopes), 1, true))
+		}
+		for _, e := range body.Scopes {
+			if !(e == "api:read" || e == "api:write" || e == "api:moderate" || e == "api:admin") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scopes[*]", e, []any{"api:read", "api:write", "api:moderate", "api:admin"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = authCreateBotToken
+	}
+	v := &auth.CreateBotPayload{
+		Name: body.Name,
+	}
+	if body.Scopes != nil {
+		v.Scopes = make([]string, len(body.Scopes))
+		for i, val := range body.Scopes {
+			v.Scopes[i] = val
+		}
+	} else {
+		v.Scopes = []string{}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListBotsPayload builds the payload for the auth list_bots endpoint from
+// CLI flags.
+func BuildListBotsPayload(authListBotsToken string) (*auth.ListBotsPayload, error) {
+	var token string
+	{
+		token = authListBotsToken
+	}
+	v := &auth.ListBotsPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteBotPayload builds the payload for the auth delete_bot endpoint
+// from CLI flags.
+func BuildDeleteBotPayload(authDeleteBotID string, authDeleteBotToken string) (*auth.DeleteBotPayload, error) {
+	var id string
+	{
+		id = authDeleteBotID
+	}
+	var token string
+	{
+		token = authDeleteBotToken
+	}
+	v := &auth.DeleteBotPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildClientTokenPayload builds the payload for the auth client_token
+// endpoint from CLI flags.
+func BuildClientTokenPayload(authClientTokenBody string, authClientTokenClientID string, authClientTokenClientSecret string) (*auth.ClientTokenPayload, error) {
+	var err error
+	var body ClientTokenRequestBody
+	{
+		err = json.Unmarshal([]byte(authClientTokenBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"grant_type\": \"client_credentials\",\n      \"scope\": \"Quasi sit in ea suscipit nihil.\"\n   }'")
+		}
+		if !(body.GrantType == "client_credentials") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", body.GrantType, []any{"client_credentials"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var client_id string
+	{
+		client_id = authClientTokenClientID
+	}
+	var client_secret string
+	{
+		client_secret = authClientTokenClientSecret
+	}
+	v := &auth.ClientTokenPayload{
+		GrantType: body.GrantType,
+		Scope:     body.Scope,
+	}
+	v.ClientID = client_id
+	v.ClientSecret = client_secret
+
+	return v, nil
+}
+
+// BuildTokenIntrospectPayload builds the payload for the auth token_introspect
+// endpoint from CLI flags.
+func BuildTokenIntrospectPayload(authTokenIntrospectBody string, authTokenIntrospectClientID string, authTokenIntrospectClientSecret string) (*auth.TokenIntrospectPayload, error) {
+	var err error
+	var body TokenIntrospectRequestBody
+	{
+		err = json.Unmarshal([]byte(authTokenIntrospectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Atque qui ab.\",\n      \"token_type_hint\": \"refresh_token\"\n   }'")
+		}
+		if body.TokenTypeHint != nil {
+			if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var client_id string
+	{
+		client_id = authTokenIntrospectClientID
+	}
+	var client_secret string
+	{
+		client_secret = authTokenIntrospectClientSecret
+	}
+	v := &auth.TokenIntrospectPayload{
+		Token:         body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+	v.ClientID = client_id
+	v.ClientSecret = client_secret
+
+	return v, nil
+}
+
+// BuildGetUserRolesPayload builds the payload for the auth get_user_roles
+// endpoint from CLI flags.
+func BuildGetUserRolesPayload(authGetUserRolesUserID string, authGetUserRolesToken string) (*auth.GetUserRolesPayload, error) {
+	var userID string
+	{
+		userID = authGetUserRolesUserID
+	}
+	var token string
+	{
+		token = authGetUserRolesToken
+	}
+	v := &auth.GetUserRolesPayload{}
+	v.UserID = userID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGrantRolePayload builds the payload for the auth grant_role endpoint
+// from CLI flags.
+func BuildGrantRolePayload(authGrantRoleBody string, authGrantRoleUserID string, authGrantRoleToken string) (*auth.GrantRolePayload, error) {
+	var err error
+	var body GrantRoleRequestBody
+	{
+		err = json.Unmarshal([]byte(authGrantRoleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"role\": \"moderator\"\n   }'")
+		}
+		if !(body.Role == "moderator" || body.Role == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", body.Role, []any{"moderator", "admin"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var userID string
+	{
+		userID = authGrantRoleUserID
+	}
+	var token string
+	{
+		token = authGrantRoleToken
+	}
+	v := &auth.GrantRolePayload{
+		Role: body.Role,
+	}
+	v.UserID = userID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokeRolePayload builds the payload for the auth revoke_role endpoint
+// from CLI flags.
+func BuildRevokeRolePayload(authRevokeRoleUserID string, authRevokeRoleRole string, authRevokeRoleToken string) (*auth.RevokeRolePayload, error) {
+	var err error
+	var userID string
+	{
+		userID = authRevokeRoleUserID
+	}
+	var role string
+	{
+		role = authRevokeRoleRole
+		if !(role == "moderator" || role == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("role", role, []any{"moderator", "admin"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = authRevokeRoleToken
+	}
+	v := &auth.RevokeRolePayload{}
+	v.UserID = userID
+	v.Role = role
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeviceAuthorizationPayload builds the payload for the auth
+// device_authorization endpoint from CLI flags.
+func BuildDeviceAuthorizationPayload(authDeviceAuthorizationBody string) (*auth.DeviceAuthorizationPayload, error) {
+	var err error
+	var body DeviceAuthorizationRequestBody
+	{
+		err = json.Unmarshal([]byte(authDeviceAuthorizationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"client_id\": \"gop\",\n      \"provider\": \"Delectus ut.\"\n   }'")
+		}
+	}
+	v := &auth.DeviceAuthorizationPayload{
+		Provider: body.Provider,
+		ClientID: body.ClientID,
+	}
+	{
+		var zero string
+		if v.Provider == zero {
+			v.Provider = "github"
+		}
+	}
+
+	return v, nil
+}
+
+// BuildDeviceVerifyPayload builds the payload for the auth device_verify
+// endpoint from CLI flags.
+func BuildDeviceVerifyPayload(authDeviceVerifyUserCode string) (*auth.DeviceVerifyPayload, error) {
+	var userCode string
+	{
+		userCode = authDeviceVerifyUserCode
+	}
+	v := &auth.DeviceVerifyPayload{}
+	v.UserCode = userCode
+
+	return v, nil
+}
+
+// BuildDeviceConfirmPayload builds the payload for the auth device_confirm
+// endpoint from CLI flags.
+func BuildDeviceConfirmPayload(authDeviceConfirmUserCode string, authDeviceConfirmConsent string, authDeviceConfirmConsentCookie string) (*auth.DeviceConfirmPayload, error) {
+	var userCode string
+	{
+		userCode = authDeviceConfirmUserCode
+	}
+	var consent string
+	{
+		consent = authDeviceConfirmConsent
+	}
+	var consentCookie string
+	{
+		consentCookie = authDeviceConfirmConsentCookie
+	}
+	v := &auth.DeviceConfirmPayload{}
+	v.UserCode = userCode
+	v.Consent = consent
+	v.ConsentCookie = consentCookie
+
+	return v, nil
+}
+
+// BuildDeviceTokenPayload builds the payload for the auth device_token
+// endpoint from CLI flags.
+func BuildDeviceTokenPayload(authDeviceTokenBody string) (*auth.DeviceTokenPayload, error) {
+	var err error
+	var body DeviceTokenRequestBody
+	{
+		err = json.Unmarshal([]byte(authDeviceTokenBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"device_code\": \"Temporibus ducimus saepe.\",\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:device_code\"\n   }'")
+		}
+		if !(body.GrantType == "urn:ietf:params:oauth:grant-type:device_code") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", body.GrantType, []any{"urn:ietf:params:oauth:grant-type:device_code"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &auth.DeviceTokenPayload{
+		GrantType:  body.GrantType,
+		DeviceCode: body.DeviceCode,
+	}
+
+	return v, nil
+}
+
+// BuildListAuditEventsPayload builds the payload for the auth
+// list_audit_events endpoint from CLI flags.
+func BuildListAuditEventsPayload(authListAuditEventsUserID string, authListAuditEventsSince string, authListAuditEventsUntil string, authListAuditEventsLimit string, authListAuditEventsToken string) (*auth.ListAuditEventsPayload, error) {
+	var err error
+	var userID *string
+	{
+		if authListAuditEventsUserID != "" {
+			userID = &authListAuditEventsUserID
+		}
+	}
+	var since *int64
+	{
+		if authListAuditEventsSince != "" {
+			val, err := strconv.ParseInt(authListAuditEventsSince, 10, 64)
+			since = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for since, must be INT64")
+			}
+		}
+	}
+	var until *int64
+	{
+		if authListAuditEventsUntil != "" {
+			val, err := strconv.ParseInt(authListAuditEventsUntil, 10, 64)
+			until = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for until, must be INT64")
+			}
+		}
+	}
+	var limit int
+	{
+		if authListAuditEventsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(authListAuditEventsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = authListAuditEventsToken
+	}
+	v := &auth.ListAuditEventsPayload{}
+	v.UserID = userID
+	v.Since = since
+	v.Until = until
+	v.Limit = limit
+	v.Token = token
 
 	return v, nil
 }
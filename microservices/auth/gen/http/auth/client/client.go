@@ -29,6 +29,131 @@ type Client struct {
 	// oauth_callback endpoint.
 	OauthCallbackDoer goahttp.Doer
 
+	// Refresh Doer is the HTTP client used to make requests to the refresh
+	// endpoint.
+	RefreshDoer goahttp.Doer
+
+	// Logout Doer is the HTTP client used to make requests to the logout endpoint.
+	LogoutDoer goahttp.Doer
+
+	// Revoke Doer is the HTTP client used to make requests to the revoke endpoint.
+	RevokeDoer goahttp.Doer
+
+	// RevokeUserSessions Doer is the HTTP client used to make requests to the
+	// revoke_user_sessions endpoint.
+	RevokeUserSessionsDoer goahttp.Doer
+
+	// ListSessions Doer is the HTTP client used to make requests to the
+	// list_sessions endpoint.
+	ListSessionsDoer goahttp.Doer
+
+	// DeleteSession Doer is the HTTP client used to make requests to the
+	// delete_session endpoint.
+	DeleteSessionDoer goahttp.Doer
+
+	// LinkIdentity Doer is the HTTP client used to make requests to the
+	// link_identity endpoint.
+	LinkIdentityDoer goahttp.Doer
+
+	// ListIdentities Doer is the HTTP client used to make requests to the
+	// list_identities endpoint.
+	ListIdentitiesDoer goahttp.Doer
+
+	// UnlinkIdentity Doer is the HTTP client used to make requests to the
+	// unlink_identity endpoint.
+	UnlinkIdentityDoer goahttp.Doer
+
+	// CreateWorkspace Doer is the HTTP client used to make requests to the
+	// create_workspace endpoint.
+	CreateWorkspaceDoer goahttp.Doer
+
+	// ListWorkspaces Doer is the HTTP client used to make requests to the
+	// list_workspaces endpoint.
+	ListWorkspacesDoer goahttp.Doer
+
+	// AddWorkspaceMember Doer is the HTTP client used to make requests to the
+	// add_workspace_member endpoint.
+	AddWorkspaceMemberDoer goahttp.Doer
+
+	// RemoveWorkspaceMember Doer is the HTTP client used to make requests to the
+	// remove_workspace_member endpoint.
+	RemoveWorkspaceMemberDoer goahttp.Doer
+
+	// SwitchWorkspace Doer is the HTTP client used to make requests to the
+	// switch_workspace endpoint.
+	SwitchWorkspaceDoer goahttp.Doer
+
+	// SwitchDefaultWorkspace Doer is the HTTP client used to make requests to the
+	// switch_default_workspace endpoint.
+	SwitchDefaultWorkspaceDoer goahttp.Doer
+
+	// Jwks Doer is the HTTP client used to make requests to the jwks endpoint.
+	JwksDoer goahttp.Doer
+
+	// CreateToken Doer is the HTTP client used to make requests to the
+	// create_token endpoint.
+	CreateTokenDoer goahttp.Doer
+
+	// ListTokens Doer is the HTTP client used to make requests to the list_tokens
+	// endpoint.
+	ListTokensDoer goahttp.Doer
+
+	// DeleteToken Doer is the HTTP client used to make requests to the
+	// delete_token endpoint.
+	DeleteTokenDoer goahttp.Doer
+
+	// CreateBot Doer is the HTTP client used to make requests to the create_bot
+	// endpoint.
+	CreateBotDoer goahttp.Doer
+
+	// ListBots Doer is the HTTP client used to make requests to the list_bots
+	// endpoint.
+	ListBotsDoer goahttp.Doer
+
+	// DeleteBot Doer is the HTTP client used to make requests to the delete_bot
+	// endpoint.
+	DeleteBotDoer goahttp.Doer
+
+	// ClientToken Doer is the HTTP client used to make requests to the
+	// client_token endpoint.
+	ClientTokenDoer goahttp.Doer
+
+	// TokenIntrospect Doer is the HTTP client used to make requests to the
+	// token_introspect endpoint.
+	TokenIntrospectDoer goahttp.Doer
+
+	// GetUserRoles Doer is the HTTP client used to make requests to the
+	// get_user_roles endpoint.
+	GetUserRolesDoer goahttp.Doer
+
+	// GrantRole Doer is the HTTP client used to make requests to the grant_role
+	// endpoint.
+	GrantRoleDoer goahttp.Doer
+
+	// RevokeRole Doer is the HTTP client used to make requests to the revoke_role
+	// endpoint.
+	RevokeRoleDoer goahttp.Doer
+
+	// DeviceAuthorization Doer is the HTTP client used to make requests to the
+	// device_authorization endpoint.
+	DeviceAuthorizationDoer goahttp.Doer
+
+	// DeviceVerify Doer is the HTTP client used to make requests to the
+	// device_verify endpoint.
+	DeviceVerifyDoer goahttp.Doer
+
+	// DeviceConfirm Doer is the HTTP client used to make requests to the
+	// device_confirm endpoint.
+	DeviceConfirmDoer goahttp.Doer
+
+	// DeviceToken Doer is the HTTP client used to make requests to the
+	// device_token endpoint.
+	DeviceTokenDoer goahttp.Doer
+
+	// ListAuditEvents Doer is the HTTP client used to make requests to the
+	// list_audit_events endpoint.
+	ListAuditEventsDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -49,14 +174,46 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		IntrospectDoer:      doer,
-		AuthURLDoer:         doer,
-		OauthCallbackDoer:   doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		IntrospectDoer:             doer,
+		AuthURLDoer:                doer,
+		OauthCallbackDoer:          doer,
+		RefreshDoer:                doer,
+		LogoutDoer:                 doer,
+		RevokeDoer:                 doer,
+		RevokeUserSessionsDoer:     doer,
+		ListSessionsDoer:           doer,
+		DeleteSessionDoer:          doer,
+		LinkIdentityDoer:           doer,
+		ListIdentitiesDoer:         doer,
+		UnlinkIdentityDoer:         doer,
+		CreateWorkspaceDoer:        doer,
+		ListWorkspacesDoer:         doer,
+		AddWorkspaceMemberDoer:     doer,
+		RemoveWorkspaceMemberDoer:  doer,
+		SwitchWorkspaceDoer:        doer,
+		SwitchDefaultWorkspaceDoer: doer,
+		JwksDoer:                   doer,
+		CreateTokenDoer:            doer,
+		ListTokensDoer:             doer,
+		DeleteTokenDoer:            doer,
+		CreateBotDoer:              doer,
+		ListBotsDoer:               doer,
+		DeleteBotDoer:              doer,
+		ClientTokenDoer:            doer,
+		TokenIntrospectDoer:        doer,
+		GetUserRolesDoer:           doer,
+		GrantRoleDoer:              doer,
+		RevokeRoleDoer:             doer,
+		DeviceAuthorizationDoer:    doer,
+		DeviceVerifyDoer:           doer,
+		DeviceConfirmDoer:          doer,
+		DeviceTokenDoer:            doer,
+		ListAuditEventsDoer:        doer,
+		RestoreResponseBody:        restoreBody,
+		scheme:                     scheme,
+		host:                       host,
+		decoder:                    dec,
+		encoder:                    enc,
 	}
 }
 
@@ -88,6 +245,7 @@ func (c *Client) Introspect() goa.Endpoint {
 // auth_url server.
 func (c *Client) AuthURL() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeAuthURLRequest(c.encoder)
 		decodeResponse = DecodeAuthURLResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -95,6 +253,10 @@ func (c *Client) AuthURL() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.AuthURLDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("auth", "auth_url", err)
@@ -126,3 +288,766 @@ func (c *Client) OauthCallback() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Refresh returns an endpoint that makes HTTP requests to the auth service
+// refresh server.
+func (c *Client) Refresh() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRefreshRequest(c.encoder)
+		decodeResponse = DecodeRefreshResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRefreshRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RefreshDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "refresh", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Logout returns an endpoint that makes HTTP requests to the auth service
+// logout server.
+func (c *Client) Logout() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeLogoutRequest(c.encoder)
+		decodeResponse = DecodeLogoutResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLogoutRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LogoutDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "logout", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Revoke returns an endpoint that makes HTTP requests to the auth service
+// revoke server.
+func (c *Client) Revoke() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeRequest(c.encoder)
+		decodeResponse = DecodeRevokeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "revoke", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeUserSessions returns an endpoint that makes HTTP requests to the auth
+// service revoke_user_sessions server.
+func (c *Client) RevokeUserSessions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeUserSessionsRequest(c.encoder)
+		decodeResponse = DecodeRevokeUserSessionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeUserSessionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeUserSessionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "revoke_user_sessions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListSessions returns an endpoint that makes HTTP requests to the auth
+// service list_sessions server.
+func (c *Client) ListSessions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListSessionsRequest(c.encoder)
+		decodeResponse = DecodeListSessionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListSessionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListSessionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "list_sessions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteSession returns an endpoint that makes HTTP requests to the auth
+// service delete_session server.
+func (c *Client) DeleteSession() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteSessionRequest(c.encoder)
+		decodeResponse = DecodeDeleteSessionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteSessionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteSessionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "delete_session", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// LinkIdentity returns an endpoint that makes HTTP requests to the auth
+// service link_identity server.
+func (c *Client) LinkIdentity() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeLinkIdentityRequest(c.encoder)
+		decodeResponse = DecodeLinkIdentityResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLinkIdentityRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LinkIdentityDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "link_identity", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListIdentities returns an endpoint that makes HTTP requests to the auth
+// service list_identities server.
+func (c *Client) ListIdentities() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListIdentitiesRequest(c.encoder)
+		decodeResponse = DecodeListIdentitiesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListIdentitiesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListIdentitiesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "list_identities", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UnlinkIdentity returns an endpoint that makes HTTP requests to the auth
+// service unlink_identity server.
+func (c *Client) UnlinkIdentity() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnlinkIdentityRequest(c.encoder)
+		decodeResponse = DecodeUnlinkIdentityResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnlinkIdentityRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnlinkIdentityDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "unlink_identity", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateWorkspace returns an endpoint that makes HTTP requests to the auth
+// service create_workspace server.
+func (c *Client) CreateWorkspace() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWorkspaceRequest(c.encoder)
+		decodeResponse = DecodeCreateWorkspaceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWorkspaceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWorkspaceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "create_workspace", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWorkspaces returns an endpoint that makes HTTP requests to the auth
+// service list_workspaces server.
+func (c *Client) ListWorkspaces() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWorkspacesRequest(c.encoder)
+		decodeResponse = DecodeListWorkspacesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWorkspacesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWorkspacesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "list_workspaces", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddWorkspaceMember returns an endpoint that makes HTTP requests to the auth
+// service add_workspace_member server.
+func (c *Client) AddWorkspaceMember() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddWorkspaceMemberRequest(c.encoder)
+		decodeResponse = DecodeAddWorkspaceMemberResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddWorkspaceMemberRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddWorkspaceMemberDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "add_workspace_member", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveWorkspaceMember returns an endpoint that makes HTTP requests to the
+// auth service remove_workspace_member server.
+func (c *Client) RemoveWorkspaceMember() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveWorkspaceMemberRequest(c.encoder)
+		decodeResponse = DecodeRemoveWorkspaceMemberResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveWorkspaceMemberRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveWorkspaceMemberDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "remove_workspace_member", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SwitchWorkspace returns an endpoint that makes HTTP requests to the auth
+// service switch_workspace server.
+func (c *Client) SwitchWorkspace() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSwitchWorkspaceRequest(c.encoder)
+		decodeResponse = DecodeSwitchWorkspaceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSwitchWorkspaceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SwitchWorkspaceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "switch_workspace", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SwitchDefaultWorkspace returns an endpoint that makes HTTP requests to the
+// auth service switch_default_workspace server.
+func (c *Client) SwitchDefaultWorkspace() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSwitchDefaultWorkspaceRequest(c.encoder)
+		decodeResponse = DecodeSwitchDefaultWorkspaceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSwitchDefaultWorkspaceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SwitchDefaultWorkspaceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "switch_default_workspace", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Jwks returns an endpoint that makes HTTP requests to the auth service jwks
+// server.
+func (c *Client) Jwks() goa.Endpoint {
+	var (
+		decodeResponse = DecodeJwksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildJwksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.JwksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "jwks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateToken returns an endpoint that makes HTTP requests to the auth service
+// create_token server.
+func (c *Client) CreateToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateTokenRequest(c.encoder)
+		decodeResponse = DecodeCreateTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "create_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListTokens returns an endpoint that makes HTTP requests to the auth service
+// list_tokens server.
+func (c *Client) ListTokens() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListTokensRequest(c.encoder)
+		decodeResponse = DecodeListTokensResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListTokensRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListTokensDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "list_tokens", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteToken returns an endpoint that makes HTTP requests to the auth service
+// delete_token server.
+func (c *Client) DeleteToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteTokenRequest(c.encoder)
+		decodeResponse = DecodeDeleteTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "delete_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateBot returns an endpoint that makes HTTP requests to the auth service
+// create_bot server.
+func (c *Client) CreateBot() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateBotRequest(c.encoder)
+		decodeResponse = DecodeCreateBotResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateBotRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateBotDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "create_bot", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListBots returns an endpoint that makes HTTP requests to the auth service
+// list_bots server.
+func (c *Client) ListBots() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListBotsRequest(c.encoder)
+		decodeResponse = DecodeListBotsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListBotsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListBotsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "list_bots", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteBot returns an endpoint that makes HTTP requests to the auth service
+// delete_bot server.
+func (c *Client) DeleteBot() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteBotRequest(c.encoder)
+		decodeResponse = DecodeDeleteBotResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteBotRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteBotDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "delete_bot", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ClientToken returns an endpoint that makes HTTP requests to the auth service
+// client_token server.
+func (c *Client) ClientToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeClientTokenRequest(c.encoder)
+		decodeResponse = DecodeClientTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildClientTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ClientTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "client_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// TokenIntrospect returns an endpoint that makes HTTP requests to the auth
+// service token_introspect server.
+func (c *Client) TokenIntrospect() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTokenIntrospectRequest(c.encoder)
+		decodeResponse = DecodeTokenIntrospectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTokenIntrospectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TokenIntrospectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "token_introspect", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetUserRoles returns an endpoint that makes HTTP requests to the auth
+// service get_user_roles server.
+func (c *Client) GetUserRoles() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetUserRolesRequest(c.encoder)
+		decodeResponse = DecodeGetUserRolesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetUserRolesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetUserRolesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "get_user_roles", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GrantRole returns an endpoint that makes HTTP requests to the auth service
+// grant_role server.
+func (c *Client) GrantRole() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGrantRoleRequest(c.encoder)
+		decodeResponse = DecodeGrantRoleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGrantRoleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GrantRoleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "grant_role", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeRole returns an endpoint that makes HTTP requests to the auth service
+// revoke_role server.
+func (c *Client) RevokeRole() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeRoleRequest(c.encoder)
+		decodeResponse = DecodeRevokeRoleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeRoleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeRoleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "revoke_role", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeviceAuthorization returns an endpoint that makes HTTP requests to the auth
+// service device_authorization server.
+func (c *Client) DeviceAuthorization() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeviceAuthorizationRequest(c.encoder)
+		decodeResponse = DecodeDeviceAuthorizationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeviceAuthorizationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeviceAuthorizationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "device_authorization", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeviceVerify returns an endpoint that makes HTTP requests to the auth
+// service device_verify server.
+func (c *Client) DeviceVerify() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeviceVerifyRequest(c.encoder)
+		decodeResponse = DecodeDeviceVerifyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeviceVerifyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeviceVerifyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "device_verify", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeviceConfirm returns an endpoint that makes HTTP requests to the auth
+// service device_confirm server.
+func (c *Client) DeviceConfirm() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeviceConfirmRequest(c.encoder)
+		decodeResponse = DecodeDeviceConfirmResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeviceConfirmRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeviceConfirmDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "device_confirm", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeviceToken returns an endpoint that makes HTTP requests to the auth service
+// device_token server.
+func (c *Client) DeviceToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeviceTokenRequest(c.encoder)
+		decodeResponse = DecodeDeviceTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeviceTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeviceTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "device_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAuditEvents returns an endpoint that makes HTTP requests to the auth
+// service list_audit_events server.
+func (c *Client) ListAuditEvents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAuditEventsRequest(c.encoder)
+		decodeResponse = DecodeListAuditEventsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAuditEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAuditEventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("auth", "list_audit_events", err)
+		}
+		return decodeResponse(resp)
+	}
+}
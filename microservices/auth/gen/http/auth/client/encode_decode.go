@@ -10,11 +10,14 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
 )
 
@@ -55,6 +58,7 @@ func EncodeIntrospectRequest(encoder func(*http.Request) goahttp.Encoder) func(*
 // DecodeIntrospectResponse may return the following errors:
 //   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
 //   - "invalid_token" (type auth.InvalidToken): http.StatusUnauthorized
+//   - "too_many_requests" (type auth.TooManyRequests): http.StatusTooManyRequests
 //   - error: internal error
 func DecodeIntrospectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -106,6 +110,16 @@ func DecodeIntrospectResponse(decoder func(*http.Response) goahttp.Decoder, rest
 				return nil, goahttp.ErrDecodingError("auth", "introspect", err)
 			}
 			return nil, NewIntrospectInvalidToken(body)
+		case http.StatusTooManyRequests:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "introspect", err)
+			}
+			return nil, NewIntrospectTooManyRequests(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("auth", "introspect", resp.StatusCode, string(body))
@@ -116,7 +130,17 @@ func DecodeIntrospectResponse(decoder func(*http.Response) goahttp.Decoder, rest
 // BuildAuthURLRequest instantiates a HTTP request object with method and path
 // set to call the "auth" service "auth_url" endpoint
 func (c *Client) BuildAuthURLRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AuthURLAuthPath()}
+	var (
+		provider string
+	)
+	{
+		p, ok := v.(*auth.AuthURLPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "auth_url", "*auth.AuthURLPayload", v)
+		}
+		provider = p.Provider
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AuthURLAuthPath(provider)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, goahttp.ErrInvalidURL("auth", "auth_url", u.String(), err)
@@ -128,11 +152,32 @@ func (c *Client) BuildAuthURLRequest(ctx context.Context, v any) (*http.Request,
 	return req, nil
 }
 
+// EncodeAuthURLRequest returns an encoder for requests sent to the auth
+// auth_url server.
+func EncodeAuthURLRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.AuthURLPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "auth_url", "*auth.AuthURLPayload", v)
+		}
+		values := req.URL.Query()
+		if p.CodeChallenge != nil {
+			values.Add("code_challenge", *p.CodeChallenge)
+		}
+		values.Add("code_challenge_method", p.CodeChallengeMethod)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
 // DecodeAuthURLResponse returns a decoder for responses returned by the auth
 // auth_url endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
 // DecodeAuthURLResponse may return the following errors:
 //   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "provider_error" (type auth.ProviderError): http.StatusBadGateway
+//   - "too_many_requests" (type auth.TooManyRequests): http.StatusTooManyRequests
+//   - "unknown_provider" (type auth.UnknownProvider): http.StatusNotFound
 //   - error: internal error
 func DecodeAuthURLResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -174,6 +219,36 @@ func DecodeAuthURLResponse(decoder func(*http.Response) goahttp.Decoder, restore
 				return nil, goahttp.ErrDecodingError("auth", "auth_url", err)
 			}
 			return nil, NewAuthURLInternalError(body)
+		case http.StatusBadGateway:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "auth_url", err)
+			}
+			return nil, NewAuthURLProviderError(body)
+		case http.StatusTooManyRequests:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "auth_url", err)
+			}
+			return nil, NewAuthURLTooManyRequests(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "auth_url", err)
+			}
+			return nil, NewAuthURLUnknownProvider(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("auth", "auth_url", resp.StatusCode, string(body))
@@ -184,7 +259,17 @@ func DecodeAuthURLResponse(decoder func(*http.Response) goahttp.Decoder, restore
 // BuildOauthCallbackRequest instantiates a HTTP request object with method and
 // path set to call the "auth" service "oauth_callback" endpoint
 func (c *Client) BuildOauthCallbackRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: OauthCallbackAuthPath()}
+	var (
+		provider string
+	)
+	{
+		p, ok := v.(*auth.OauthCallbackPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "oauth_callback", "*auth.OauthCallbackPayload", v)
+		}
+		provider = p.Provider
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: OauthCallbackAuthPath(provider)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, goahttp.ErrInvalidURL("auth", "oauth_callback", u.String(), err)
@@ -207,6 +292,9 @@ func EncodeOauthCallbackRequest(encoder func(*http.Request) goahttp.Encoder) fun
 		values := req.URL.Query()
 		values.Add("code", p.Code)
 		values.Add("state", p.State)
+		if p.CodeVerifier != nil {
+			values.Add("code_verifier", *p.CodeVerifier)
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -216,10 +304,14 @@ func EncodeOauthCallbackRequest(encoder func(*http.Request) goahttp.Encoder) fun
 // auth oauth_callback endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
 // DecodeOauthCallbackResponse may return the following errors:
-//   - "github_error" (type auth.GithubError): http.StatusBadGateway
+//   - "identity_conflict" (type auth.IdentityConflict): http.StatusConflict
 //   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
 //   - "invalid_code" (type auth.InvalidCode): http.StatusBadRequest
 //   - "invalid_state" (type auth.InvalidState): http.StatusBadRequest
+//   - "invalid_verifier" (type auth.InvalidVerifier): http.StatusBadRequest
+//   - "provider_error" (type auth.ProviderError): http.StatusBadGateway
+//   - "too_many_requests" (type auth.TooManyRequests): http.StatusTooManyRequests
+//   - "unknown_provider" (type auth.UnknownProvider): http.StatusNotFound
 //   - error: internal error
 func DecodeOauthCallbackResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -249,9 +341,9 @@ func DecodeOauthCallbackResponse(decoder func(*http.Response) goahttp.Decoder, r
 			if err != nil {
 				return nil, goahttp.ErrValidationError("auth", "oauth_callback", err)
 			}
-			res := NewOauthCallbackResultOK(&body)
+			res := NewOauthCallbackTokenResultOK(&body)
 			return res, nil
-		case http.StatusBadGateway:
+		case http.StatusConflict:
 			var (
 				body string
 				err  error
@@ -260,7 +352,7 @@ func DecodeOauthCallbackResponse(decoder func(*http.Response) goahttp.Decoder, r
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("auth", "oauth_callback", err)
 			}
-			return nil, NewOauthCallbackGithubError(body)
+			return nil, NewOauthCallbackIdentityConflict(body)
 		case http.StatusInternalServerError:
 			var (
 				body string
@@ -294,13 +386,3668 @@ func DecodeOauthCallbackResponse(decoder func(*http.Response) goahttp.Decoder, r
 					return nil, goahttp.ErrDecodingError("auth", "oauth_callback", err)
 				}
 				return nil, NewOauthCallbackInvalidState(body)
+			case "invalid_verifier":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("auth", "oauth_callback", err)
+				}
+				return nil, NewOauthCallbackInvalidVerifier(body)
 			default:
 				body, _ := io.ReadAll(resp.Body)
 				return nil, goahttp.ErrInvalidResponse("auth", "oauth_callback", resp.StatusCode, string(body))
 			}
+		case http.StatusBadGateway:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "oauth_callback", err)
+			}
+			return nil, NewOauthCallbackProviderError(body)
+		case http.StatusTooManyRequests:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "oauth_callback", err)
+			}
+			return nil, NewOauthCallbackTooManyRequests(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "oauth_callback", err)
+			}
+			return nil, NewOauthCallbackUnknownProvider(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("auth", "oauth_callback", resp.StatusCode, string(body))
 		}
 	}
 }
+
+// BuildRefreshRequest instantiates a HTTP request object with method and path
+// set to call the "auth" service "refresh" endpoint
+func (c *Client) BuildRefreshRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RefreshAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "refresh", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRefreshRequest returns an encoder for requests sent to the auth
+// refresh server.
+func EncodeRefreshRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.RefreshPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "refresh", "*auth.RefreshPayload", v)
+		}
+		body := NewRefreshRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "refresh", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRefreshResponse returns a decoder for responses returned by the auth
+// refresh endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeRefreshResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "invalid_token" (type auth.InvalidToken): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRefreshResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RefreshResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "refresh", err)
+			}
+			err = ValidateRefreshResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "refresh", err)
+			}
+			res := NewRefreshTokenResultOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "refresh", err)
+			}
+			return nil, NewRefreshInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "refresh", err)
+			}
+			return nil, NewRefreshInvalidToken(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "refresh", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLogoutRequest instantiates a HTTP request object with method and path
+// set to call the "auth" service "logout" endpoint
+func (c *Client) BuildLogoutRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LogoutAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "logout", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeLogoutRequest returns an encoder for requests sent to the auth logout
+// server.
+func EncodeLogoutRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.LogoutPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "logout", "*auth.LogoutPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeLogoutResponse returns a decoder for responses returned by the auth
+// logout endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeLogoutResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeLogoutResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "logout", err)
+			}
+			return nil, NewLogoutInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "logout", err)
+			}
+			return nil, NewLogoutUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "logout", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeRequest instantiates a HTTP request object with method and path
+// set to call the "auth" service "revoke" endpoint
+func (c *Client) BuildRevokeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "revoke", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeRequest returns an encoder for requests sent to the auth revoke
+// server.
+func EncodeRevokeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.RevokePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "revoke", "*auth.RevokePayload", v)
+		}
+		body := NewRevokeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "revoke", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeResponse returns a decoder for responses returned by the auth
+// revoke endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeRevokeResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRevokeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke", err)
+			}
+			return nil, NewRevokeInternalError(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "revoke", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeUserSessionsRequest instantiates a HTTP request object with
+// method and path set to call the "auth" service "revoke_user_sessions"
+// endpoint
+func (c *Client) BuildRevokeUserSessionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+	)
+	{
+		p, ok := v.(*auth.RevokeUserSessionsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "revoke_user_sessions", "*auth.RevokeUserSessionsPayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeUserSessionsAuthPath(userID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "revoke_user_sessions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeUserSessionsRequest returns an encoder for requests sent to the
+// auth revoke_user_sessions server.
+func EncodeRevokeUserSessionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.RevokeUserSessionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "revoke_user_sessions", "*auth.RevokeUserSessionsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeUserSessionsResponse returns a decoder for responses returned by
+// the auth revoke_user_sessions endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRevokeUserSessionsResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRevokeUserSessionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke_user_sessions", err)
+			}
+			return nil, NewRevokeUserSessionsForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke_user_sessions", err)
+			}
+			return nil, NewRevokeUserSessionsInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke_user_sessions", err)
+			}
+			return nil, NewRevokeUserSessionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "revoke_user_sessions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListSessionsRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "list_sessions" endpoint
+func (c *Client) BuildListSessionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSessionsAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "list_sessions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSessionsRequest returns an encoder for requests sent to the auth
+// list_sessions server.
+func EncodeListSessionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.ListSessionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "list_sessions", "*auth.ListSessionsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListSessionsResponse returns a decoder for responses returned by the
+// auth list_sessions endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListSessionsResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListSessionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSessionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_sessions", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateSessionResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "list_sessions", err)
+			}
+			res := NewListSessionsSessionOK(body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_sessions", err)
+			}
+			return nil, NewListSessionsInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_sessions", err)
+			}
+			return nil, NewListSessionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "list_sessions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteSessionRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "delete_session" endpoint
+func (c *Client) BuildDeleteSessionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*auth.DeleteSessionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "delete_session", "*auth.DeleteSessionPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteSessionAuthPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "delete_session", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteSessionRequest returns an encoder for requests sent to the auth
+// delete_session server.
+func EncodeDeleteSessionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.DeleteSessionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "delete_session", "*auth.DeleteSessionPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteSessionResponse returns a decoder for responses returned by the
+// auth delete_session endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteSessionResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "not_found" (type auth.NotFound): http.StatusNotFound
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteSessionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_session", err)
+			}
+			return nil, NewDeleteSessionInternalError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_session", err)
+			}
+			return nil, NewDeleteSessionNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_session", err)
+			}
+			return nil, NewDeleteSessionUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "delete_session", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLinkIdentityRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "link_identity" endpoint
+func (c *Client) BuildLinkIdentityRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		provider string
+	)
+	{
+		p, ok := v.(*auth.LinkIdentityPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "link_identity", "*auth.LinkIdentityPayload", v)
+		}
+		provider = p.Provider
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LinkIdentityAuthPath(provider)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "link_identity", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeLinkIdentityRequest returns an encoder for requests sent to the auth
+// link_identity server.
+func EncodeLinkIdentityRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.LinkIdentityPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "link_identity", "*auth.LinkIdentityPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewLinkIdentityRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "link_identity", err)
+		}
+		return nil
+	}
+}
+
+// DecodeLinkIdentityResponse returns a decoder for responses returned by the
+// auth link_identity endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeLinkIdentityResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "provider_error" (type auth.ProviderError): http.StatusBadGateway
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - "unknown_provider" (type auth.UnknownProvider): http.StatusNotFound
+//   - error: internal error
+func DecodeLinkIdentityResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body LinkIdentityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "link_identity", err)
+			}
+			err = ValidateLinkIdentityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "link_identity", err)
+			}
+			res := NewLinkIdentityResultOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "link_identity", err)
+			}
+			return nil, NewLinkIdentityInternalError(body)
+		case http.StatusBadGateway:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "link_identity", err)
+			}
+			return nil, NewLinkIdentityProviderError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "link_identity", err)
+			}
+			return nil, NewLinkIdentityUnauthorized(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "link_identity", err)
+			}
+			return nil, NewLinkIdentityUnknownProvider(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "link_identity", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListIdentitiesRequest instantiates a HTTP request object with method
+// and path set to call the "auth" service "list_identities" endpoint
+func (c *Client) BuildListIdentitiesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListIdentitiesAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "list_identities", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListIdentitiesRequest returns an encoder for requests sent to the auth
+// list_identities server.
+func EncodeListIdentitiesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.ListIdentitiesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "list_identities", "*auth.ListIdentitiesPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListIdentitiesResponse returns a decoder for responses returned by the
+// auth list_identities endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListIdentitiesResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListIdentitiesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListIdentitiesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_identities", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateLinkedIdentityResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "list_identities", err)
+			}
+			res := NewListIdentitiesLinkedIdentityOK(body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_identities", err)
+			}
+			return nil, NewListIdentitiesInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_identities", err)
+			}
+			return nil, NewListIdentitiesUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "list_identities", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnlinkIdentityRequest instantiates a HTTP request object with method
+// and path set to call the "auth" service "unlink_identity" endpoint
+func (c *Client) BuildUnlinkIdentityRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		provider string
+	)
+	{
+		p, ok := v.(*auth.UnlinkIdentityPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "unlink_identity", "*auth.UnlinkIdentityPayload", v)
+		}
+		provider = p.Provider
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnlinkIdentityAuthPath(provider)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "unlink_identity", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUnlinkIdentityRequest returns an encoder for requests sent to the auth
+// unlink_identity server.
+func EncodeUnlinkIdentityRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.UnlinkIdentityPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "unlink_identity", "*auth.UnlinkIdentityPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeUnlinkIdentityResponse returns a decoder for responses returned by the
+// auth unlink_identity endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUnlinkIdentityResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "last_identity" (type auth.LastIdentity): http.StatusConflict
+//   - "not_found" (type auth.NotFound): http.StatusNotFound
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUnlinkIdentityResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "unlink_identity", err)
+			}
+			return nil, NewUnlinkIdentityInternalError(body)
+		case http.StatusConflict:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "unlink_identity", err)
+			}
+			return nil, NewUnlinkIdentityLastIdentity(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "unlink_identity", err)
+			}
+			return nil, NewUnlinkIdentityNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "unlink_identity", err)
+			}
+			return nil, NewUnlinkIdentityUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "unlink_identity", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateWorkspaceRequest instantiates a HTTP request object with method
+// and path set to call the "auth" service "create_workspace" endpoint
+func (c *Client) BuildCreateWorkspaceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWorkspaceAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "create_workspace", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWorkspaceRequest returns an encoder for requests sent to the
+// auth create_workspace server.
+func EncodeCreateWorkspaceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.CreateWorkspacePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "create_workspace", "*auth.CreateWorkspacePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateWorkspaceRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "create_workspace", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWorkspaceResponse returns a decoder for responses returned by
+// the auth create_workspace endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateWorkspaceResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateWorkspaceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateWorkspaceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_workspace", err)
+			}
+			err = ValidateCreateWorkspaceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "create_workspace", err)
+			}
+			res := NewCreateWorkspaceWorkspaceCreated(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_workspace", err)
+			}
+			return nil, NewCreateWorkspaceInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_workspace", err)
+			}
+			return nil, NewCreateWorkspaceUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "create_workspace", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWorkspacesRequest instantiates a HTTP request object with method
+// and path set to call the "auth" service "list_workspaces" endpoint
+func (c *Client) BuildListWorkspacesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWorkspacesAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "list_workspaces", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWorkspacesRequest returns an encoder for requests sent to the auth
+// list_workspaces server.
+func EncodeListWorkspacesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.ListWorkspacesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "list_workspaces", "*auth.ListWorkspacesPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListWorkspacesResponse returns a decoder for responses returned by the
+// auth list_workspaces endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListWorkspacesResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListWorkspacesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWorkspacesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_workspaces", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWorkspaceResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "list_workspaces", err)
+			}
+			res := NewListWorkspacesWorkspaceOK(body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_workspaces", err)
+			}
+			return nil, NewListWorkspacesInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_workspaces", err)
+			}
+			return nil, NewListWorkspacesUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "list_workspaces", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddWorkspaceMemberRequest instantiates a HTTP request object with
+// method and path set to call the "auth" service "add_workspace_member"
+// endpoint
+func (c *Client) BuildAddWorkspaceMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		workspaceID string
+	)
+	{
+		p, ok := v.(*auth.AddWorkspaceMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "add_workspace_member", "*auth.AddWorkspaceMemberPayload", v)
+		}
+		workspaceID = p.WorkspaceID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddWorkspaceMemberAuthPath(workspaceID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "add_workspace_member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddWorkspaceMemberRequest returns an encoder for requests sent to the
+// auth add_workspace_member server.
+func EncodeAddWorkspaceMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.AddWorkspaceMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "add_workspace_member", "*auth.AddWorkspaceMemberPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewAddWorkspaceMemberRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "add_workspace_member", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAddWorkspaceMemberResponse returns a decoder for responses returned by
+// the auth add_workspace_member endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeAddWorkspaceMemberResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "not_found" (type auth.NotFound): http.StatusNotFound
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAddWorkspaceMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "add_workspace_member", err)
+			}
+			return nil, NewAddWorkspaceMemberForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "add_workspace_member", err)
+			}
+			return nil, NewAddWorkspaceMemberInternalError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "add_workspace_member", err)
+			}
+			return nil, NewAddWorkspaceMemberNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "add_workspace_member", err)
+			}
+			return nil, NewAddWorkspaceMemberUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "add_workspace_member", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRemoveWorkspaceMemberRequest instantiates a HTTP request object with
+// method and path set to call the "auth" service "remove_workspace_member"
+// endpoint
+func (c *Client) BuildRemoveWorkspaceMemberRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		workspaceID string
+		userID      string
+	)
+	{
+		p, ok := v.(*auth.RemoveWorkspaceMemberPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "remove_workspace_member", "*auth.RemoveWorkspaceMemberPayload", v)
+		}
+		workspaceID = p.WorkspaceID
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveWorkspaceMemberAuthPath(workspaceID, userID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "remove_workspace_member", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRemoveWorkspaceMemberRequest returns an encoder for requests sent to
+// the auth remove_workspace_member server.
+func EncodeRemoveWorkspaceMemberRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.RemoveWorkspaceMemberPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "remove_workspace_member", "*auth.RemoveWorkspaceMemberPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRemoveWorkspaceMemberResponse returns a decoder for responses returned
+// by the auth remove_workspace_member endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeRemoveWorkspaceMemberResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "not_found" (type auth.NotFound): http.StatusNotFound
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRemoveWorkspaceMemberResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "remove_workspace_member", err)
+			}
+			return nil, NewRemoveWorkspaceMemberForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "remove_workspace_member", err)
+			}
+			return nil, NewRemoveWorkspaceMemberInternalError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "remove_workspace_member", err)
+			}
+			return nil, NewRemoveWorkspaceMemberNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "remove_workspace_member", err)
+			}
+			return nil, NewRemoveWorkspaceMemberUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "remove_workspace_member", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSwitchWorkspaceRequest instantiates a HTTP request object with method
+// and path set to call the "auth" service "switch_workspace" endpoint
+func (c *Client) BuildSwitchWorkspaceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		workspaceID string
+	)
+	{
+		p, ok := v.(*auth.SwitchWorkspacePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "switch_workspace", "*auth.SwitchWorkspacePayload", v)
+		}
+		workspaceID = p.WorkspaceID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SwitchWorkspaceAuthPath(workspaceID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "switch_workspace", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSwitchWorkspaceRequest returns an encoder for requests sent to the
+// auth switch_workspace server.
+func EncodeSwitchWorkspaceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.SwitchWorkspacePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "switch_workspace", "*auth.SwitchWorkspacePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeSwitchWorkspaceResponse returns a decoder for responses returned by
+// the auth switch_workspace endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeSwitchWorkspaceResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "not_found" (type auth.NotFound): http.StatusNotFound
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSwitchWorkspaceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SwitchWorkspaceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "switch_workspace", err)
+			}
+			err = ValidateSwitchWorkspaceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "switch_workspace", err)
+			}
+			res := NewSwitchWorkspaceTokenResultOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "switch_workspace", err)
+			}
+			return nil, NewSwitchWorkspaceInternalError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "switch_workspace", err)
+			}
+			return nil, NewSwitchWorkspaceNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "switch_workspace", err)
+			}
+			return nil, NewSwitchWorkspaceUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "switch_workspace", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSwitchDefaultWorkspaceRequest instantiates a HTTP request object with
+// method and path set to call the "auth" service "switch_default_workspace"
+// endpoint
+func (c *Client) BuildSwitchDefaultWorkspaceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SwitchDefaultWorkspaceAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "switch_default_workspace", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSwitchDefaultWorkspaceRequest returns an encoder for requests sent to
+// the auth switch_default_workspace server.
+func EncodeSwitchDefaultWorkspaceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.SwitchDefaultWorkspacePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "switch_default_workspace", "*auth.SwitchDefaultWorkspacePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeSwitchDefaultWorkspaceResponse returns a decoder for responses
+// returned by the auth switch_default_workspace endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeSwitchDefaultWorkspaceResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSwitchDefaultWorkspaceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SwitchDefaultWorkspaceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "switch_default_workspace", err)
+			}
+			err = ValidateSwitchDefaultWorkspaceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "switch_default_workspace", err)
+			}
+			res := NewSwitchDefaultWorkspaceTokenResultOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "switch_default_workspace", err)
+			}
+			return nil, NewSwitchDefaultWorkspaceInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "switch_default_workspace", err)
+			}
+			return nil, NewSwitchDefaultWorkspaceUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "switch_default_workspace", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildJwksRequest instantiates a HTTP request object with method and path set
+// to call the "auth" service "jwks" endpoint
+func (c *Client) BuildJwksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: JwksAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "jwks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeJwksResponse returns a decoder for responses returned by the auth jwks
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeJwksResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeJwksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body JwksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "jwks", err)
+			}
+			err = ValidateJwksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "jwks", err)
+			}
+			res := NewJwksResultOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "jwks", err)
+			}
+			return nil, NewJwksInternalError(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "jwks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateTokenRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "create_token" endpoint
+func (c *Client) BuildCreateTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateTokenAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "create_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateTokenRequest returns an encoder for requests sent to the auth
+// create_token server.
+func EncodeCreateTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.CreateTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "create_token", "*auth.CreateTokenPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateTokenRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "create_token", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateTokenResponse returns a decoder for responses returned by the
+// auth create_token endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateTokenResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_token", err)
+			}
+			err = ValidateCreateTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "create_token", err)
+			}
+			res := NewCreateTokenCreatedTokenCreated(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_token", err)
+			}
+			return nil, NewCreateTokenForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_token", err)
+			}
+			return nil, NewCreateTokenInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_token", err)
+			}
+			return nil, NewCreateTokenUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "create_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListTokensRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "list_tokens" endpoint
+func (c *Client) BuildListTokensRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTokensAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "list_tokens", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListTokensRequest returns an encoder for requests sent to the auth
+// list_tokens server.
+func EncodeListTokensRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.ListTokensPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "list_tokens", "*auth.ListTokensPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListTokensResponse returns a decoder for responses returned by the
+// auth list_tokens endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListTokensResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListTokensResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListTokensResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_tokens", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidatePersonalAccessTokenResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "list_tokens", err)
+			}
+			res := NewListTokensPersonalAccessTokenOK(body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_tokens", err)
+			}
+			return nil, NewListTokensInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_tokens", err)
+			}
+			return nil, NewListTokensUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "list_tokens", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteTokenRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "delete_token" endpoint
+func (c *Client) BuildDeleteTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*auth.DeleteTokenPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "delete_token", "*auth.DeleteTokenPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTokenAuthPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "delete_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteTokenRequest returns an encoder for requests sent to the auth
+// delete_token server.
+func EncodeDeleteTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.DeleteTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "delete_token", "*auth.DeleteTokenPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteTokenResponse returns a decoder for responses returned by the
+// auth delete_token endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteTokenResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "not_found" (type auth.NotFound): http.StatusNotFound
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_token", err)
+			}
+			return nil, NewDeleteTokenInternalError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_token", err)
+			}
+			return nil, NewDeleteTokenNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_token", err)
+			}
+			return nil, NewDeleteTokenUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "delete_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateBotRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "create_bot" endpoint
+func (c *Client) BuildCreateBotRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateBotAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "create_bot", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateBotRequest returns an encoder for requests sent to the auth
+// create_bot server.
+func EncodeCreateBotRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.CreateBotPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "create_bot", "*auth.CreateBotPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateBotRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "create_bot", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateBotResponse returns a decoder for responses returned by the auth
+// create_bot endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+// DecodeCreateBotResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateBotResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateBotResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_bot", err)
+			}
+			err = ValidateCreateBotResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "create_bot", err)
+			}
+			res := NewCreateBotCreatedBotCreated(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_bot", err)
+			}
+			return nil, NewCreateBotForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_bot", err)
+			}
+			return nil, NewCreateBotInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "create_bot", err)
+			}
+			return nil, NewCreateBotUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "create_bot", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListBotsRequest instantiates a HTTP request object with method and path
+// set to call the "auth" service "list_bots" endpoint
+func (c *Client) BuildListBotsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListBotsAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "list_bots", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListBotsRequest returns an encoder for requests sent to the auth
+// list_bots server.
+func EncodeListBotsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.ListBotsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "list_bots", "*auth.ListBotsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListBotsResponse returns a decoder for responses returned by the auth
+// list_bots endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeListBotsResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListBotsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListBotsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_bots", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateBotResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "list_bots", err)
+			}
+			res := NewListBotsBotOK(body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_bots", err)
+			}
+			return nil, NewListBotsForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_bots", err)
+			}
+			return nil, NewListBotsInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_bots", err)
+			}
+			return nil, NewListBotsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "list_bots", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteBotRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "delete_bot" endpoint
+func (c *Client) BuildDeleteBotRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*auth.DeleteBotPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "delete_bot", "*auth.DeleteBotPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteBotAuthPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "delete_bot", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteBotRequest returns an encoder for requests sent to the auth
+// delete_bot server.
+func EncodeDeleteBotRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.DeleteBotPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "delete_bot", "*auth.DeleteBotPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteBotResponse returns a decoder for responses returned by the auth
+// delete_bot endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+// DecodeDeleteBotResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "not_found" (type auth.NotFound): http.StatusNotFound
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteBotResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_bot", err)
+			}
+			return nil, NewDeleteBotForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_bot", err)
+			}
+			return nil, NewDeleteBotInternalError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_bot", err)
+			}
+			return nil, NewDeleteBotNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "delete_bot", err)
+			}
+			return nil, NewDeleteBotUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "delete_bot", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildClientTokenRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "client_token" endpoint
+func (c *Client) BuildClientTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ClientTokenAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "client_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeClientTokenRequest returns an encoder for requests sent to the auth
+// client_token server.
+func EncodeClientTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.ClientTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "client_token", "*auth.ClientTokenPayload", v)
+		}
+		body := NewClientTokenRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "client_token", err)
+		}
+		req.SetBasicAuth(p.ClientID, p.ClientSecret)
+		return nil
+	}
+}
+
+// DecodeClientTokenResponse returns a decoder for responses returned by the
+// auth client_token endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeClientTokenResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "invalid_scope" (type auth.InvalidScope): http.StatusBadRequest
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeClientTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ClientTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "client_token", err)
+			}
+			err = ValidateClientTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "client_token", err)
+			}
+			res := NewClientTokenResultOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "client_token", err)
+			}
+			return nil, NewClientTokenInternalError(body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "client_token", err)
+			}
+			return nil, NewClientTokenInvalidScope(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "client_token", err)
+			}
+			return nil, NewClientTokenUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "client_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildTokenIntrospectRequest instantiates a HTTP request object with method
+// and path set to call the "auth" service "token_introspect" endpoint
+func (c *Client) BuildTokenIntrospectRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TokenIntrospectAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "token_introspect", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeTokenIntrospectRequest returns an encoder for requests sent to the
+// auth token_introspect server.
+func EncodeTokenIntrospectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.TokenIntrospectPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "token_introspect", "*auth.TokenIntrospectPayload", v)
+		}
+		body := NewTokenIntrospectRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "token_introspect", err)
+		}
+		req.SetBasicAuth(p.ClientID, p.ClientSecret)
+		return nil
+	}
+}
+
+// DecodeTokenIntrospectResponse returns a decoder for responses returned by
+// the auth token_introspect endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeTokenIntrospectResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeTokenIntrospectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body TokenIntrospectResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "token_introspect", err)
+			}
+			err = ValidateTokenIntrospectResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "token_introspect", err)
+			}
+			res := NewTokenIntrospectionOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "token_introspect", err)
+			}
+			return nil, NewTokenIntrospectInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "token_introspect", err)
+			}
+			return nil, NewTokenIntrospectUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "token_introspect", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetUserRolesRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "get_user_roles" endpoint
+func (c *Client) BuildGetUserRolesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+	)
+	{
+		p, ok := v.(*auth.GetUserRolesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "get_user_roles", "*auth.GetUserRolesPayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetUserRolesAuthPath(userID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "get_user_roles", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetUserRolesRequest returns an encoder for requests sent to the auth
+// get_user_roles server.
+func EncodeGetUserRolesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.GetUserRolesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "get_user_roles", "*auth.GetUserRolesPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetUserRolesResponse returns a decoder for responses returned by the
+// auth get_user_roles endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetUserRolesResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetUserRolesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetUserRolesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "get_user_roles", err)
+			}
+			err = ValidateGetUserRolesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "get_user_roles", err)
+			}
+			res := NewGetUserRolesUserRolesOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "get_user_roles", err)
+			}
+			return nil, NewGetUserRolesForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "get_user_roles", err)
+			}
+			return nil, NewGetUserRolesInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "get_user_roles", err)
+			}
+			return nil, NewGetUserRolesUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "get_user_roles", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGrantRoleRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "grant_role" endpoint
+func (c *Client) BuildGrantRoleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+	)
+	{
+		p, ok := v.(*auth.GrantRolePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "grant_role", "*auth.GrantRolePayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GrantRoleAuthPath(userID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "grant_role", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGrantRoleRequest returns an encoder for requests sent to the auth
+// grant_role server.
+func EncodeGrantRoleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.GrantRolePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "grant_role", "*auth.GrantRolePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewGrantRoleRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "grant_role", err)
+		}
+		return nil
+	}
+}
+
+// DecodeGrantRoleResponse returns a decoder for responses returned by the auth
+// grant_role endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+// DecodeGrantRoleResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGrantRoleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GrantRoleResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "grant_role", err)
+			}
+			err = ValidateGrantRoleResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "grant_role", err)
+			}
+			res := NewGrantRoleUserRolesOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "grant_role", err)
+			}
+			return nil, NewGrantRoleForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "grant_role", err)
+			}
+			return nil, NewGrantRoleInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "grant_role", err)
+			}
+			return nil, NewGrantRoleUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "grant_role", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeRoleRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "revoke_role" endpoint
+func (c *Client) BuildRevokeRoleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID string
+		role   string
+	)
+	{
+		p, ok := v.(*auth.RevokeRolePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("auth", "revoke_role", "*auth.RevokeRolePayload", v)
+		}
+		userID = p.UserID
+		role = p.Role
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeRoleAuthPath(userID, role)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "revoke_role", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeRoleRequest returns an encoder for requests sent to the auth
+// revoke_role server.
+func EncodeRevokeRoleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.RevokeRolePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "revoke_role", "*auth.RevokeRolePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeRoleResponse returns a decoder for responses returned by the
+// auth revoke_role endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRevokeRoleResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRevokeRoleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RevokeRoleResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke_role", err)
+			}
+			err = ValidateRevokeRoleResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "revoke_role", err)
+			}
+			res := NewRevokeRoleUserRolesOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke_role", err)
+			}
+			return nil, NewRevokeRoleForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke_role", err)
+			}
+			return nil, NewRevokeRoleInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "revoke_role", err)
+			}
+			return nil, NewRevokeRoleUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "revoke_role", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeviceAuthorizationRequest instantiates a HTTP request object with
+// method and path set to call the "auth" service "device_authorization"
+// endpoint
+func (c *Client) BuildDeviceAuthorizationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeviceAuthorizationAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "device_authorization", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeviceAuthorizationRequest returns an encoder for requests sent to the
+// auth device_authorization server.
+func EncodeDeviceAuthorizationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.DeviceAuthorizationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "device_authorization", "*auth.DeviceAuthorizationPayload", v)
+		}
+		body := NewDeviceAuthorizationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "device_authorization", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDeviceAuthorizationResponse returns a decoder for responses returned
+// by the auth device_authorization endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeviceAuthorizationResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unknown_provider" (type auth.UnknownProvider): http.StatusNotFound
+//   - error: internal error
+func DecodeDeviceAuthorizationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeviceAuthorizationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_authorization", err)
+			}
+			err = ValidateDeviceAuthorizationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "device_authorization", err)
+			}
+			res := NewDeviceAuthorizationResultOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_authorization", err)
+			}
+			return nil, NewDeviceAuthorizationInternalError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_authorization", err)
+			}
+			return nil, NewDeviceAuthorizationUnknownProvider(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "device_authorization", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeviceVerifyRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "device_verify" endpoint
+func (c *Client) BuildDeviceVerifyRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeviceVerifyAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "device_verify", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeviceVerifyRequest returns an encoder for requests sent to the auth
+// device_verify server.
+func EncodeDeviceVerifyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.DeviceVerifyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "device_verify", "*auth.DeviceVerifyPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("user_code", p.UserCode)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDeviceVerifyResponse returns a decoder for responses returned by the
+// auth device_verify endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeviceVerifyResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "invalid_user_code" (type auth.InvalidUserCode): http.StatusBadRequest
+//   - error: internal error
+func DecodeDeviceVerifyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_verify", err)
+			}
+			var (
+				consent    string
+				consentRaw string
+
+				cookies = resp.Cookies()
+			)
+			for _, c := range cookies {
+				switch c.Name {
+				case "device_consent":
+					consentRaw = c.Value
+				}
+			}
+			if consentRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("consent", "cookie"))
+			}
+			consent = consentRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "device_verify", err)
+			}
+			res := NewDeviceVerifyResultOK(body, consent)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_verify", err)
+			}
+			return nil, NewDeviceVerifyInternalError(body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_verify", err)
+			}
+			return nil, NewDeviceVerifyInvalidUserCode(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "device_verify", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeviceConfirmRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "device_confirm" endpoint
+func (c *Client) BuildDeviceConfirmRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeviceConfirmAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "device_confirm", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeviceConfirmRequest returns an encoder for requests sent to the auth
+// device_confirm server.
+func EncodeDeviceConfirmRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.DeviceConfirmPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "device_confirm", "*auth.DeviceConfirmPayload", v)
+		}
+		{
+			v := p.ConsentCookie
+			req.AddCookie(&http.Cookie{
+				Name:  "device_consent",
+				Value: v,
+			})
+		}
+		values := req.URL.Query()
+		values.Add("user_code", p.UserCode)
+		values.Add("consent", p.Consent)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDeviceConfirmResponse returns a decoder for responses returned by the
+// auth device_confirm endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeviceConfirmResponse may return the following errors:
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "invalid_consent" (type auth.InvalidConsent): http.StatusForbidden
+//   - "invalid_user_code" (type auth.InvalidUserCode): http.StatusBadRequest
+//   - "provider_error" (type auth.ProviderError): http.StatusBadGateway
+//   - "unknown_provider" (type auth.UnknownProvider): http.StatusNotFound
+//   - error: internal error
+func DecodeDeviceConfirmResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusFound:
+			var (
+				location string
+				err      error
+			)
+			locationRaw := resp.Header.Get("Location")
+			if locationRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("location", "header"))
+			}
+			location = locationRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "device_confirm", err)
+			}
+			res := NewDeviceConfirmResultFound(location)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_confirm", err)
+			}
+			return nil, NewDeviceConfirmInternalError(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_confirm", err)
+			}
+			return nil, NewDeviceConfirmInvalidConsent(body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_confirm", err)
+			}
+			return nil, NewDeviceConfirmInvalidUserCode(body)
+		case http.StatusBadGateway:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_confirm", err)
+			}
+			return nil, NewDeviceConfirmProviderError(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_confirm", err)
+			}
+			return nil, NewDeviceConfirmUnknownProvider(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "device_confirm", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeviceTokenRequest instantiates a HTTP request object with method and
+// path set to call the "auth" service "device_token" endpoint
+func (c *Client) BuildDeviceTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeviceTokenAuthPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "device_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeviceTokenRequest returns an encoder for requests sent to the auth
+// device_token server.
+func EncodeDeviceTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.DeviceTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "device_token", "*auth.DeviceTokenPayload", v)
+		}
+		body := NewDeviceTokenRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("auth", "device_token", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDeviceTokenResponse returns a decoder for responses returned by the
+// auth device_token endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeviceTokenResponse may return the following errors:
+//   - "authorization_pending" (type auth.AuthorizationPending): http.StatusBadRequest
+//   - "expired_token" (type auth.ExpiredToken): http.StatusBadRequest
+//   - "slow_down" (type auth.SlowDown): http.StatusBadRequest
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeviceTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeviceTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_token", err)
+			}
+			err = ValidateDeviceTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "device_token", err)
+			}
+			res := NewDeviceTokenTokenResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "authorization_pending":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("auth", "device_token", err)
+				}
+				return nil, NewDeviceTokenAuthorizationPending(body)
+			case "expired_token":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("auth", "device_token", err)
+				}
+				return nil, NewDeviceTokenExpiredToken(body)
+			case "slow_down":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("auth", "device_token", err)
+				}
+				return nil, NewDeviceTokenSlowDown(body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("auth", "device_token", resp.StatusCode, string(body))
+			}
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "device_token", err)
+			}
+			return nil, NewDeviceTokenInternalError(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "device_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListAuditEventsRequest instantiates a HTTP request object with method
+// and path set to call the "auth" service "list_audit_events" endpoint
+func (c *Client) BuildListAuditEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAuditEventsAuthPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("auth", "list_audit_events", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAuditEventsRequest returns an encoder for requests sent to the
+// auth list_audit_events server.
+func EncodeListAuditEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*auth.ListAuditEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("auth", "list_audit_events", "*auth.ListAuditEventsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("user_id", *p.UserID)
+		}
+		if p.Since != nil {
+			values.Add("since", fmt.Sprintf("%v", *p.Since))
+		}
+		if p.Until != nil {
+			values.Add("until", fmt.Sprintf("%v", *p.Until))
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListAuditEventsResponse returns a decoder for responses returned by
+// the auth list_audit_events endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListAuditEventsResponse may return the following errors:
+//   - "forbidden" (type auth.Forbidden): http.StatusForbidden
+//   - "internal_error" (type auth.InternalError): http.StatusInternalServerError
+//   - "unauthorized" (type auth.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListAuditEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAuditEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_audit_events", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAuditEventResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("auth", "list_audit_events", err)
+			}
+			res := NewListAuditEventsAuditEventOK(body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_audit_events", err)
+			}
+			return nil, NewListAuditEventsForbidden(body)
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_audit_events", err)
+			}
+			return nil, NewListAuditEventsInternalError(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("auth", "list_audit_events", err)
+			}
+			return nil, NewListAuditEventsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("auth", "list_audit_events", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalSessionResponseToAuthSession builds a value of type *auth.Session
+// from a value of type *SessionResponse.
+func unmarshalSessionResponseToAuthSession(v *SessionResponse) *auth.Session {
+	res := &auth.Session{
+		ID:         *v.ID,
+		UserAgent:  *v.UserAgent,
+		IP:         *v.IP,
+		CreatedAt:  *v.CreatedAt,
+		LastUsedAt: *v.LastUsedAt,
+		Current:    *v.Current,
+	}
+
+	return res
+}
+
+// unmarshalLinkedIdentityResponseToAuthLinkedIdentity builds a value of type
+// *auth.LinkedIdentity from a value of type *LinkedIdentityResponse.
+func unmarshalLinkedIdentityResponseToAuthLinkedIdentity(v *LinkedIdentityResponse) *auth.LinkedIdentity {
+	res := &auth.LinkedIdentity{
+		Provider: *v.Provider,
+		Login:    *v.Login,
+		Email:    v.Email,
+		LinkedAt: *v.LinkedAt,
+	}
+
+	return res
+}
+
+// unmarshalWorkspaceResponseToAuthWorkspace builds a value of type
+// *auth.Workspace from a value of type *WorkspaceResponse.
+func unmarshalWorkspaceResponseToAuthWorkspace(v *WorkspaceResponse) *auth.Workspace {
+	res := &auth.Workspace{
+		ID:        *v.ID,
+		Name:      *v.Name,
+		CreatedBy: *v.CreatedBy,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalJWKResponseBodyToAuthJWK builds a value of type *auth.JWK from a
+// value of type *JWKResponseBody.
+func unmarshalJWKResponseBodyToAuthJWK(v *JWKResponseBody) *auth.JWK {
+	res := &auth.JWK{
+		Kty: *v.Kty,
+		Kid: *v.Kid,
+		Use: *v.Use,
+		Alg: *v.Alg,
+		N:   *v.N,
+		E:   *v.E,
+	}
+
+	return res
+}
+
+// unmarshalPersonalAccessTokenResponseToAuthPersonalAccessToken builds a value
+// of type *auth.PersonalAccessToken from a value of type
+// *PersonalAccessTokenResponse.
+func unmarshalPersonalAccessTokenResponseToAuthPersonalAccessToken(v *PersonalAccessTokenResponse) *auth.PersonalAccessToken {
+	res := &auth.PersonalAccessToken{
+		ID:          *v.ID,
+		Name:        *v.Name,
+		CreatedAt:   *v.CreatedAt,
+		ExpiresAt:   v.ExpiresAt,
+		LastUsedAt:  v.LastUsedAt,
+		WorkspaceID: v.WorkspaceID,
+	}
+	res.Scopes = make([]string, len(v.Scopes))
+	for i, val := range v.Scopes {
+		res.Scopes[i] = val
+	}
+
+	return res
+}
+
+// unmarshalBotResponseToAuthBot builds a value of type *auth.Bot from a value
+// of type *BotResponse.
+func unmarshalBotResponseToAuthBot(v *BotResponse) *auth.Bot {
+	res := &auth.Bot{
+		ID:        *v.ID,
+		Name:      *v.Name,
+		UserID:    *v.UserID,
+		CreatedAt: *v.CreatedAt,
+	}
+	res.Scopes = make([]string, len(v.Scopes))
+	for i, val := range v.Scopes {
+		res.Scopes[i] = val
+	}
+
+	return res
+}
+
+// unmarshalAuditEventResponseToAuthAuditEvent builds a value of type
+// *auth.AuditEvent from a value of type *AuditEventResponse.
+func unmarshalAuditEventResponseToAuthAuditEvent(v *AuditEventResponse) *auth.AuditEvent {
+	res := &auth.AuditEvent{
+		ID:        *v.ID,
+		Type:      *v.Type,
+		UserID:    v.UserID,
+		ActorID:   v.ActorID,
+		IP:        v.IP,
+		Provider:  v.Provider,
+		Reason:    v.Reason,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
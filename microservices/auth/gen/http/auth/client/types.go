@@ -19,6 +19,109 @@ type IntrospectRequestBody struct {
 	Token string `form:"token" json:"token" xml:"token"`
 }
 
+// RefreshRequestBody is the type of the "auth" service "refresh" endpoint HTTP
+// request body.
+type RefreshRequestBody struct {
+	// Opaque refresh token
+	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+}
+
+// RevokeRequestBody is the type of the "auth" service "revoke" endpoint HTTP
+// request body.
+type RevokeRequestBody struct {
+	// Token to revoke
+	Token string `form:"token" json:"token" xml:"token"`
+	// Type of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
+// LinkIdentityRequestBody is the type of the "auth" service "link_identity"
+// endpoint HTTP request body.
+type LinkIdentityRequestBody struct {
+	// PKCE code challenge of the client (RFC 7636), its verifier is required by
+	// the callback
+	CodeChallenge string `form:"code_challenge" json:"code_challenge" xml:"code_challenge"`
+	// PKCE code challenge method
+	CodeChallengeMethod string `form:"code_challenge_method" json:"code_challenge_method" xml:"code_challenge_method"`
+}
+
+// CreateWorkspaceRequestBody is the type of the "auth" service
+// "create_workspace" endpoint HTTP request body.
+type CreateWorkspaceRequestBody struct {
+	// Workspace name
+	Name string `form:"name" json:"name" xml:"name"`
+}
+
+// AddWorkspaceMemberRequestBody is the type of the "auth" service
+// "add_workspace_member" endpoint HTTP request body.
+type AddWorkspaceMemberRequestBody struct {
+	// User to add
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+}
+
+// CreateTokenRequestBody is the type of the "auth" service "create_token"
+// endpoint HTTP request body.
+type CreateTokenRequestBody struct {
+	// Token name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Scopes to grant, a subset of the scopes of the user
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// Token lifetime in seconds, the token never expires if unset
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
+
+// CreateBotRequestBody is the type of the "auth" service "create_bot" endpoint
+// HTTP request body.
+type CreateBotRequestBody struct {
+	// Bot name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Scopes to grant to the API key
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+}
+
+// ClientTokenRequestBody is the type of the "auth" service "client_token"
+// endpoint HTTP request body.
+type ClientTokenRequestBody struct {
+	// OAuth grant type
+	GrantType string `form:"grant_type" json:"grant_type" xml:"grant_type"`
+	// Space separated scopes to grant, every scope of the client if unset
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// TokenIntrospectRequestBody is the type of the "auth" service
+// "token_introspect" endpoint HTTP request body.
+type TokenIntrospectRequestBody struct {
+	// Token to introspect
+	Token string `form:"token" json:"token" xml:"token"`
+	// Type of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
+// GrantRoleRequestBody is the type of the "auth" service "grant_role" endpoint
+// HTTP request body.
+type GrantRoleRequestBody struct {
+	// Role to grant
+	Role string `form:"role" json:"role" xml:"role"`
+}
+
+// DeviceAuthorizationRequestBody is the type of the "auth" service
+// "device_authorization" endpoint HTTP request body.
+type DeviceAuthorizationRequestBody struct {
+	// Identity provider the user logs in with
+	Provider string `form:"provider" json:"provider" xml:"provider"`
+	// Client requesting the authorization, shown to the user on the consent page
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+}
+
+// DeviceTokenRequestBody is the type of the "auth" service "device_token"
+// endpoint HTTP request body.
+type DeviceTokenRequestBody struct {
+	// OAuth grant type
+	GrantType string `form:"grant_type" json:"grant_type" xml:"grant_type"`
+	// Device verification code
+	DeviceCode string `form:"device_code" json:"device_code" xml:"device_code"`
+}
+
 // IntrospectResponseBody is the type of the "auth" service "introspect"
 // endpoint HTTP response body.
 type IntrospectResponseBody struct {
@@ -35,7 +138,7 @@ type IntrospectResponseBody struct {
 // AuthURLResponseBody is the type of the "auth" service "auth_url" endpoint
 // HTTP response body.
 type AuthURLResponseBody struct {
-	// GitHub OAuth authorization URL
+	// OAuth authorization URL of the identity provider
 	AuthURL *string `form:"auth_url,omitempty" json:"auth_url,omitempty" xml:"auth_url,omitempty"`
 	// OAuth state parameter for CSRF protection
 	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
@@ -50,8 +153,371 @@ type OauthCallbackResponseBody struct {
 	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
 	// Token expiration in seconds
 	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
-	// GitHub user ID
+	// Internal user ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Opaque refresh token
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn *int64 `form:"refresh_expires_in,omitempty" json:"refresh_expires_in,omitempty" xml:"refresh_expires_in,omitempty"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// RefreshResponseBody is the type of the "auth" service "refresh" endpoint
+// HTTP response body.
+type RefreshResponseBody struct {
+	// Opaque access token
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// Token type (Bearer)
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiration in seconds
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Internal user ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Opaque refresh token
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn *int64 `form:"refresh_expires_in,omitempty" json:"refresh_expires_in,omitempty" xml:"refresh_expires_in,omitempty"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// ListSessionsResponseBody is the type of the "auth" service "list_sessions"
+// endpoint HTTP response body.
+type ListSessionsResponseBody []*SessionResponse
+
+// LinkIdentityResponseBody is the type of the "auth" service "link_identity"
+// endpoint HTTP response body.
+type LinkIdentityResponseBody struct {
+	// OAuth authorization URL of the identity provider
+	AuthURL *string `form:"auth_url,omitempty" json:"auth_url,omitempty" xml:"auth_url,omitempty"`
+	// OAuth state parameter for CSRF protection
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+}
+
+// ListIdentitiesResponseBody is the type of the "auth" service
+// "list_identities" endpoint HTTP response body.
+type ListIdentitiesResponseBody []*LinkedIdentityResponse
+
+// CreateWorkspaceResponseBody is the type of the "auth" service
+// "create_workspace" endpoint HTTP response body.
+type CreateWorkspaceResponseBody struct {
+	// Workspace ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Workspace name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// User who created the workspace
+	CreatedBy *string `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListWorkspacesResponseBody is the type of the "auth" service
+// "list_workspaces" endpoint HTTP response body.
+type ListWorkspacesResponseBody []*WorkspaceResponse
+
+// SwitchWorkspaceResponseBody is the type of the "auth" service
+// "switch_workspace" endpoint HTTP response body.
+type SwitchWorkspaceResponseBody struct {
+	// Opaque access token
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// Token type (Bearer)
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiration in seconds
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Internal user ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Opaque refresh token
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn *int64 `form:"refresh_expires_in,omitempty" json:"refresh_expires_in,omitempty" xml:"refresh_expires_in,omitempty"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// SwitchDefaultWorkspaceResponseBody is the type of the "auth" service
+// "switch_default_workspace" endpoint HTTP response body.
+type SwitchDefaultWorkspaceResponseBody struct {
+	// Opaque access token
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// Token type (Bearer)
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiration in seconds
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Internal user ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Opaque refresh token
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn *int64 `form:"refresh_expires_in,omitempty" json:"refresh_expires_in,omitempty" xml:"refresh_expires_in,omitempty"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// JwksResponseBody is the type of the "auth" service "jwks" endpoint HTTP
+// response body.
+type JwksResponseBody struct {
+	// Published keys
+	Keys []*JWKResponseBody `form:"keys,omitempty" json:"keys,omitempty" xml:"keys,omitempty"`
+}
+
+// CreateTokenResponseBody is the type of the "auth" service "create_token"
+// endpoint HTTP response body.
+type CreateTokenResponseBody struct {
+	// Secret token to send as bearer token
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// Token ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Token name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Granted scopes
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Expiration timestamp, unset if the token never expires
+	ExpiresAt *int64 `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Workspace the token acts in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// ListTokensResponseBody is the type of the "auth" service "list_tokens"
+// endpoint HTTP response body.
+type ListTokensResponseBody []*PersonalAccessTokenResponse
+
+// CreateBotResponseBody is the type of the "auth" service "create_bot"
+// endpoint HTTP response body.
+type CreateBotResponseBody struct {
+	// Secret API key to send as bearer token
+	APIKey *string `form:"api_key,omitempty" json:"api_key,omitempty" xml:"api_key,omitempty"`
+	// Bot ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Bot name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// User ID of the bot in the other services
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Scopes granted to the API key
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListBotsResponseBody is the type of the "auth" service "list_bots" endpoint
+// HTTP response body.
+type ListBotsResponseBody []*BotResponse
+
+// ClientTokenResponseBody is the type of the "auth" service "client_token"
+// endpoint HTTP response body.
+type ClientTokenResponseBody struct {
+	// Service JWT
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// Token type (Bearer)
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiration in seconds
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Space separated granted scopes
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// TokenIntrospectResponseBody is the type of the "auth" service
+// "token_introspect" endpoint HTTP response body.
+type TokenIntrospectResponseBody struct {
+	// Whether the token is active
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Space separated scopes of the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Client the token was presented to, which introspects it
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// Login of the user of the token
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Token type (Bearer)
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiration timestamp
+	Exp *int64 `form:"exp,omitempty" json:"exp,omitempty" xml:"exp,omitempty"`
+	// Token issuance timestamp
+	Iat *int64 `form:"iat,omitempty" json:"iat,omitempty" xml:"iat,omitempty"`
+	// User ID of the token
+	Sub *string `form:"sub,omitempty" json:"sub,omitempty" xml:"sub,omitempty"`
+}
+
+// GetUserRolesResponseBody is the type of the "auth" service "get_user_roles"
+// endpoint HTTP response body.
+type GetUserRolesResponseBody struct {
+	// User ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Roles of the user, every user has the user role
+	Roles []string `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
+	// Scopes granted by the roles
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+}
+
+// GrantRoleResponseBody is the type of the "auth" service "grant_role"
+// endpoint HTTP response body.
+type GrantRoleResponseBody struct {
+	// User ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Roles of the user, every user has the user role
+	Roles []string `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
+	// Scopes granted by the roles
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+}
+
+// RevokeRoleResponseBody is the type of the "auth" service "revoke_role"
+// endpoint HTTP response body.
+type RevokeRoleResponseBody struct {
+	// User ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Roles of the user, every user has the user role
+	Roles []string `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
+	// Scopes granted by the roles
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+}
+
+// DeviceAuthorizationResponseBody is the type of the "auth" service
+// "device_authorization" endpoint HTTP response body.
+type DeviceAuthorizationResponseBody struct {
+	// Device verification code the client polls with
+	DeviceCode *string `form:"device_code,omitempty" json:"device_code,omitempty" xml:"device_code,omitempty"`
+	// Code the user enters on the verification page
+	UserCode *string `form:"user_code,omitempty" json:"user_code,omitempty" xml:"user_code,omitempty"`
+	// Verification page
+	VerificationURI *string `form:"verification_uri,omitempty" json:"verification_uri,omitempty" xml:"verification_uri,omitempty"`
+	// Verification page with the user code filled in
+	VerificationURIComplete *string `form:"verification_uri_complete,omitempty" json:"verification_uri_complete,omitempty" xml:"verification_uri_complete,omitempty"`
+	// Lifetime of the codes in seconds
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Minimum polling interval in seconds
+	Interval *int64 `form:"interval,omitempty" json:"interval,omitempty" xml:"interval,omitempty"`
+}
+
+// DeviceTokenResponseBody is the type of the "auth" service "device_token"
+// endpoint HTTP response body.
+type DeviceTokenResponseBody struct {
+	// Opaque access token
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// Token type (Bearer)
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiration in seconds
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Internal user ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Opaque refresh token
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn *int64 `form:"refresh_expires_in,omitempty" json:"refresh_expires_in,omitempty" xml:"refresh_expires_in,omitempty"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// ListAuditEventsResponseBody is the type of the "auth" service
+// "list_audit_events" endpoint HTTP response body.
+type ListAuditEventsResponseBody []*AuditEventResponse
+
+// SessionResponse is used to define fields on response body types.
+type SessionResponse struct {
+	// Session ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// User agent of the device
+	UserAgent *string `form:"user_agent,omitempty" json:"user_agent,omitempty" xml:"user_agent,omitempty"`
+	// IP address of the device
+	IP *string `form:"ip,omitempty" json:"ip,omitempty" xml:"ip,omitempty"`
+	// Login timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last use timestamp
+	LastUsedAt *int64 `form:"last_used_at,omitempty" json:"last_used_at,omitempty" xml:"last_used_at,omitempty"`
+	// Whether the session is the one of the request
+	Current *bool `form:"current,omitempty" json:"current,omitempty" xml:"current,omitempty"`
+}
+
+// LinkedIdentityResponse is used to define fields on response body types.
+type LinkedIdentityResponse struct {
+	// Identity provider
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// Login of the account at the provider
+	Login *string `form:"login,omitempty" json:"login,omitempty" xml:"login,omitempty"`
+	// Email address of the account
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Link timestamp
+	LinkedAt *int64 `form:"linked_at,omitempty" json:"linked_at,omitempty" xml:"linked_at,omitempty"`
+}
+
+// WorkspaceResponse is used to define fields on response body types.
+type WorkspaceResponse struct {
+	// Workspace ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Workspace name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// User who created the workspace
+	CreatedBy *string `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// JWKResponseBody is used to define fields on response body types.
+type JWKResponseBody struct {
+	// Key type
+	Kty *string `form:"kty,omitempty" json:"kty,omitempty" xml:"kty,omitempty"`
+	// Key ID
+	Kid *string `form:"kid,omitempty" json:"kid,omitempty" xml:"kid,omitempty"`
+	// Public key use
+	Use *string `form:"use,omitempty" json:"use,omitempty" xml:"use,omitempty"`
+	// Signing algorithm
+	Alg *string `form:"alg,omitempty" json:"alg,omitempty" xml:"alg,omitempty"`
+	// RSA modulus
+	N *string `form:"n,omitempty" json:"n,omitempty" xml:"n,omitempty"`
+	// RSA public exponent
+	E *string `form:"e,omitempty" json:"e,omitempty" xml:"e,omitempty"`
+}
+
+// PersonalAccessTokenResponse is used to define fields on response body types.
+type PersonalAccessTokenResponse struct {
+	// Token ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Token name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Granted scopes
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Expiration timestamp, unset if the token never expires
+	ExpiresAt *int64 `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Last use timestamp, unset if the token was never used
+	LastUsedAt *int64 `form:"last_used_at,omitempty" json:"last_used_at,omitempty" xml:"last_used_at,omitempty"`
+	// Workspace the token acts in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// BotResponse is used to define fields on response body types.
+type BotResponse struct {
+	// Bot ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Bot name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// User ID of the bot in the other services
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Scopes granted to the API key
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// AuditEventResponse is used to define fields on response body types.
+type AuditEventResponse struct {
+	// Event ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Event type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// User the event is about
 	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// User who performed the action, if not the user itself
+	ActorID *string `form:"actor_id,omitempty" json:"actor_id,omitempty" xml:"actor_id,omitempty"`
+	// IP address of the request
+	IP *string `form:"ip,omitempty" json:"ip,omitempty" xml:"ip,omitempty"`
+	// Identity provider
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// Reason of a failure or detail of the event
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Event timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 }
 
 // NewIntrospectRequestBody builds the HTTP request body from the payload of
@@ -63,6 +529,149 @@ func NewIntrospectRequestBody(p *auth.IntrospectPayload) *IntrospectRequestBody
 	return body
 }
 
+// NewRefreshRequestBody builds the HTTP request body from the payload of the
+// "refresh" endpoint of the "auth" service.
+func NewRefreshRequestBody(p *auth.RefreshPayload) *RefreshRequestBody {
+	body := &RefreshRequestBody{
+		RefreshToken: p.RefreshToken,
+	}
+	return body
+}
+
+// NewRevokeRequestBody builds the HTTP request body from the payload of the
+// "revoke" endpoint of the "auth" service.
+func NewRevokeRequestBody(p *auth.RevokePayload) *RevokeRequestBody {
+	body := &RevokeRequestBody{
+		Token:         p.Token,
+		TokenTypeHint: p.TokenTypeHint,
+	}
+	return body
+}
+
+// NewLinkIdentityRequestBody builds the HTTP request body from the payload of
+// the "link_identity" endpoint of the "auth" service.
+func NewLinkIdentityRequestBody(p *auth.LinkIdentityPayload) *LinkIdentityRequestBody {
+	body := &LinkIdentityRequestBody{
+		CodeChallenge:       p.CodeChallenge,
+		CodeChallengeMethod: p.CodeChallengeMethod,
+	}
+	{
+		var zero string
+		if body.CodeChallengeMethod == zero {
+			body.CodeChallengeMethod = "S256"
+		}
+	}
+	return body
+}
+
+// NewCreateWorkspaceRequestBody builds the HTTP request body from the payload
+// of the "create_workspace" endpoint of the "auth" service.
+func NewCreateWorkspaceRequestBody(p *auth.CreateWorkspacePayload) *CreateWorkspaceRequestBody {
+	body := &CreateWorkspaceRequestBody{
+		Name: p.Name,
+	}
+	return body
+}
+
+// NewAddWorkspaceMemberRequestBody builds the HTTP request body from the
+// payload of the "add_workspace_member" endpoint of the "auth" service.
+func NewAddWorkspaceMemberRequestBody(p *auth.AddWorkspaceMemberPayload) *AddWorkspaceMemberRequestBody {
+	body := &AddWorkspaceMemberRequestBody{
+		UserID: p.UserID,
+	}
+	return body
+}
+
+// NewCreateTokenRequestBody builds the HTTP request body from the payload of
+// the "create_token" endpoint of the "auth" service.
+func NewCreateTokenRequestBody(p *auth.CreateTokenPayload) *CreateTokenRequestBody {
+	body := &CreateTokenRequestBody{
+		Name:      p.Name,
+		ExpiresIn: p.ExpiresIn,
+	}
+	if p.Scopes != nil {
+		body.Scopes = make([]string, len(p.Scopes))
+		for i, val := range p.Scopes {
+			body.Scopes[i] = val
+		}
+	} else {
+		body.Scopes = []string{}
+	}
+	return body
+}
+
+// NewCreateBotRequestBody builds the HTTP request body from the payload of the
+// "create_bot" endpoint of the "auth" service.
+func NewCreateBotRequestBody(p *auth.CreateBotPayload) *CreateBotRequestBody {
+	body := &CreateBotRequestBody{
+		Name: p.Name,
+	}
+	if p.Scopes != nil {
+		body.Scopes = make([]string, len(p.Scopes))
+		for i, val := range p.Scopes {
+			body.Scopes[i] = val
+		}
+	} else {
+		body.Scopes = []string{}
+	}
+	return body
+}
+
+// NewClientTokenRequestBody builds the HTTP request body from the payload of
+// the "client_token" endpoint of the "auth" service.
+func NewClientTokenRequestBody(p *auth.ClientTokenPayload) *ClientTokenRequestBody {
+	body := &ClientTokenRequestBody{
+		GrantType: p.GrantType,
+		Scope:     p.Scope,
+	}
+	return body
+}
+
+// NewTokenIntrospectRequestBody builds the HTTP request body from the payload
+// of the "token_introspect" endpoint of the "auth" service.
+func NewTokenIntrospectRequestBody(p *auth.TokenIntrospectPayload) *TokenIntrospectRequestBody {
+	body := &TokenIntrospectRequestBody{
+		Token:         p.Token,
+		TokenTypeHint: p.TokenTypeHint,
+	}
+	return body
+}
+
+// NewGrantRoleRequestBody builds the HTTP request body from the payload of the
+// "grant_role" endpoint of the "auth" service.
+func NewGrantRoleRequestBody(p *auth.GrantRolePayload) *GrantRoleRequestBody {
+	body := &GrantRoleRequestBody{
+		Role: p.Role,
+	}
+	return body
+}
+
+// NewDeviceAuthorizationRequestBody builds the HTTP request body from the
+// payload of the "device_authorization" endpoint of the "auth" service.
+func NewDeviceAuthorizationRequestBody(p *auth.DeviceAuthorizationPayload) *DeviceAuthorizationRequestBody {
+	body := &DeviceAuthorizationRequestBody{
+		Provider: p.Provider,
+		ClientID: p.ClientID,
+	}
+	{
+		var zero string
+		if body.Provider == zero {
+			body.Provider = "github"
+		}
+	}
+	return body
+}
+
+// NewDeviceTokenRequestBody builds the HTTP request body from the payload of
+// the "device_token" endpoint of the "auth" service.
+func NewDeviceTokenRequestBody(p *auth.DeviceTokenPayload) *DeviceTokenRequestBody {
+	body := &DeviceTokenRequestBody{
+		GrantType:  p.GrantType,
+		DeviceCode: p.DeviceCode,
+	}
+	return body
+}
+
 // NewIntrospectResultOK builds a "auth" service "introspect" endpoint result
 // from a HTTP "OK" response.
 func NewIntrospectResultOK(body *IntrospectResponseBody) *auth.IntrospectResult {
@@ -97,6 +706,14 @@ func NewIntrospectInvalidToken(body string) auth.InvalidToken {
 	return v
 }
 
+// NewIntrospectTooManyRequests builds a auth service introspect endpoint
+// too_many_requests error.
+func NewIntrospectTooManyRequests(body string) auth.TooManyRequests {
+	v := auth.TooManyRequests(body)
+
+	return v
+}
+
 // NewAuthURLResultOK builds a "auth" service "auth_url" endpoint result from a
 // HTTP "OK" response.
 func NewAuthURLResultOK(body *AuthURLResponseBody) *auth.AuthURLResult {
@@ -116,23 +733,50 @@ func NewAuthURLInternalError(body string) auth.InternalError {
 	return v
 }
 
-// NewOauthCallbackResultOK builds a "auth" service "oauth_callback" endpoint
-// result from a HTTP "OK" response.
-func NewOauthCallbackResultOK(body *OauthCallbackResponseBody) *auth.OauthCallbackResult {
-	v := &auth.OauthCallbackResult{
-		AccessToken: *body.AccessToken,
-		TokenType:   *body.TokenType,
-		ExpiresIn:   *body.ExpiresIn,
-		UserID:      *body.UserID,
+// NewAuthURLProviderError builds a auth service auth_url endpoint
+// provider_error error.
+func NewAuthURLProviderError(body string) auth.ProviderError {
+	v := auth.ProviderError(body)
+
+	return v
+}
+
+// NewAuthURLTooManyRequests builds a auth service auth_url endpoint
+// too_many_requests error.
+func NewAuthURLTooManyRequests(body string) auth.TooManyRequests {
+	v := auth.TooManyRequests(body)
+
+	return v
+}
+
+// NewAuthURLUnknownProvider builds a auth service auth_url endpoint
+// unknown_provider error.
+func NewAuthURLUnknownProvider(body string) auth.UnknownProvider {
+	v := auth.UnknownProvider(body)
+
+	return v
+}
+
+// NewOauthCallbackTokenResultOK builds a "auth" service "oauth_callback"
+// endpoint result from a HTTP "OK" response.
+func NewOauthCallbackTokenResultOK(body *OauthCallbackResponseBody) *auth.TokenResult {
+	v := &auth.TokenResult{
+		AccessToken:      *body.AccessToken,
+		TokenType:        *body.TokenType,
+		ExpiresIn:        *body.ExpiresIn,
+		UserID:           *body.UserID,
+		RefreshToken:     *body.RefreshToken,
+		RefreshExpiresIn: *body.RefreshExpiresIn,
+		WorkspaceID:      body.WorkspaceID,
 	}
 
 	return v
 }
 
-// NewOauthCallbackGithubError builds a auth service oauth_callback endpoint
-// github_error error.
-func NewOauthCallbackGithubError(body string) auth.GithubError {
-	v := auth.GithubError(body)
+// NewOauthCallbackIdentityConflict builds a auth service oauth_callback
+// endpoint identity_conflict error.
+func NewOauthCallbackIdentityConflict(body string) auth.IdentityConflict {
+	v := auth.IdentityConflict(body)
 
 	return v
 }
@@ -161,37 +805,1386 @@ func NewOauthCallbackInvalidState(body string) auth.InvalidState {
 	return v
 }
 
-// ValidateIntrospectResponseBody runs the validations defined on
-// IntrospectResponseBody
-func ValidateIntrospectResponseBody(body *IntrospectResponseBody) (err error) {
-	if body.JWT == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("jwt", "body"))
-	}
-	if body.Active == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
-	}
-	return
+// NewOauthCallbackInvalidVerifier builds a auth service oauth_callback
+// endpoint invalid_verifier error.
+func NewOauthCallbackInvalidVerifier(body string) auth.InvalidVerifier {
+	v := auth.InvalidVerifier(body)
+
+	return v
 }
 
-// ValidateAuthURLResponseBody runs the validations defined on
-// auth_url_response_body
-func ValidateAuthURLResponseBody(body *AuthURLResponseBody) (err error) {
-	if body.AuthURL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("auth_url", "body"))
-	}
-	if body.State == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
-	}
-	return
+// NewOauthCallbackProviderError builds a auth service oauth_callback endpoint
+// provider_error error.
+func NewOauthCallbackProviderError(body string) auth.ProviderError {
+	v := auth.ProviderError(body)
+
+	return v
 }
 
-// ValidateOauthCallbackResponseBody runs the validations defined on
-// oauth_callback_response_body
-func ValidateOauthCallbackResponseBody(body *OauthCallbackResponseBody) (err error) {
-	if body.AccessToken == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
-	}
-	if body.TokenType == nil {
+// NewOauthCallbackTooManyRequests builds a auth service oauth_callback
+// endpoint too_many_requests error.
+func NewOauthCallbackTooManyRequests(body string) auth.TooManyRequests {
+	v := auth.TooManyRequests(body)
+
+	return v
+}
+
+// NewOauthCallbackUnknownProvider builds a auth service oauth_callback
+// endpoint unknown_provider error.
+func NewOauthCallbackUnknownProvider(body string) auth.UnknownProvider {
+	v := auth.UnknownProvider(body)
+
+	return v
+}
+
+// NewRefreshTokenResultOK builds a "auth" service "refresh" endpoint result
+// from a HTTP "OK" response.
+func NewRefreshTokenResultOK(body *RefreshResponseBody) *auth.TokenResult {
+	v := &auth.TokenResult{
+		AccessToken:      *body.AccessToken,
+		TokenType:        *body.TokenType,
+		ExpiresIn:        *body.ExpiresIn,
+		UserID:           *body.UserID,
+		RefreshToken:     *body.RefreshToken,
+		RefreshExpiresIn: *body.RefreshExpiresIn,
+		WorkspaceID:      body.WorkspaceID,
+	}
+
+	return v
+}
+
+// NewRefreshInternalError builds a auth service refresh endpoint
+// internal_error error.
+func NewRefreshInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewRefreshInvalidToken builds a auth service refresh endpoint invalid_token
+// error.
+func NewRefreshInvalidToken(body string) auth.InvalidToken {
+	v := auth.InvalidToken(body)
+
+	return v
+}
+
+// NewLogoutInternalError builds a auth service logout endpoint internal_error
+// error.
+func NewLogoutInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewLogoutUnauthorized builds a auth service logout endpoint unauthorized
+// error.
+func NewLogoutUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewRevokeInternalError builds a auth service revoke endpoint internal_error
+// error.
+func NewRevokeInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewRevokeUserSessionsForbidden builds a auth service revoke_user_sessions
+// endpoint forbidden error.
+func NewRevokeUserSessionsForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewRevokeUserSessionsInternalError builds a auth service
+// revoke_user_sessions endpoint internal_error error.
+func NewRevokeUserSessionsInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewRevokeUserSessionsUnauthorized builds a auth service revoke_user_sessions
+// endpoint unauthorized error.
+func NewRevokeUserSessionsUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewListSessionsSessionOK builds a "auth" service "list_sessions" endpoint
+// result from a HTTP "OK" response.
+func NewListSessionsSessionOK(body []*SessionResponse) []*auth.Session {
+	v := make([]*auth.Session, len(body))
+	for i, val := range body {
+		v[i] = unmarshalSessionResponseToAuthSession(val)
+	}
+
+	return v
+}
+
+// NewListSessionsInternalError builds a auth service list_sessions endpoint
+// internal_error error.
+func NewListSessionsInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewListSessionsUnauthorized builds a auth service list_sessions endpoint
+// unauthorized error.
+func NewListSessionsUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewDeleteSessionInternalError builds a auth service delete_session endpoint
+// internal_error error.
+func NewDeleteSessionInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewDeleteSessionNotFound builds a auth service delete_session endpoint
+// not_found error.
+func NewDeleteSessionNotFound(body string) auth.NotFound {
+	v := auth.NotFound(body)
+
+	return v
+}
+
+// NewDeleteSessionUnauthorized builds a auth service delete_session endpoint
+// unauthorized error.
+func NewDeleteSessionUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewLinkIdentityResultOK builds a "auth" service "link_identity" endpoint
+// result from a HTTP "OK" response.
+func NewLinkIdentityResultOK(body *LinkIdentityResponseBody) *auth.LinkIdentityResult {
+	v := &auth.LinkIdentityResult{
+		AuthURL: *body.AuthURL,
+		State:   *body.State,
+	}
+
+	return v
+}
+
+// NewLinkIdentityInternalError builds a auth service link_identity endpoint
+// internal_error error.
+func NewLinkIdentityInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewLinkIdentityProviderError builds a auth service link_identity endpoint
+// provider_error error.
+func NewLinkIdentityProviderError(body string) auth.ProviderError {
+	v := auth.ProviderError(body)
+
+	return v
+}
+
+// NewLinkIdentityUnauthorized builds a auth service link_identity endpoint
+// unauthorized error.
+func NewLinkIdentityUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewLinkIdentityUnknownProvider builds a auth service link_identity endpoint
+// unknown_provider error.
+func NewLinkIdentityUnknownProvider(body string) auth.UnknownProvider {
+	v := auth.UnknownProvider(body)
+
+	return v
+}
+
+// NewListIdentitiesLinkedIdentityOK builds a "auth" service "list_identities"
+// endpoint result from a HTTP "OK" response.
+func NewListIdentitiesLinkedIdentityOK(body []*LinkedIdentityResponse) []*auth.LinkedIdentity {
+	v := make([]*auth.LinkedIdentity, len(body))
+	for i, val := range body {
+		v[i] = unmarshalLinkedIdentityResponseToAuthLinkedIdentity(val)
+	}
+
+	return v
+}
+
+// NewListIdentitiesInternalError builds a auth service list_identities
+// endpoint internal_error error.
+func NewListIdentitiesInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewListIdentitiesUnauthorized builds a auth service list_identities endpoint
+// unauthorized error.
+func NewListIdentitiesUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewUnlinkIdentityInternalError builds a auth service unlink_identity
+// endpoint internal_error error.
+func NewUnlinkIdentityInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewUnlinkIdentityLastIdentity builds a auth service unlink_identity endpoint
+// last_identity error.
+func NewUnlinkIdentityLastIdentity(body string) auth.LastIdentity {
+	v := auth.LastIdentity(body)
+
+	return v
+}
+
+// NewUnlinkIdentityNotFound builds a auth service unlink_identity endpoint
+// not_found error.
+func NewUnlinkIdentityNotFound(body string) auth.NotFound {
+	v := auth.NotFound(body)
+
+	return v
+}
+
+// NewUnlinkIdentityUnauthorized builds a auth service unlink_identity endpoint
+// unauthorized error.
+func NewUnlinkIdentityUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewCreateWorkspaceWorkspaceCreated builds a "auth" service
+// "create_workspace" endpoint result from a HTTP "Created" response.
+func NewCreateWorkspaceWorkspaceCreated(body *CreateWorkspaceResponseBody) *auth.Workspace {
+	v := &auth.Workspace{
+		ID:        *body.ID,
+		Name:      *body.Name,
+		CreatedBy: *body.CreatedBy,
+		CreatedAt: *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewCreateWorkspaceInternalError builds a auth service create_workspace
+// endpoint internal_error error.
+func NewCreateWorkspaceInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewCreateWorkspaceUnauthorized builds a auth service create_workspace
+// endpoint unauthorized error.
+func NewCreateWorkspaceUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewListWorkspacesWorkspaceOK builds a "auth" service "list_workspaces"
+// endpoint result from a HTTP "OK" response.
+func NewListWorkspacesWorkspaceOK(body []*WorkspaceResponse) []*auth.Workspace {
+	v := make([]*auth.Workspace, len(body))
+	for i, val := range body {
+		v[i] = unmarshalWorkspaceResponseToAuthWorkspace(val)
+	}
+
+	return v
+}
+
+// NewListWorkspacesInternalError builds a auth service list_workspaces
+// endpoint internal_error error.
+func NewListWorkspacesInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewListWorkspacesUnauthorized builds a auth service list_workspaces endpoint
+// unauthorized error.
+func NewListWorkspacesUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewAddWorkspaceMemberForbidden builds a auth service add_workspace_member
+// endpoint forbidden error.
+func NewAddWorkspaceMemberForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewAddWorkspaceMemberInternalError builds a auth service
+// add_workspace_member endpoint internal_error error.
+func NewAddWorkspaceMemberInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewAddWorkspaceMemberNotFound builds a auth service add_workspace_member
+// endpoint not_found error.
+func NewAddWorkspaceMemberNotFound(body string) auth.NotFound {
+	v := auth.NotFound(body)
+
+	return v
+}
+
+// NewAddWorkspaceMemberUnauthorized builds a auth service add_workspace_member
+// endpoint unauthorized error.
+func NewAddWorkspaceMemberUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewRemoveWorkspaceMemberForbidden builds a auth service
+// remove_workspace_member endpoint forbidden error.
+func NewRemoveWorkspaceMemberForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewRemoveWorkspaceMemberInternalError builds a auth service
+// remove_workspace_member endpoint internal_error error.
+func NewRemoveWorkspaceMemberInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewRemoveWorkspaceMemberNotFound builds a auth service
+// remove_workspace_member endpoint not_found error.
+func NewRemoveWorkspaceMemberNotFound(body string) auth.NotFound {
+	v := auth.NotFound(body)
+
+	return v
+}
+
+// NewRemoveWorkspaceMemberUnauthorized builds a auth service
+// remove_workspace_member endpoint unauthorized error.
+func NewRemoveWorkspaceMemberUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewSwitchWorkspaceTokenResultOK builds a "auth" service "switch_workspace"
+// endpoint result from a HTTP "OK" response.
+func NewSwitchWorkspaceTokenResultOK(body *SwitchWorkspaceResponseBody) *auth.TokenResult {
+	v := &auth.TokenResult{
+		AccessToken:      *body.AccessToken,
+		TokenType:        *body.TokenType,
+		ExpiresIn:        *body.ExpiresIn,
+		UserID:           *body.UserID,
+		RefreshToken:     *body.RefreshToken,
+		RefreshExpiresIn: *body.RefreshExpiresIn,
+		WorkspaceID:      body.WorkspaceID,
+	}
+
+	return v
+}
+
+// NewSwitchWorkspaceInternalError builds a auth service switch_workspace
+// endpoint internal_error error.
+func NewSwitchWorkspaceInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewSwitchWorkspaceNotFound builds a auth service switch_workspace endpoint
+// not_found error.
+func NewSwitchWorkspaceNotFound(body string) auth.NotFound {
+	v := auth.NotFound(body)
+
+	return v
+}
+
+// NewSwitchWorkspaceUnauthorized builds a auth service switch_workspace
+// endpoint unauthorized error.
+func NewSwitchWorkspaceUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewSwitchDefaultWorkspaceTokenResultOK builds a "auth" service
+// "switch_default_workspace" endpoint result from a HTTP "OK" response.
+func NewSwitchDefaultWorkspaceTokenResultOK(body *SwitchDefaultWorkspaceResponseBody) *auth.TokenResult {
+	v := &auth.TokenResult{
+		AccessToken:      *body.AccessToken,
+		TokenType:        *body.TokenType,
+		ExpiresIn:        *body.ExpiresIn,
+		UserID:           *body.UserID,
+		RefreshToken:     *body.RefreshToken,
+		RefreshExpiresIn: *body.RefreshExpiresIn,
+		WorkspaceID:      body.WorkspaceID,
+	}
+
+	return v
+}
+
+// NewSwitchDefaultWorkspaceInternalError builds a auth service
+// switch_default_workspace endpoint internal_error error.
+func NewSwitchDefaultWorkspaceInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewSwitchDefaultWorkspaceUnauthorized builds a auth service
+// switch_default_workspace endpoint unauthorized error.
+func NewSwitchDefaultWorkspaceUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewJwksResultOK builds a "auth" service "jwks" endpoint result from a HTTP
+// "OK" response.
+func NewJwksResultOK(body *JwksResponseBody) *auth.JwksResult {
+	v := &auth.JwksResult{}
+	v.Keys = make([]*auth.JWK, len(body.Keys))
+	for i, val := range body.Keys {
+		v.Keys[i] = unmarshalJWKResponseBodyToAuthJWK(val)
+	}
+
+	return v
+}
+
+// NewJwksInternalError builds a auth service jwks endpoint internal_error
+// error.
+func NewJwksInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewCreateTokenCreatedTokenCreated builds a "auth" service "create_token"
+// endpoint result from a HTTP "Created" response.
+func NewCreateTokenCreatedTokenCreated(body *CreateTokenResponseBody) *auth.CreatedToken {
+	v := &auth.CreatedToken{
+		Token:       *body.Token,
+		ID:          *body.ID,
+		Name:        *body.Name,
+		CreatedAt:   *body.CreatedAt,
+		ExpiresAt:   body.ExpiresAt,
+		WorkspaceID: body.WorkspaceID,
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+
+	return v
+}
+
+// NewCreateTokenForbidden builds a auth service create_token endpoint
+// forbidden error.
+func NewCreateTokenForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewCreateTokenInternalError builds a auth service create_token endpoint
+// internal_error error.
+func NewCreateTokenInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewCreateTokenUnauthorized builds a auth service create_token endpoint
+// unauthorized error.
+func NewCreateTokenUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewListTokensPersonalAccessTokenOK builds a "auth" service "list_tokens"
+// endpoint result from a HTTP "OK" response.
+func NewListTokensPersonalAccessTokenOK(body []*PersonalAccessTokenResponse) []*auth.PersonalAccessToken {
+	v := make([]*auth.PersonalAccessToken, len(body))
+	for i, val := range body {
+		v[i] = unmarshalPersonalAccessTokenResponseToAuthPersonalAccessToken(val)
+	}
+
+	return v
+}
+
+// NewListTokensInternalError builds a auth service list_tokens endpoint
+// internal_error error.
+func NewListTokensInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewListTokensUnauthorized builds a auth service list_tokens endpoint
+// unauthorized error.
+func NewListTokensUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewDeleteTokenInternalError builds a auth service delete_token endpoint
+// internal_error error.
+func NewDeleteTokenInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewDeleteTokenNotFound builds a auth service delete_token endpoint not_found
+// error.
+func NewDeleteTokenNotFound(body string) auth.NotFound {
+	v := auth.NotFound(body)
+
+	return v
+}
+
+// NewDeleteTokenUnauthorized builds a auth service delete_token endpoint
+// unauthorized error.
+func NewDeleteTokenUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewCreateBotCreatedBotCreated builds a "auth" service "create_bot" endpoint
+// result from a HTTP "Created" response.
+func NewCreateBotCreatedBotCreated(body *CreateBotResponseBody) *auth.CreatedBot {
+	v := &auth.CreatedBot{
+		APIKey:    *body.APIKey,
+		ID:        *body.ID,
+		Name:      *body.Name,
+		UserID:    *body.UserID,
+		CreatedAt: *body.CreatedAt,
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+
+	return v
+}
+
+// NewCreateBotForbidden builds a auth service create_bot endpoint forbidden
+// error.
+func NewCreateBotForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewCreateBotInternalError builds a auth service create_bot endpoint
+// internal_error error.
+func NewCreateBotInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewCreateBotUnauthorized builds a auth service create_bot endpoint
+// unauthorized error.
+func NewCreateBotUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewListBotsBotOK builds a "auth" service "list_bots" endpoint result from a
+// HTTP "OK" response.
+func NewListBotsBotOK(body []*BotResponse) []*auth.Bot {
+	v := make([]*auth.Bot, len(body))
+	for i, val := range body {
+		v[i] = unmarshalBotResponseToAuthBot(val)
+	}
+
+	return v
+}
+
+// NewListBotsForbidden builds a auth service list_bots endpoint forbidden
+// error.
+func NewListBotsForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewListBotsInternalError builds a auth service list_bots endpoint
+// internal_error error.
+func NewListBotsInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewListBotsUnauthorized builds a auth service list_bots endpoint
+// unauthorized error.
+func NewListBotsUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewDeleteBotForbidden builds a auth service delete_bot endpoint forbidden
+// error.
+func NewDeleteBotForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewDeleteBotInternalError builds a auth service delete_bot endpoint
+// internal_error error.
+func NewDeleteBotInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewDeleteBotNotFound builds a auth service delete_bot endpoint not_found
+// error.
+func NewDeleteBotNotFound(body string) auth.NotFound {
+	v := auth.NotFound(body)
+
+	return v
+}
+
+// NewDeleteBotUnauthorized builds a auth service delete_bot endpoint
+// unauthorized error.
+func NewDeleteBotUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewClientTokenResultOK builds a "auth" service "client_token" endpoint
+// result from a HTTP "OK" response.
+func NewClientTokenResultOK(body *ClientTokenResponseBody) *auth.ClientTokenResult {
+	v := &auth.ClientTokenResult{
+		AccessToken: *body.AccessToken,
+		TokenType:   *body.TokenType,
+		ExpiresIn:   *body.ExpiresIn,
+		Scope:       *body.Scope,
+	}
+
+	return v
+}
+
+// NewClientTokenInternalError builds a auth service client_token endpoint
+// internal_error error.
+func NewClientTokenInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewClientTokenInvalidScope builds a auth service client_token endpoint
+// invalid_scope error.
+func NewClientTokenInvalidScope(body string) auth.InvalidScope {
+	v := auth.InvalidScope(body)
+
+	return v
+}
+
+// NewClientTokenUnauthorized builds a auth service client_token endpoint
+// unauthorized error.
+func NewClientTokenUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewTokenIntrospectionOK builds a "auth" service "token_introspect" endpoint
+// result from a HTTP "OK" response.
+func NewTokenIntrospectionOK(body *TokenIntrospectResponseBody) *auth.TokenIntrospection {
+	v := &auth.TokenIntrospection{
+		Active:    *body.Active,
+		Scope:     body.Scope,
+		ClientID:  body.ClientID,
+		Username:  body.Username,
+		TokenType: body.TokenType,
+		Exp:       body.Exp,
+		Iat:       body.Iat,
+		Sub:       body.Sub,
+	}
+
+	return v
+}
+
+// NewTokenIntrospectInternalError builds a auth service token_introspect
+// endpoint internal_error error.
+func NewTokenIntrospectInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewTokenIntrospectUnauthorized builds a auth service token_introspect
+// endpoint unauthorized error.
+func NewTokenIntrospectUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewGetUserRolesUserRolesOK builds a "auth" service "get_user_roles" endpoint
+// result from a HTTP "OK" response.
+func NewGetUserRolesUserRolesOK(body *GetUserRolesResponseBody) *auth.UserRoles {
+	v := &auth.UserRoles{
+		UserID: *body.UserID,
+	}
+	v.Roles = make([]string, len(body.Roles))
+	for i, val := range body.Roles {
+		v.Roles[i] = val
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+
+	return v
+}
+
+// NewGetUserRolesForbidden builds a auth service get_user_roles endpoint
+// forbidden error.
+func NewGetUserRolesForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewGetUserRolesInternalError builds a auth service get_user_roles endpoint
+// internal_error error.
+func NewGetUserRolesInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewGetUserRolesUnauthorized builds a auth service get_user_roles endpoint
+// unauthorized error.
+func NewGetUserRolesUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewGrantRoleUserRolesOK builds a "auth" service "grant_role" endpoint result
+// from a HTTP "OK" response.
+func NewGrantRoleUserRolesOK(body *GrantRoleResponseBody) *auth.UserRoles {
+	v := &auth.UserRoles{
+		UserID: *body.UserID,
+	}
+	v.Roles = make([]string, len(body.Roles))
+	for i, val := range body.Roles {
+		v.Roles[i] = val
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+
+	return v
+}
+
+// NewGrantRoleForbidden builds a auth service grant_role endpoint forbidden
+// error.
+func NewGrantRoleForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewGrantRoleInternalError builds a auth service grant_role endpoint
+// internal_error error.
+func NewGrantRoleInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewGrantRoleUnauthorized builds a auth service grant_role endpoint
+// unauthorized error.
+func NewGrantRoleUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewRevokeRoleUserRolesOK builds a "auth" service "revoke_role" endpoint
+// result from a HTTP "OK" response.
+func NewRevokeRoleUserRolesOK(body *RevokeRoleResponseBody) *auth.UserRoles {
+	v := &auth.UserRoles{
+		UserID: *body.UserID,
+	}
+	v.Roles = make([]string, len(body.Roles))
+	for i, val := range body.Roles {
+		v.Roles[i] = val
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+
+	return v
+}
+
+// NewRevokeRoleForbidden builds a auth service revoke_role endpoint forbidden
+// error.
+func NewRevokeRoleForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewRevokeRoleInternalError builds a auth service revoke_role endpoint
+// internal_error error.
+func NewRevokeRoleInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewRevokeRoleUnauthorized builds a auth service revoke_role endpoint
+// unauthorized error.
+func NewRevokeRoleUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// NewDeviceAuthorizationResultOK builds a "auth" service
+// "device_authorization" endpoint result from a HTTP "OK" response.
+func NewDeviceAuthorizationResultOK(body *DeviceAuthorizationResponseBody) *auth.DeviceAuthorizationResult {
+	v := &auth.DeviceAuthorizationResult{
+		DeviceCode:              *body.DeviceCode,
+		UserCode:                *body.UserCode,
+		VerificationURI:         *body.VerificationURI,
+		VerificationURIComplete: *body.VerificationURIComplete,
+		ExpiresIn:               *body.ExpiresIn,
+		Interval:                *body.Interval,
+	}
+
+	return v
+}
+
+// NewDeviceAuthorizationInternalError builds a auth service
+// device_authorization endpoint internal_error error.
+func NewDeviceAuthorizationInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewDeviceAuthorizationUnknownProvider builds a auth service
+// device_authorization endpoint unknown_provider error.
+func NewDeviceAuthorizationUnknownProvider(body string) auth.UnknownProvider {
+	v := auth.UnknownProvider(body)
+
+	return v
+}
+
+// NewDeviceVerifyResultOK builds a "auth" service "device_verify" endpoint
+// result from a HTTP "OK" response.
+func NewDeviceVerifyResultOK(body string, consent string) *auth.DeviceVerifyResult {
+	v := body
+	res := &auth.DeviceVerifyResult{
+		Page: v,
+	}
+	res.Consent = consent
+
+	return res
+}
+
+// NewDeviceVerifyInternalError builds a auth service device_verify endpoint
+// internal_error error.
+func NewDeviceVerifyInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewDeviceVerifyInvalidUserCode builds a auth service device_verify endpoint
+// invalid_user_code error.
+func NewDeviceVerifyInvalidUserCode(body string) auth.InvalidUserCode {
+	v := auth.InvalidUserCode(body)
+
+	return v
+}
+
+// NewDeviceConfirmResultFound builds a "auth" service "device_confirm"
+// endpoint result from a HTTP "Found" response.
+func NewDeviceConfirmResultFound(location string) *auth.DeviceConfirmResult {
+	v := &auth.DeviceConfirmResult{}
+	v.Location = location
+
+	return v
+}
+
+// NewDeviceConfirmInternalError builds a auth service device_confirm endpoint
+// internal_error error.
+func NewDeviceConfirmInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewDeviceConfirmInvalidConsent builds a auth service device_confirm endpoint
+// invalid_consent error.
+func NewDeviceConfirmInvalidConsent(body string) auth.InvalidConsent {
+	v := auth.InvalidConsent(body)
+
+	return v
+}
+
+// NewDeviceConfirmInvalidUserCode builds a auth service device_confirm
+// endpoint invalid_user_code error.
+func NewDeviceConfirmInvalidUserCode(body string) auth.InvalidUserCode {
+	v := auth.InvalidUserCode(body)
+
+	return v
+}
+
+// NewDeviceConfirmProviderError builds a auth service device_confirm endpoint
+// provider_error error.
+func NewDeviceConfirmProviderError(body string) auth.ProviderError {
+	v := auth.ProviderError(body)
+
+	return v
+}
+
+// NewDeviceConfirmUnknownProvider builds a auth service device_confirm
+// endpoint unknown_provider error.
+func NewDeviceConfirmUnknownProvider(body string) auth.UnknownProvider {
+	v := auth.UnknownProvider(body)
+
+	return v
+}
+
+// NewDeviceTokenTokenResultOK builds a "auth" service "device_token" endpoint
+// result from a HTTP "OK" response.
+func NewDeviceTokenTokenResultOK(body *DeviceTokenResponseBody) *auth.TokenResult {
+	v := &auth.TokenResult{
+		AccessToken:      *body.AccessToken,
+		TokenType:        *body.TokenType,
+		ExpiresIn:        *body.ExpiresIn,
+		UserID:           *body.UserID,
+		RefreshToken:     *body.RefreshToken,
+		RefreshExpiresIn: *body.RefreshExpiresIn,
+		WorkspaceID:      body.WorkspaceID,
+	}
+
+	return v
+}
+
+// NewDeviceTokenAuthorizationPending builds a auth service device_token
+// endpoint authorization_pending error.
+func NewDeviceTokenAuthorizationPending(body string) auth.AuthorizationPending {
+	v := auth.AuthorizationPending(body)
+
+	return v
+}
+
+// NewDeviceTokenExpiredToken builds a auth service device_token endpoint
+// expired_token error.
+func NewDeviceTokenExpiredToken(body string) auth.ExpiredToken {
+	v := auth.ExpiredToken(body)
+
+	return v
+}
+
+// NewDeviceTokenSlowDown builds a auth service device_token endpoint slow_down
+// error.
+func NewDeviceTokenSlowDown(body string) auth.SlowDown {
+	v := auth.SlowDown(body)
+
+	return v
+}
+
+// NewDeviceTokenInternalError builds a auth service device_token endpoint
+// internal_error error.
+func NewDeviceTokenInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewListAuditEventsAuditEventOK builds a "auth" service "list_audit_events"
+// endpoint result from a HTTP "OK" response.
+func NewListAuditEventsAuditEventOK(body []*AuditEventResponse) []*auth.AuditEvent {
+	v := make([]*auth.AuditEvent, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAuditEventResponseToAuthAuditEvent(val)
+	}
+
+	return v
+}
+
+// NewListAuditEventsForbidden builds a auth service list_audit_events endpoint
+// forbidden error.
+func NewListAuditEventsForbidden(body string) auth.Forbidden {
+	v := auth.Forbidden(body)
+
+	return v
+}
+
+// NewListAuditEventsInternalError builds a auth service list_audit_events
+// endpoint internal_error error.
+func NewListAuditEventsInternalError(body string) auth.InternalError {
+	v := auth.InternalError(body)
+
+	return v
+}
+
+// NewListAuditEventsUnauthorized builds a auth service list_audit_events
+// endpoint unauthorized error.
+func NewListAuditEventsUnauthorized(body string) auth.Unauthorized {
+	v := auth.Unauthorized(body)
+
+	return v
+}
+
+// ValidateIntrospectResponseBody runs the validations defined on
+// IntrospectResponseBody
+func ValidateIntrospectResponseBody(body *IntrospectResponseBody) (err error) {
+	if body.JWT == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("jwt", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	return
+}
+
+// ValidateAuthURLResponseBody runs the validations defined on
+// auth_url_response_body
+func ValidateAuthURLResponseBody(body *AuthURLResponseBody) (err error) {
+	if body.AuthURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("auth_url", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	return
+}
+
+// ValidateOauthCallbackResponseBody runs the validations defined on
+// oauth_callback_response_body
+func ValidateOauthCallbackResponseBody(body *OauthCallbackResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.TokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.RefreshToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_token", "body"))
+	}
+	if body.RefreshExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_expires_in", "body"))
+	}
+	return
+}
+
+// ValidateRefreshResponseBody runs the validations defined on
+// RefreshResponseBody
+func ValidateRefreshResponseBody(body *RefreshResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.TokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.RefreshToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_token", "body"))
+	}
+	if body.RefreshExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_expires_in", "body"))
+	}
+	return
+}
+
+// ValidateLinkIdentityResponseBody runs the validations defined on
+// link_identity_response_body
+func ValidateLinkIdentityResponseBody(body *LinkIdentityResponseBody) (err error) {
+	if body.AuthURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("auth_url", "body"))
+	}
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	return
+}
+
+// ValidateCreateWorkspaceResponseBody runs the validations defined on
+// create_workspace_response_body
+func ValidateCreateWorkspaceResponseBody(body *CreateWorkspaceResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateSwitchWorkspaceResponseBody runs the validations defined on
+// switch_workspace_response_body
+func ValidateSwitchWorkspaceResponseBody(body *SwitchWorkspaceResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.TokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.RefreshToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_token", "body"))
+	}
+	if body.RefreshExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_expires_in", "body"))
+	}
+	return
+}
+
+// ValidateSwitchDefaultWorkspaceResponseBody runs the validations defined on
+// switch_default_workspace_response_body
+func ValidateSwitchDefaultWorkspaceResponseBody(body *SwitchDefaultWorkspaceResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.TokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.RefreshToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_token", "body"))
+	}
+	if body.RefreshExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_expires_in", "body"))
+	}
+	return
+}
+
+// ValidateJwksResponseBody runs the validations defined on JwksResponseBody
+func ValidateJwksResponseBody(body *JwksResponseBody) (err error) {
+	if body.Keys == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keys", "body"))
+	}
+	for _, e := range body.Keys {
+		if e != nil {
+			if err2 := ValidateJWKResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateTokenResponseBody runs the validations defined on
+// create_token_response_body
+func ValidateCreateTokenResponseBody(body *CreateTokenResponseBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateCreateBotResponseBody runs the validations defined on
+// create_bot_response_body
+func ValidateCreateBotResponseBody(body *CreateBotResponseBody) (err error) {
+	if body.APIKey == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("api_key", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateClientTokenResponseBody runs the validations defined on
+// client_token_response_body
+func ValidateClientTokenResponseBody(body *ClientTokenResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.TokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	if body.Scope == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope", "body"))
+	}
+	return
+}
+
+// ValidateTokenIntrospectResponseBody runs the validations defined on
+// token_introspect_response_body
+func ValidateTokenIntrospectResponseBody(body *TokenIntrospectResponseBody) (err error) {
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	return
+}
+
+// ValidateGetUserRolesResponseBody runs the validations defined on
+// get_user_roles_response_body
+func ValidateGetUserRolesResponseBody(body *GetUserRolesResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Roles == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("roles", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	return
+}
+
+// ValidateGrantRoleResponseBody runs the validations defined on
+// grant_role_response_body
+func ValidateGrantRoleResponseBody(body *GrantRoleResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Roles == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("roles", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	return
+}
+
+// ValidateRevokeRoleResponseBody runs the validations defined on
+// revoke_role_response_body
+func ValidateRevokeRoleResponseBody(body *RevokeRoleResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Roles == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("roles", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	return
+}
+
+// ValidateDeviceAuthorizationResponseBody runs the validations defined on
+// device_authorization_response_body
+func ValidateDeviceAuthorizationResponseBody(body *DeviceAuthorizationResponseBody) (err error) {
+	if body.DeviceCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("device_code", "body"))
+	}
+	if body.UserCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_code", "body"))
+	}
+	if body.VerificationURI == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("verification_uri", "body"))
+	}
+	if body.VerificationURIComplete == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("verification_uri_complete", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	if body.Interval == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("interval", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenResponseBody runs the validations defined on
+// device_token_response_body
+func ValidateDeviceTokenResponseBody(body *DeviceTokenResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.TokenType == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
 	}
 	if body.ExpiresIn == nil {
@@ -200,5 +2193,146 @@ func ValidateOauthCallbackResponseBody(body *OauthCallbackResponseBody) (err err
 	if body.UserID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
 	}
+	if body.RefreshToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_token", "body"))
+	}
+	if body.RefreshExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_expires_in", "body"))
+	}
+	return
+}
+
+// ValidateSessionResponse runs the validations defined on SessionResponse
+func ValidateSessionResponse(body *SessionResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserAgent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_agent", "body"))
+	}
+	if body.IP == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ip", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.LastUsedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("last_used_at", "body"))
+	}
+	if body.Current == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("current", "body"))
+	}
+	return
+}
+
+// ValidateLinkedIdentityResponse runs the validations defined on
+// LinkedIdentityResponse
+func ValidateLinkedIdentityResponse(body *LinkedIdentityResponse) (err error) {
+	if body.Provider == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("provider", "body"))
+	}
+	if body.Login == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("login", "body"))
+	}
+	if body.LinkedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("linked_at", "body"))
+	}
+	return
+}
+
+// ValidateWorkspaceResponse runs the validations defined on WorkspaceResponse
+func ValidateWorkspaceResponse(body *WorkspaceResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateJWKResponseBody runs the validations defined on JWKResponseBody
+func ValidateJWKResponseBody(body *JWKResponseBody) (err error) {
+	if body.Kty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kty", "body"))
+	}
+	if body.Kid == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kid", "body"))
+	}
+	if body.Use == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("use", "body"))
+	}
+	if body.Alg == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("alg", "body"))
+	}
+	if body.N == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("n", "body"))
+	}
+	if body.E == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("e", "body"))
+	}
+	return
+}
+
+// ValidatePersonalAccessTokenResponse runs the validations defined on
+// PersonalAccessTokenResponse
+func ValidatePersonalAccessTokenResponse(body *PersonalAccessTokenResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateBotResponse runs the validations defined on BotResponse
+func ValidateBotResponse(body *BotResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateAuditEventResponse runs the validations defined on AuditEventResponse
+func ValidateAuditEventResponse(body *AuditEventResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "state_created" || *body.Type == "login_succeeded" || *body.Type == "login_failed" || *body.Type == "introspection_failed" || *body.Type == "token_revoked" || *body.Type == "role_granted" || *body.Type == "role_revoked" || *body.Type == "rate_limited" || *body.Type == "locked_out" || *body.Type == "identity_linked" || *body.Type == "identity_unlinked" || *body.Type == "workspace_member_added" || *body.Type == "workspace_member_removed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"state_created", "login_succeeded", "login_failed", "introspection_failed", "token_revoked", "role_granted", "role_revoked", "rate_limited", "locked_out", "identity_linked", "identity_unlinked", "workspace_member_added", "workspace_member_removed"}))
+		}
+	}
 	return
 }
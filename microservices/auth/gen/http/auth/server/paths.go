@@ -7,17 +7,181 @@
 
 package server
 
+import (
+	"fmt"
+)
+
 // IntrospectAuthPath returns the URL path to the auth service introspect HTTP endpoint.
 func IntrospectAuthPath() string {
 	return "/introspect"
 }
 
 // AuthURLAuthPath returns the URL path to the auth service auth_url HTTP endpoint.
-func AuthURLAuthPath() string {
-	return "/auth/github"
+func AuthURLAuthPath(provider string) string {
+	return fmt.Sprintf("/auth/%v", provider)
 }
 
 // OauthCallbackAuthPath returns the URL path to the auth service oauth_callback HTTP endpoint.
-func OauthCallbackAuthPath() string {
-	return "/auth/github/callback"
+func OauthCallbackAuthPath(provider string) string {
+	return fmt.Sprintf("/auth/%v/callback", provider)
+}
+
+// RefreshAuthPath returns the URL path to the auth service refresh HTTP endpoint.
+func RefreshAuthPath() string {
+	return "/auth/token/refresh"
+}
+
+// LogoutAuthPath returns the URL path to the auth service logout HTTP endpoint.
+func LogoutAuthPath() string {
+	return "/auth/logout"
+}
+
+// RevokeAuthPath returns the URL path to the auth service revoke HTTP endpoint.
+func RevokeAuthPath() string {
+	return "/auth/revoke"
+}
+
+// RevokeUserSessionsAuthPath returns the URL path to the auth service revoke_user_sessions HTTP endpoint.
+func RevokeUserSessionsAuthPath(userID string) string {
+	return fmt.Sprintf("/auth/users/%v/revoke", userID)
+}
+
+// ListSessionsAuthPath returns the URL path to the auth service list_sessions HTTP endpoint.
+func ListSessionsAuthPath() string {
+	return "/auth/sessions"
+}
+
+// DeleteSessionAuthPath returns the URL path to the auth service delete_session HTTP endpoint.
+func DeleteSessionAuthPath(id string) string {
+	return fmt.Sprintf("/auth/sessions/%v", id)
+}
+
+// LinkIdentityAuthPath returns the URL path to the auth service link_identity HTTP endpoint.
+func LinkIdentityAuthPath(provider string) string {
+	return fmt.Sprintf("/auth/identities/%v", provider)
+}
+
+// ListIdentitiesAuthPath returns the URL path to the auth service list_identities HTTP endpoint.
+func ListIdentitiesAuthPath() string {
+	return "/auth/identities"
+}
+
+// UnlinkIdentityAuthPath returns the URL path to the auth service unlink_identity HTTP endpoint.
+func UnlinkIdentityAuthPath(provider string) string {
+	return fmt.Sprintf("/auth/identities/%v", provider)
+}
+
+// CreateWorkspaceAuthPath returns the URL path to the auth service create_workspace HTTP endpoint.
+func CreateWorkspaceAuthPath() string {
+	return "/auth/workspaces"
+}
+
+// ListWorkspacesAuthPath returns the URL path to the auth service list_workspaces HTTP endpoint.
+func ListWorkspacesAuthPath() string {
+	return "/auth/workspaces"
+}
+
+// AddWorkspaceMemberAuthPath returns the URL path to the auth service add_workspace_member HTTP endpoint.
+func AddWorkspaceMemberAuthPath(workspaceID string) string {
+	return fmt.Sprintf("/auth/workspaces/%v/members", workspaceID)
+}
+
+// RemoveWorkspaceMemberAuthPath returns the URL path to the auth service remove_workspace_member HTTP endpoint.
+func RemoveWorkspaceMemberAuthPath(workspaceID string, userID string) string {
+	return fmt.Sprintf("/auth/workspaces/%v/members/%v", workspaceID, userID)
+}
+
+// SwitchWorkspaceAuthPath returns the URL path to the auth service switch_workspace HTTP endpoint.
+func SwitchWorkspaceAuthPath(workspaceID string) string {
+	return fmt.Sprintf("/auth/workspaces/%v/token", workspaceID)
+}
+
+// SwitchDefaultWorkspaceAuthPath returns the URL path to the auth service switch_default_workspace HTTP endpoint.
+func SwitchDefaultWorkspaceAuthPath() string {
+	return "/auth/token/default-workspace"
+}
+
+// JwksAuthPath returns the URL path to the auth service jwks HTTP endpoint.
+func JwksAuthPath() string {
+	return "/.well-known/jwks.json"
+}
+
+// CreateTokenAuthPath returns the URL path to the auth service create_token HTTP endpoint.
+func CreateTokenAuthPath() string {
+	return "/auth/tokens"
+}
+
+// ListTokensAuthPath returns the URL path to the auth service list_tokens HTTP endpoint.
+func ListTokensAuthPath() string {
+	return "/auth/tokens"
+}
+
+// DeleteTokenAuthPath returns the URL path to the auth service delete_token HTTP endpoint.
+func DeleteTokenAuthPath(id string) string {
+	return fmt.Sprintf("/auth/tokens/%v", id)
+}
+
+// CreateBotAuthPath returns the URL path to the auth service create_bot HTTP endpoint.
+func CreateBotAuthPath() string {
+	return "/auth/bots"
+}
+
+// ListBotsAuthPath returns the URL path to the auth service list_bots HTTP endpoint.
+func ListBotsAuthPath() string {
+	return "/auth/bots"
+}
+
+// DeleteBotAuthPath returns the URL path to the auth service delete_bot HTTP endpoint.
+func DeleteBotAuthPath(id string) string {
+	return fmt.Sprintf("/auth/bots/%v", id)
+}
+
+// ClientTokenAuthPath returns the URL path to the auth service client_token HTTP endpoint.
+func ClientTokenAuthPath() string {
+	return "/auth/token"
+}
+
+// TokenIntrospectAuthPath returns the URL path to the auth service token_introspect HTTP endpoint.
+func TokenIntrospectAuthPath() string {
+	return "/auth/token/introspect"
+}
+
+// GetUserRolesAuthPath returns the URL path to the auth service get_user_roles HTTP endpoint.
+func GetUserRolesAuthPath(userID string) string {
+	return fmt.Sprintf("/auth/users/%v/roles", userID)
+}
+
+// GrantRoleAuthPath returns the URL path to the auth service grant_role HTTP endpoint.
+func GrantRoleAuthPath(userID string) string {
+	return fmt.Sprintf("/auth/users/%v/roles", userID)
+}
+
+// RevokeRoleAuthPath returns the URL path to the auth service revoke_role HTTP endpoint.
+func RevokeRoleAuthPath(userID string, role string) string {
+	return fmt.Sprintf("/auth/users/%v/roles/%v", userID, role)
+}
+
+// DeviceAuthorizationAuthPath returns the URL path to the auth service device_authorization HTTP endpoint.
+func DeviceAuthorizationAuthPath() string {
+	return "/auth/device/code"
+}
+
+// DeviceVerifyAuthPath returns the URL path to the auth service device_verify HTTP endpoint.
+func DeviceVerifyAuthPath() string {
+	return "/auth/device"
+}
+
+// DeviceConfirmAuthPath returns the URL path to the auth service device_confirm HTTP endpoint.
+func DeviceConfirmAuthPath() string {
+	return "/auth/device/confirm"
+}
+
+// DeviceTokenAuthPath returns the URL path to the auth service device_token HTTP endpoint.
+func DeviceTokenAuthPath() string {
+	return "/auth/device/token"
+}
+
+// ListAuditEventsAuthPath returns the URL path to the auth service list_audit_events HTTP endpoint.
+func ListAuditEventsAuthPath() string {
+	return "/auth/audit"
 }
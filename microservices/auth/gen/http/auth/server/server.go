@@ -18,10 +18,42 @@ import (
 
 // Server lists the auth service endpoint HTTP handlers.
 type Server struct {
-	Mounts        []*MountPoint
-	Introspect    http.Handler
-	AuthURL       http.Handler
-	OauthCallback http.Handler
+	Mounts                 []*MountPoint
+	Introspect             http.Handler
+	AuthURL                http.Handler
+	OauthCallback          http.Handler
+	Refresh                http.Handler
+	Logout                 http.Handler
+	Revoke                 http.Handler
+	RevokeUserSessions     http.Handler
+	ListSessions           http.Handler
+	DeleteSession          http.Handler
+	LinkIdentity           http.Handler
+	ListIdentities         http.Handler
+	UnlinkIdentity         http.Handler
+	CreateWorkspace        http.Handler
+	ListWorkspaces         http.Handler
+	AddWorkspaceMember     http.Handler
+	RemoveWorkspaceMember  http.Handler
+	SwitchWorkspace        http.Handler
+	SwitchDefaultWorkspace http.Handler
+	Jwks                   http.Handler
+	CreateToken            http.Handler
+	ListTokens             http.Handler
+	DeleteToken            http.Handler
+	CreateBot              http.Handler
+	ListBots               http.Handler
+	DeleteBot              http.Handler
+	ClientToken            http.Handler
+	TokenIntrospect        http.Handler
+	GetUserRoles           http.Handler
+	GrantRole              http.Handler
+	RevokeRole             http.Handler
+	DeviceAuthorization    http.Handler
+	DeviceVerify           http.Handler
+	DeviceConfirm          http.Handler
+	DeviceToken            http.Handler
+	ListAuditEvents        http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -52,12 +84,76 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"Introspect", "POST", "/introspect"},
-			{"AuthURL", "GET", "/auth/github"},
-			{"OauthCallback", "GET", "/auth/github/callback"},
+			{"AuthURL", "GET", "/auth/{provider}"},
+			{"OauthCallback", "GET", "/auth/{provider}/callback"},
+			{"Refresh", "POST", "/auth/token/refresh"},
+			{"Logout", "POST", "/auth/logout"},
+			{"Revoke", "POST", "/auth/revoke"},
+			{"RevokeUserSessions", "POST", "/auth/users/{user_id}/revoke"},
+			{"ListSessions", "GET", "/auth/sessions"},
+			{"DeleteSession", "DELETE", "/auth/sessions/{id}"},
+			{"LinkIdentity", "POST", "/auth/identities/{provider}"},
+			{"ListIdentities", "GET", "/auth/identities"},
+			{"UnlinkIdentity", "DELETE", "/auth/identities/{provider}"},
+			{"CreateWorkspace", "POST", "/auth/workspaces"},
+			{"ListWorkspaces", "GET", "/auth/workspaces"},
+			{"AddWorkspaceMember", "POST", "/auth/workspaces/{workspace_id}/members"},
+			{"RemoveWorkspaceMember", "DELETE", "/auth/workspaces/{workspace_id}/members/{user_id}"},
+			{"SwitchWorkspace", "POST", "/auth/workspaces/{workspace_id}/token"},
+			{"SwitchDefaultWorkspace", "POST", "/auth/token/default-workspace"},
+			{"Jwks", "GET", "/.well-known/jwks.json"},
+			{"CreateToken", "POST", "/auth/tokens"},
+			{"ListTokens", "GET", "/auth/tokens"},
+			{"DeleteToken", "DELETE", "/auth/tokens/{id}"},
+			{"CreateBot", "POST", "/auth/bots"},
+			{"ListBots", "GET", "/auth/bots"},
+			{"DeleteBot", "DELETE", "/auth/bots/{id}"},
+			{"ClientToken", "POST", "/auth/token"},
+			{"TokenIntrospect", "POST", "/auth/token/introspect"},
+			{"GetUserRoles", "GET", "/auth/users/{user_id}/roles"},
+			{"GrantRole", "POST", "/auth/users/{user_id}/roles"},
+			{"RevokeRole", "DELETE", "/auth/users/{user_id}/roles/{role}"},
+			{"DeviceAuthorization", "POST", "/auth/device/code"},
+			{"DeviceVerify", "GET", "/auth/device"},
+			{"DeviceConfirm", "GET", "/auth/device/confirm"},
+			{"DeviceToken", "POST", "/auth/device/token"},
+			{"ListAuditEvents", "GET", "/auth/audit"},
 		},
-		Introspect:    NewIntrospectHandler(e.Introspect, mux, decoder, encoder, errhandler, formatter),
-		AuthURL:       NewAuthURLHandler(e.AuthURL, mux, decoder, encoder, errhandler, formatter),
-		OauthCallback: NewOauthCallbackHandler(e.OauthCallback, mux, decoder, encoder, errhandler, formatter),
+		Introspect:             NewIntrospectHandler(e.Introspect, mux, decoder, encoder, errhandler, formatter),
+		AuthURL:                NewAuthURLHandler(e.AuthURL, mux, decoder, encoder, errhandler, formatter),
+		OauthCallback:          NewOauthCallbackHandler(e.OauthCallback, mux, decoder, encoder, errhandler, formatter),
+		Refresh:                NewRefreshHandler(e.Refresh, mux, decoder, encoder, errhandler, formatter),
+		Logout:                 NewLogoutHandler(e.Logout, mux, decoder, encoder, errhandler, formatter),
+		Revoke:                 NewRevokeHandler(e.Revoke, mux, decoder, encoder, errhandler, formatter),
+		RevokeUserSessions:     NewRevokeUserSessionsHandler(e.RevokeUserSessions, mux, decoder, encoder, errhandler, formatter),
+		ListSessions:           NewListSessionsHandler(e.ListSessions, mux, decoder, encoder, errhandler, formatter),
+		DeleteSession:          NewDeleteSessionHandler(e.DeleteSession, mux, decoder, encoder, errhandler, formatter),
+		LinkIdentity:           NewLinkIdentityHandler(e.LinkIdentity, mux, decoder, encoder, errhandler, formatter),
+		ListIdentities:         NewListIdentitiesHandler(e.ListIdentities, mux, decoder, encoder, errhandler, formatter),
+		UnlinkIdentity:         NewUnlinkIdentityHandler(e.UnlinkIdentity, mux, decoder, encoder, errhandler, formatter),
+		CreateWorkspace:        NewCreateWorkspaceHandler(e.CreateWorkspace, mux, decoder, encoder, errhandler, formatter),
+		ListWorkspaces:         NewListWorkspacesHandler(e.ListWorkspaces, mux, decoder, encoder, errhandler, formatter),
+		AddWorkspaceMember:     NewAddWorkspaceMemberHandler(e.AddWorkspaceMember, mux, decoder, encoder, errhandler, formatter),
+		RemoveWorkspaceMember:  NewRemoveWorkspaceMemberHandler(e.RemoveWorkspaceMember, mux, decoder, encoder, errhandler, formatter),
+		SwitchWorkspace:        NewSwitchWorkspaceHandler(e.SwitchWorkspace, mux, decoder, encoder, errhandler, formatter),
+		SwitchDefaultWorkspace: NewSwitchDefaultWorkspaceHandler(e.SwitchDefaultWorkspace, mux, decoder, encoder, errhandler, formatter),
+		Jwks:                   NewJwksHandler(e.Jwks, mux, decoder, encoder, errhandler, formatter),
+		CreateToken:            NewCreateTokenHandler(e.CreateToken, mux, decoder, encoder, errhandler, formatter),
+		ListTokens:             NewListTokensHandler(e.ListTokens, mux, decoder, encoder, errhandler, formatter),
+		DeleteToken:            NewDeleteTokenHandler(e.DeleteToken, mux, decoder, encoder, errhandler, formatter),
+		CreateBot:              NewCreateBotHandler(e.CreateBot, mux, decoder, encoder, errhandler, formatter),
+		ListBots:               NewListBotsHandler(e.ListBots, mux, decoder, encoder, errhandler, formatter),
+		DeleteBot:              NewDeleteBotHandler(e.DeleteBot, mux, decoder, encoder, errhandler, formatter),
+		ClientToken:            NewClientTokenHandler(e.ClientToken, mux, decoder, encoder, errhandler, formatter),
+		TokenIntrospect:        NewTokenIntrospectHandler(e.TokenIntrospect, mux, decoder, encoder, errhandler, formatter),
+		GetUserRoles:           NewGetUserRolesHandler(e.GetUserRoles, mux, decoder, encoder, errhandler, formatter),
+		GrantRole:              NewGrantRoleHandler(e.GrantRole, mux, decoder, encoder, errhandler, formatter),
+		RevokeRole:             NewRevokeRoleHandler(e.RevokeRole, mux, decoder, encoder, errhandler, formatter),
+		DeviceAuthorization:    NewDeviceAuthorizationHandler(e.DeviceAuthorization, mux, decoder, encoder, errhandler, formatter),
+		DeviceVerify:           NewDeviceVerifyHandler(e.DeviceVerify, mux, decoder, encoder, errhandler, formatter),
+		DeviceConfirm:          NewDeviceConfirmHandler(e.DeviceConfirm, mux, decoder, encoder, errhandler, formatter),
+		DeviceToken:            NewDeviceTokenHandler(e.DeviceToken, mux, decoder, encoder, errhandler, formatter),
+		ListAuditEvents:        NewListAuditEventsHandler(e.ListAuditEvents, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -69,6 +165,38 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Introspect = m(s.Introspect)
 	s.AuthURL = m(s.AuthURL)
 	s.OauthCallback = m(s.OauthCallback)
+	s.Refresh = m(s.Refresh)
+	s.Logout = m(s.Logout)
+	s.Revoke = m(s.Revoke)
+	s.RevokeUserSessions = m(s.RevokeUserSessions)
+	s.ListSessions = m(s.ListSessions)
+	s.DeleteSession = m(s.DeleteSession)
+	s.LinkIdentity = m(s.LinkIdentity)
+	s.ListIdentities = m(s.ListIdentities)
+	s.UnlinkIdentity = m(s.UnlinkIdentity)
+	s.CreateWorkspace = m(s.CreateWorkspace)
+	s.ListWorkspaces = m(s.ListWorkspaces)
+	s.AddWorkspaceMember = m(s.AddWorkspaceMember)
+	s.RemoveWorkspaceMember = m(s.RemoveWorkspaceMember)
+	s.SwitchWorkspace = m(s.SwitchWorkspace)
+	s.SwitchDefaultWorkspace = m(s.SwitchDefaultWorkspace)
+	s.Jwks = m(s.Jwks)
+	s.CreateToken = m(s.CreateToken)
+	s.ListTokens = m(s.ListTokens)
+	s.DeleteToken = m(s.DeleteToken)
+	s.CreateBot = m(s.CreateBot)
+	s.ListBots = m(s.ListBots)
+	s.DeleteBot = m(s.DeleteBot)
+	s.ClientToken = m(s.ClientToken)
+	s.TokenIntrospect = m(s.TokenIntrospect)
+	s.GetUserRoles = m(s.GetUserRoles)
+	s.GrantRole = m(s.GrantRole)
+	s.RevokeRole = m(s.RevokeRole)
+	s.DeviceAuthorization = m(s.DeviceAuthorization)
+	s.DeviceVerify = m(s.DeviceVerify)
+	s.DeviceConfirm = m(s.DeviceConfirm)
+	s.DeviceToken = m(s.DeviceToken)
+	s.ListAuditEvents = m(s.ListAuditEvents)
 }
 
 // MethodNames returns the methods served.
@@ -79,6 +207,38 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountIntrospectHandler(mux, h.Introspect)
 	MountAuthURLHandler(mux, h.AuthURL)
 	MountOauthCallbackHandler(mux, h.OauthCallback)
+	MountRefreshHandler(mux, h.Refresh)
+	MountLogoutHandler(mux, h.Logout)
+	MountRevokeHandler(mux, h.Revoke)
+	MountRevokeUserSessionsHandler(mux, h.RevokeUserSessions)
+	MountListSessionsHandler(mux, h.ListSessions)
+	MountDeleteSessionHandler(mux, h.DeleteSession)
+	MountLinkIdentityHandler(mux, h.LinkIdentity)
+	MountListIdentitiesHandler(mux, h.ListIdentities)
+	MountUnlinkIdentityHandler(mux, h.UnlinkIdentity)
+	MountCreateWorkspaceHandler(mux, h.CreateWorkspace)
+	MountListWorkspacesHandler(mux, h.ListWorkspaces)
+	MountAddWorkspaceMemberHandler(mux, h.AddWorkspaceMember)
+	MountRemoveWorkspaceMemberHandler(mux, h.RemoveWorkspaceMember)
+	MountSwitchWorkspaceHandler(mux, h.SwitchWorkspace)
+	MountSwitchDefaultWorkspaceHandler(mux, h.SwitchDefaultWorkspace)
+	MountJwksHandler(mux, h.Jwks)
+	MountCreateTokenHandler(mux, h.CreateToken)
+	MountListTokensHandler(mux, h.ListTokens)
+	MountDeleteTokenHandler(mux, h.DeleteToken)
+	MountCreateBotHandler(mux, h.CreateBot)
+	MountListBotsHandler(mux, h.ListBots)
+	MountDeleteBotHandler(mux, h.DeleteBot)
+	MountClientTokenHandler(mux, h.ClientToken)
+	MountTokenIntrospectHandler(mux, h.TokenIntrospect)
+	MountGetUserRolesHandler(mux, h.GetUserRoles)
+	MountGrantRoleHandler(mux, h.GrantRole)
+	MountRevokeRoleHandler(mux, h.RevokeRole)
+	MountDeviceAuthorizationHandler(mux, h.DeviceAuthorization)
+	MountDeviceVerifyHandler(mux, h.DeviceVerify)
+	MountDeviceConfirmHandler(mux, h.DeviceConfirm)
+	MountDeviceTokenHandler(mux, h.DeviceToken)
+	MountListAuditEventsHandler(mux, h.ListAuditEvents)
 }
 
 // Mount configures the mux to serve the auth endpoints.
@@ -146,7 +306,7 @@ func MountAuthURLHandler(mux goahttp.Muxer, h http.Handler) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/auth/github", f)
+	mux.Handle("GET", "/auth/{provider}", f)
 }
 
 // NewAuthURLHandler creates a HTTP handler which loads the HTTP request and
@@ -160,6 +320,7 @@ func NewAuthURLHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
+		decodeRequest  = DecodeAuthURLRequest(mux, decoder)
 		encodeResponse = EncodeAuthURLResponse(encoder)
 		encodeError    = EncodeAuthURLError(encoder, formatter)
 	)
@@ -167,8 +328,14 @@ func NewAuthURLHandler(
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
 		ctx = context.WithValue(ctx, goa.MethodKey, "auth_url")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
-		var err error
-		res, err := endpoint(ctx, nil)
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil {
 				errhandler(ctx, w, err)
@@ -190,7 +357,7 @@ func MountOauthCallbackHandler(mux goahttp.Muxer, h http.Handler) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/auth/github/callback", f)
+	mux.Handle("GET", "/auth/{provider}/callback", f)
 }
 
 // NewOauthCallbackHandler creates a HTTP handler which loads the HTTP request
@@ -231,3 +398,1628 @@ func NewOauthCallbackHandler(
 		}
 	})
 }
+
+// MountRefreshHandler configures the mux to serve the "auth" service "refresh"
+// endpoint.
+func MountRefreshHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/token/refresh", f)
+}
+
+// NewRefreshHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "refresh" endpoint.
+func NewRefreshHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRefreshRequest(mux, decoder)
+		encodeResponse = EncodeRefreshResponse(encoder)
+		encodeError    = EncodeRefreshError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "refresh")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountLogoutHandler configures the mux to serve the "auth" service "logout"
+// endpoint.
+func MountLogoutHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/logout", f)
+}
+
+// NewLogoutHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "logout" endpoint.
+func NewLogoutHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeLogoutRequest(mux, decoder)
+		encodeResponse = EncodeLogoutResponse(encoder)
+		encodeError    = EncodeLogoutError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "logout")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeHandler configures the mux to serve the "auth" service "revoke"
+// endpoint.
+func MountRevokeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/revoke", f)
+}
+
+// NewRevokeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "revoke" endpoint.
+func NewRevokeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeRequest(mux, decoder)
+		encodeResponse = EncodeRevokeResponse(encoder)
+		encodeError    = EncodeRevokeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeUserSessionsHandler configures the mux to serve the "auth"
+// service "revoke_user_sessions" endpoint.
+func MountRevokeUserSessionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/users/{user_id}/revoke", f)
+}
+
+// NewRevokeUserSessionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "revoke_user_sessions" endpoint.
+func NewRevokeUserSessionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeUserSessionsRequest(mux, decoder)
+		encodeResponse = EncodeRevokeUserSessionsResponse(encoder)
+		encodeError    = EncodeRevokeUserSessionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke_user_sessions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListSessionsHandler configures the mux to serve the "auth" service
+// "list_sessions" endpoint.
+func MountListSessionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/sessions", f)
+}
+
+// NewListSessionsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "list_sessions" endpoint.
+func NewListSessionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListSessionsRequest(mux, decoder)
+		encodeResponse = EncodeListSessionsResponse(encoder)
+		encodeError    = EncodeListSessionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_sessions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteSessionHandler configures the mux to serve the "auth" service
+// "delete_session" endpoint.
+func MountDeleteSessionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/auth/sessions/{id}", f)
+}
+
+// NewDeleteSessionHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "delete_session" endpoint.
+func NewDeleteSessionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteSessionRequest(mux, decoder)
+		encodeResponse = EncodeDeleteSessionResponse(encoder)
+		encodeError    = EncodeDeleteSessionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_session")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountLinkIdentityHandler configures the mux to serve the "auth" service
+// "link_identity" endpoint.
+func MountLinkIdentityHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/identities/{provider}", f)
+}
+
+// NewLinkIdentityHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "link_identity" endpoint.
+func NewLinkIdentityHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeLinkIdentityRequest(mux, decoder)
+		encodeResponse = EncodeLinkIdentityResponse(encoder)
+		encodeError    = EncodeLinkIdentityError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "link_identity")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListIdentitiesHandler configures the mux to serve the "auth" service
+// "list_identities" endpoint.
+func MountListIdentitiesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/identities", f)
+}
+
+// NewListIdentitiesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "list_identities" endpoint.
+func NewListIdentitiesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListIdentitiesRequest(mux, decoder)
+		encodeResponse = EncodeListIdentitiesResponse(encoder)
+		encodeError    = EncodeListIdentitiesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_identities")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUnlinkIdentityHandler configures the mux to serve the "auth" service
+// "unlink_identity" endpoint.
+func MountUnlinkIdentityHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/auth/identities/{provider}", f)
+}
+
+// NewUnlinkIdentityHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "unlink_identity" endpoint.
+func NewUnlinkIdentityHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUnlinkIdentityRequest(mux, decoder)
+		encodeResponse = EncodeUnlinkIdentityResponse(encoder)
+		encodeError    = EncodeUnlinkIdentityError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "unlink_identity")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateWorkspaceHandler configures the mux to serve the "auth" service
+// "create_workspace" endpoint.
+func MountCreateWorkspaceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/workspaces", f)
+}
+
+// NewCreateWorkspaceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "create_workspace" endpoint.
+func NewCreateWorkspaceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateWorkspaceRequest(mux, decoder)
+		encodeResponse = EncodeCreateWorkspaceResponse(encoder)
+		encodeError    = EncodeCreateWorkspaceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_workspace")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListWorkspacesHandler configures the mux to serve the "auth" service
+// "list_workspaces" endpoint.
+func MountListWorkspacesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/workspaces", f)
+}
+
+// NewListWorkspacesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "list_workspaces" endpoint.
+func NewListWorkspacesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWorkspacesRequest(mux, decoder)
+		encodeResponse = EncodeListWorkspacesResponse(encoder)
+		encodeError    = EncodeListWorkspacesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_workspaces")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountAddWorkspaceMemberHandler configures the mux to serve the "auth"
+// service "add_workspace_member" endpoint.
+func MountAddWorkspaceMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/workspaces/{workspace_id}/members", f)
+}
+
+// NewAddWorkspaceMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "add_workspace_member" endpoint.
+func NewAddWorkspaceMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddWorkspaceMemberRequest(mux, decoder)
+		encodeResponse = EncodeAddWorkspaceMemberResponse(encoder)
+		encodeError    = EncodeAddWorkspaceMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "add_workspace_member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRemoveWorkspaceMemberHandler configures the mux to serve the "auth"
+// service "remove_workspace_member" endpoint.
+func MountRemoveWorkspaceMemberHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/auth/workspaces/{workspace_id}/members/{user_id}", f)
+}
+
+// NewRemoveWorkspaceMemberHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "remove_workspace_member" endpoint.
+func NewRemoveWorkspaceMemberHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRemoveWorkspaceMemberRequest(mux, decoder)
+		encodeResponse = EncodeRemoveWorkspaceMemberResponse(encoder)
+		encodeError    = EncodeRemoveWorkspaceMemberError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove_workspace_member")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountSwitchWorkspaceHandler configures the mux to serve the "auth" service
+// "switch_workspace" endpoint.
+func MountSwitchWorkspaceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/workspaces/{workspace_id}/token", f)
+}
+
+// NewSwitchWorkspaceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "switch_workspace" endpoint.
+func NewSwitchWorkspaceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSwitchWorkspaceRequest(mux, decoder)
+		encodeResponse = EncodeSwitchWorkspaceResponse(encoder)
+		encodeError    = EncodeSwitchWorkspaceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "switch_workspace")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountSwitchDefaultWorkspaceHandler configures the mux to serve the "auth"
+// service "switch_default_workspace" endpoint.
+func MountSwitchDefaultWorkspaceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/token/default-workspace", f)
+}
+
+// NewSwitchDefaultWorkspaceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "switch_default_workspace" endpoint.
+func NewSwitchDefaultWorkspaceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSwitchDefaultWorkspaceRequest(mux, decoder)
+		encodeResponse = EncodeSwitchDefaultWorkspaceResponse(encoder)
+		encodeError    = EncodeSwitchDefaultWorkspaceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "switch_default_workspace")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountJwksHandler configures the mux to serve the "auth" service "jwks"
+// endpoint.
+func MountJwksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/.well-known/jwks.json", f)
+}
+
+// NewJwksHandler creates a HTTP handler which loads the HTTP request and calls
+// the "auth" service "jwks" endpoint.
+func NewJwksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeJwksResponse(encoder)
+		encodeError    = EncodeJwksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "jwks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateTokenHandler configures the mux to serve the "auth" service
+// "create_token" endpoint.
+func MountCreateTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/tokens", f)
+}
+
+// NewCreateTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "create_token" endpoint.
+func NewCreateTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateTokenRequest(mux, decoder)
+		encodeResponse = EncodeCreateTokenResponse(encoder)
+		encodeError    = EncodeCreateTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListTokensHandler configures the mux to serve the "auth" service
+// "list_tokens" endpoint.
+func MountListTokensHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/tokens", f)
+}
+
+// NewListTokensHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "list_tokens" endpoint.
+func NewListTokensHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListTokensRequest(mux, decoder)
+		encodeResponse = EncodeListTokensResponse(encoder)
+		encodeError    = EncodeListTokensError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_tokens")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteTokenHandler configures the mux to serve the "auth" service
+// "delete_token" endpoint.
+func MountDeleteTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/auth/tokens/{id}", f)
+}
+
+// NewDeleteTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "delete_token" endpoint.
+func NewDeleteTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteTokenRequest(mux, decoder)
+		encodeResponse = EncodeDeleteTokenResponse(encoder)
+		encodeError    = EncodeDeleteTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateBotHandler configures the mux to serve the "auth" service
+// "create_bot" endpoint.
+func MountCreateBotHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/bots", f)
+}
+
+// NewCreateBotHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "create_bot" endpoint.
+func NewCreateBotHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateBotRequest(mux, decoder)
+		encodeResponse = EncodeCreateBotResponse(encoder)
+		encodeError    = EncodeCreateBotError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_bot")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListBotsHandler configures the mux to serve the "auth" service
+// "list_bots" endpoint.
+func MountListBotsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/bots", f)
+}
+
+// NewListBotsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "list_bots" endpoint.
+func NewListBotsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListBotsRequest(mux, decoder)
+		encodeResponse = EncodeListBotsResponse(encoder)
+		encodeError    = EncodeListBotsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_bots")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteBotHandler configures the mux to serve the "auth" service
+// "delete_bot" endpoint.
+func MountDeleteBotHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/auth/bots/{id}", f)
+}
+
+// NewDeleteBotHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "delete_bot" endpoint.
+func NewDeleteBotHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteBotRequest(mux, decoder)
+		encodeResponse = EncodeDeleteBotResponse(encoder)
+		encodeError    = EncodeDeleteBotError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_bot")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountClientTokenHandler configures the mux to serve the "auth" service
+// "client_token" endpoint.
+func MountClientTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/token", f)
+}
+
+// NewClientTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "client_token" endpoint.
+func NewClientTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeClientTokenRequest(mux, decoder)
+		encodeResponse = EncodeClientTokenResponse(encoder)
+		encodeError    = EncodeClientTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "client_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountTokenIntrospectHandler configures the mux to serve the "auth" service
+// "token_introspect" endpoint.
+func MountTokenIntrospectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/token/introspect", f)
+}
+
+// NewTokenIntrospectHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "token_introspect" endpoint.
+func NewTokenIntrospectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTokenIntrospectRequest(mux, decoder)
+		encodeResponse = EncodeTokenIntrospectResponse(encoder)
+		encodeError    = EncodeTokenIntrospectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "token_introspect")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetUserRolesHandler configures the mux to serve the "auth" service
+// "get_user_roles" endpoint.
+func MountGetUserRolesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/users/{user_id}/roles", f)
+}
+
+// NewGetUserRolesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "get_user_roles" endpoint.
+func NewGetUserRolesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetUserRolesRequest(mux, decoder)
+		encodeResponse = EncodeGetUserRolesResponse(encoder)
+		encodeError    = EncodeGetUserRolesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_user_roles")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGrantRoleHandler configures the mux to serve the "auth" service
+// "grant_role" endpoint.
+func MountGrantRoleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/users/{user_id}/roles", f)
+}
+
+// NewGrantRoleHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "grant_role" endpoint.
+func NewGrantRoleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGrantRoleRequest(mux, decoder)
+		encodeResponse = EncodeGrantRoleResponse(encoder)
+		encodeError    = EncodeGrantRoleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "grant_role")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeRoleHandler configures the mux to serve the "auth" service
+// "revoke_role" endpoint.
+func MountRevokeRoleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/auth/users/{user_id}/roles/{role}", f)
+}
+
+// NewRevokeRoleHandler creates a HTTP handler which loads the HTTP request and
+// calls the "auth" service "revoke_role" endpoint.
+func NewRevokeRoleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeRoleRequest(mux, decoder)
+		encodeResponse = EncodeRevokeRoleResponse(encoder)
+		encodeError    = EncodeRevokeRoleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke_role")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeviceAuthorizationHandler configures the mux to serve the "auth"
+// service "device_authorization" endpoint.
+func MountDeviceAuthorizationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/device/code", f)
+}
+
+// NewDeviceAuthorizationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "device_authorization" endpoint.
+func NewDeviceAuthorizationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeviceAuthorizationRequest(mux, decoder)
+		encodeResponse = EncodeDeviceAuthorizationResponse(encoder)
+		encodeError    = EncodeDeviceAuthorizationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "device_authorization")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeviceVerifyHandler configures the mux to serve the "auth" service
+// "device_verify" endpoint.
+func MountDeviceVerifyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/device", f)
+}
+
+// NewDeviceVerifyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "device_verify" endpoint.
+func NewDeviceVerifyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeviceVerifyRequest(mux, decoder)
+		encodeResponse = EncodeDeviceVerifyResponse(encoder)
+		encodeError    = EncodeDeviceVerifyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "device_verify")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeviceConfirmHandler configures the mux to serve the "auth" service
+// "device_confirm" endpoint.
+func MountDeviceConfirmHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/device/confirm", f)
+}
+
+// NewDeviceConfirmHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "device_confirm" endpoint.
+func NewDeviceConfirmHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeviceConfirmRequest(mux, decoder)
+		encodeResponse = EncodeDeviceConfirmResponse(encoder)
+		encodeError    = EncodeDeviceConfirmError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "device_confirm")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeviceTokenHandler configures the mux to serve the "auth" service
+// "device_token" endpoint.
+func MountDeviceTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/auth/device/token", f)
+}
+
+// NewDeviceTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "auth" service "device_token" endpoint.
+func NewDeviceTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeviceTokenRequest(mux, decoder)
+		encodeResponse = EncodeDeviceTokenResponse(encoder)
+		encodeError    = EncodeDeviceTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "device_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListAuditEventsHandler configures the mux to serve the "auth" service
+// "list_audit_events" endpoint.
+func MountListAuditEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/auth/audit", f)
+}
+
+// NewListAuditEventsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "auth" service "list_audit_events" endpoint.
+func NewListAuditEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAuditEventsRequest(mux, decoder)
+		encodeResponse = EncodeListAuditEventsResponse(encoder)
+		encodeError    = EncodeListAuditEventsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_audit_events")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "auth")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
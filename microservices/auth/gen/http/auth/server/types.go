@@ -8,6 +8,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	goa "goa.design/goa/v3/pkg"
 	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
 )
@@ -19,6 +21,109 @@ type IntrospectRequestBody struct {
 	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
 }
 
+// RefreshRequestBody is the type of the "auth" service "refresh" endpoint HTTP
+// request body.
+type RefreshRequestBody struct {
+	// Opaque refresh token
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+}
+
+// RevokeRequestBody is the type of the "auth" service "revoke" endpoint HTTP
+// request body.
+type RevokeRequestBody struct {
+	// Token to revoke
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// Type of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
+// LinkIdentityRequestBody is the type of the "auth" service "link_identity"
+// endpoint HTTP request body.
+type LinkIdentityRequestBody struct {
+	// PKCE code challenge of the client (RFC 7636), its verifier is required by
+	// the callback
+	CodeChallenge *string `form:"code_challenge,omitempty" json:"code_challenge,omitempty" xml:"code_challenge,omitempty"`
+	// PKCE code challenge method
+	CodeChallengeMethod *string `form:"code_challenge_method,omitempty" json:"code_challenge_method,omitempty" xml:"code_challenge_method,omitempty"`
+}
+
+// CreateWorkspaceRequestBody is the type of the "auth" service
+// "create_workspace" endpoint HTTP request body.
+type CreateWorkspaceRequestBody struct {
+	// Workspace name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// AddWorkspaceMemberRequestBody is the type of the "auth" service
+// "add_workspace_member" endpoint HTTP request body.
+type AddWorkspaceMemberRequestBody struct {
+	// User to add
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+}
+
+// CreateTokenRequestBody is the type of the "auth" service "create_token"
+// endpoint HTTP request body.
+type CreateTokenRequestBody struct {
+	// Token name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Scopes to grant, a subset of the scopes of the user
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Token lifetime in seconds, the token never expires if unset
+	ExpiresIn *int64 `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
+
+// CreateBotRequestBody is the type of the "auth" service "create_bot" endpoint
+// HTTP request body.
+type CreateBotRequestBody struct {
+	// Bot name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Scopes to grant to the API key
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+}
+
+// ClientTokenRequestBody is the type of the "auth" service "client_token"
+// endpoint HTTP request body.
+type ClientTokenRequestBody struct {
+	// OAuth grant type
+	GrantType *string `form:"grant_type,omitempty" json:"grant_type,omitempty" xml:"grant_type,omitempty"`
+	// Space separated scopes to grant, every scope of the client if unset
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// TokenIntrospectRequestBody is the type of the "auth" service
+// "token_introspect" endpoint HTTP request body.
+type TokenIntrospectRequestBody struct {
+	// Token to introspect
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// Type of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
+// GrantRoleRequestBody is the type of the "auth" service "grant_role" endpoint
+// HTTP request body.
+type GrantRoleRequestBody struct {
+	// Role to grant
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+}
+
+// DeviceAuthorizationRequestBody is the type of the "auth" service
+// "device_authorization" endpoint HTTP request body.
+type DeviceAuthorizationRequestBody struct {
+	// Identity provider the user logs in with
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// Client requesting the authorization, shown to the user on the consent page
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+}
+
+// DeviceTokenRequestBody is the type of the "auth" service "device_token"
+// endpoint HTTP request body.
+type DeviceTokenRequestBody struct {
+	// OAuth grant type
+	GrantType *string `form:"grant_type,omitempty" json:"grant_type,omitempty" xml:"grant_type,omitempty"`
+	// Device verification code
+	DeviceCode *string `form:"device_code,omitempty" json:"device_code,omitempty" xml:"device_code,omitempty"`
+}
+
 // IntrospectResponseBody is the type of the "auth" service "introspect"
 // endpoint HTTP response body.
 type IntrospectResponseBody struct {
@@ -35,7 +140,7 @@ type IntrospectResponseBody struct {
 // AuthURLResponseBody is the type of the "auth" service "auth_url" endpoint
 // HTTP response body.
 type AuthURLResponseBody struct {
-	// GitHub OAuth authorization URL
+	// OAuth authorization URL of the identity provider
 	AuthURL string `form:"auth_url" json:"auth_url" xml:"auth_url"`
 	// OAuth state parameter for CSRF protection
 	State string `form:"state" json:"state" xml:"state"`
@@ -50,8 +155,371 @@ type OauthCallbackResponseBody struct {
 	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
 	// Token expiration in seconds
 	ExpiresIn int64 `form:"expires_in" json:"expires_in" xml:"expires_in"`
-	// GitHub user ID
+	// Internal user ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Opaque refresh token
+	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn int64 `form:"refresh_expires_in" json:"refresh_expires_in" xml:"refresh_expires_in"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// RefreshResponseBody is the type of the "auth" service "refresh" endpoint
+// HTTP response body.
+type RefreshResponseBody struct {
+	// Opaque access token
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Token type (Bearer)
+	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
+	// Token expiration in seconds
+	ExpiresIn int64 `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Internal user ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Opaque refresh token
+	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn int64 `form:"refresh_expires_in" json:"refresh_expires_in" xml:"refresh_expires_in"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// ListSessionsResponseBody is the type of the "auth" service "list_sessions"
+// endpoint HTTP response body.
+type ListSessionsResponseBody []*SessionResponse
+
+// LinkIdentityResponseBody is the type of the "auth" service "link_identity"
+// endpoint HTTP response body.
+type LinkIdentityResponseBody struct {
+	// OAuth authorization URL of the identity provider
+	AuthURL string `form:"auth_url" json:"auth_url" xml:"auth_url"`
+	// OAuth state parameter for CSRF protection
+	State string `form:"state" json:"state" xml:"state"`
+}
+
+// ListIdentitiesResponseBody is the type of the "auth" service
+// "list_identities" endpoint HTTP response body.
+type ListIdentitiesResponseBody []*LinkedIdentityResponse
+
+// CreateWorkspaceResponseBody is the type of the "auth" service
+// "create_workspace" endpoint HTTP response body.
+type CreateWorkspaceResponseBody struct {
+	// Workspace ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Workspace name
+	Name string `form:"name" json:"name" xml:"name"`
+	// User who created the workspace
+	CreatedBy string `form:"created_by" json:"created_by" xml:"created_by"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListWorkspacesResponseBody is the type of the "auth" service
+// "list_workspaces" endpoint HTTP response body.
+type ListWorkspacesResponseBody []*WorkspaceResponse
+
+// SwitchWorkspaceResponseBody is the type of the "auth" service
+// "switch_workspace" endpoint HTTP response body.
+type SwitchWorkspaceResponseBody struct {
+	// Opaque access token
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Token type (Bearer)
+	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
+	// Token expiration in seconds
+	ExpiresIn int64 `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Internal user ID
 	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Opaque refresh token
+	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn int64 `form:"refresh_expires_in" json:"refresh_expires_in" xml:"refresh_expires_in"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// SwitchDefaultWorkspaceResponseBody is the type of the "auth" service
+// "switch_default_workspace" endpoint HTTP response body.
+type SwitchDefaultWorkspaceResponseBody struct {
+	// Opaque access token
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Token type (Bearer)
+	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
+	// Token expiration in seconds
+	ExpiresIn int64 `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Internal user ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Opaque refresh token
+	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn int64 `form:"refresh_expires_in" json:"refresh_expires_in" xml:"refresh_expires_in"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// JwksResponseBody is the type of the "auth" service "jwks" endpoint HTTP
+// response body.
+type JwksResponseBody struct {
+	// Published keys
+	Keys []*JWKResponseBody `form:"keys" json:"keys" xml:"keys"`
+}
+
+// CreateTokenResponseBody is the type of the "auth" service "create_token"
+// endpoint HTTP response body.
+type CreateTokenResponseBody struct {
+	// Secret token to send as bearer token
+	Token string `form:"token" json:"token" xml:"token"`
+	// Token ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Token name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Granted scopes
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Expiration timestamp, unset if the token never expires
+	ExpiresAt *int64 `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Workspace the token acts in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// ListTokensResponseBody is the type of the "auth" service "list_tokens"
+// endpoint HTTP response body.
+type ListTokensResponseBody []*PersonalAccessTokenResponse
+
+// CreateBotResponseBody is the type of the "auth" service "create_bot"
+// endpoint HTTP response body.
+type CreateBotResponseBody struct {
+	// Secret API key to send as bearer token
+	APIKey string `form:"api_key" json:"api_key" xml:"api_key"`
+	// Bot ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Bot name
+	Name string `form:"name" json:"name" xml:"name"`
+	// User ID of the bot in the other services
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Scopes granted to the API key
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListBotsResponseBody is the type of the "auth" service "list_bots" endpoint
+// HTTP response body.
+type ListBotsResponseBody []*BotResponse
+
+// ClientTokenResponseBody is the type of the "auth" service "client_token"
+// endpoint HTTP response body.
+type ClientTokenResponseBody struct {
+	// Service JWT
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Token type (Bearer)
+	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
+	// Token expiration in seconds
+	ExpiresIn int64 `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Space separated granted scopes
+	Scope string `form:"scope" json:"scope" xml:"scope"`
+}
+
+// TokenIntrospectResponseBody is the type of the "auth" service
+// "token_introspect" endpoint HTTP response body.
+type TokenIntrospectResponseBody struct {
+	// Whether the token is active
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Space separated scopes of the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Client the token was presented to, which introspects it
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// Login of the user of the token
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Token type (Bearer)
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiration timestamp
+	Exp *int64 `form:"exp,omitempty" json:"exp,omitempty" xml:"exp,omitempty"`
+	// Token issuance timestamp
+	Iat *int64 `form:"iat,omitempty" json:"iat,omitempty" xml:"iat,omitempty"`
+	// User ID of the token
+	Sub *string `form:"sub,omitempty" json:"sub,omitempty" xml:"sub,omitempty"`
+}
+
+// GetUserRolesResponseBody is the type of the "auth" service "get_user_roles"
+// endpoint HTTP response body.
+type GetUserRolesResponseBody struct {
+	// User ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Roles of the user, every user has the user role
+	Roles []string `form:"roles" json:"roles" xml:"roles"`
+	// Scopes granted by the roles
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+}
+
+// GrantRoleResponseBody is the type of the "auth" service "grant_role"
+// endpoint HTTP response body.
+type GrantRoleResponseBody struct {
+	// User ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Roles of the user, every user has the user role
+	Roles []string `form:"roles" json:"roles" xml:"roles"`
+	// Scopes granted by the roles
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+}
+
+// RevokeRoleResponseBody is the type of the "auth" service "revoke_role"
+// endpoint HTTP response body.
+type RevokeRoleResponseBody struct {
+	// User ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Roles of the user, every user has the user role
+	Roles []string `form:"roles" json:"roles" xml:"roles"`
+	// Scopes granted by the roles
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+}
+
+// DeviceAuthorizationResponseBody is the type of the "auth" service
+// "device_authorization" endpoint HTTP response body.
+type DeviceAuthorizationResponseBody struct {
+	// Device verification code the client polls with
+	DeviceCode string `form:"device_code" json:"device_code" xml:"device_code"`
+	// Code the user enters on the verification page
+	UserCode string `form:"user_code" json:"user_code" xml:"user_code"`
+	// Verification page
+	VerificationURI string `form:"verification_uri" json:"verification_uri" xml:"verification_uri"`
+	// Verification page with the user code filled in
+	VerificationURIComplete string `form:"verification_uri_complete" json:"verification_uri_complete" xml:"verification_uri_complete"`
+	// Lifetime of the codes in seconds
+	ExpiresIn int64 `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Minimum polling interval in seconds
+	Interval int64 `form:"interval" json:"interval" xml:"interval"`
+}
+
+// DeviceTokenResponseBody is the type of the "auth" service "device_token"
+// endpoint HTTP response body.
+type DeviceTokenResponseBody struct {
+	// Opaque access token
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Token type (Bearer)
+	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
+	// Token expiration in seconds
+	ExpiresIn int64 `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Internal user ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Opaque refresh token
+	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	// Refresh token expiration in seconds
+	RefreshExpiresIn int64 `form:"refresh_expires_in" json:"refresh_expires_in" xml:"refresh_expires_in"`
+	// Workspace the tokens act in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// ListAuditEventsResponseBody is the type of the "auth" service
+// "list_audit_events" endpoint HTTP response body.
+type ListAuditEventsResponseBody []*AuditEventResponse
+
+// SessionResponse is used to define fields on response body types.
+type SessionResponse struct {
+	// Session ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// User agent of the device
+	UserAgent string `form:"user_agent" json:"user_agent" xml:"user_agent"`
+	// IP address of the device
+	IP string `form:"ip" json:"ip" xml:"ip"`
+	// Login timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last use timestamp
+	LastUsedAt int64 `form:"last_used_at" json:"last_used_at" xml:"last_used_at"`
+	// Whether the session is the one of the request
+	Current bool `form:"current" json:"current" xml:"current"`
+}
+
+// LinkedIdentityResponse is used to define fields on response body types.
+type LinkedIdentityResponse struct {
+	// Identity provider
+	Provider string `form:"provider" json:"provider" xml:"provider"`
+	// Login of the account at the provider
+	Login string `form:"login" json:"login" xml:"login"`
+	// Email address of the account
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Link timestamp
+	LinkedAt int64 `form:"linked_at" json:"linked_at" xml:"linked_at"`
+}
+
+// WorkspaceResponse is used to define fields on response body types.
+type WorkspaceResponse struct {
+	// Workspace ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Workspace name
+	Name string `form:"name" json:"name" xml:"name"`
+	// User who created the workspace
+	CreatedBy string `form:"created_by" json:"created_by" xml:"created_by"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// JWKResponseBody is used to define fields on response body types.
+type JWKResponseBody struct {
+	// Key type
+	Kty string `form:"kty" json:"kty" xml:"kty"`
+	// Key ID
+	Kid string `form:"kid" json:"kid" xml:"kid"`
+	// Public key use
+	Use string `form:"use" json:"use" xml:"use"`
+	// Signing algorithm
+	Alg string `form:"alg" json:"alg" xml:"alg"`
+	// RSA modulus
+	N string `form:"n" json:"n" xml:"n"`
+	// RSA public exponent
+	E string `form:"e" json:"e" xml:"e"`
+}
+
+// PersonalAccessTokenResponse is used to define fields on response body types.
+type PersonalAccessTokenResponse struct {
+	// Token ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Token name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Granted scopes
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Expiration timestamp, unset if the token never expires
+	ExpiresAt *int64 `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Last use timestamp, unset if the token was never used
+	LastUsedAt *int64 `form:"last_used_at,omitempty" json:"last_used_at,omitempty" xml:"last_used_at,omitempty"`
+	// Workspace the token acts in, absent for the default workspace
+	WorkspaceID *string `form:"workspace_id,omitempty" json:"workspace_id,omitempty" xml:"workspace_id,omitempty"`
+}
+
+// BotResponse is used to define fields on response body types.
+type BotResponse struct {
+	// Bot ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Bot name
+	Name string `form:"name" json:"name" xml:"name"`
+	// User ID of the bot in the other services
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Scopes granted to the API key
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// AuditEventResponse is used to define fields on response body types.
+type AuditEventResponse struct {
+	// Event ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Event type
+	Type string `form:"type" json:"type" xml:"type"`
+	// User the event is about
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// User who performed the action, if not the user itself
+	ActorID *string `form:"actor_id,omitempty" json:"actor_id,omitempty" xml:"actor_id,omitempty"`
+	// IP address of the request
+	IP *string `form:"ip,omitempty" json:"ip,omitempty" xml:"ip,omitempty"`
+	// Identity provider
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// Reason of a failure or detail of the event
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Event timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 }
 
 // NewIntrospectResponseBody builds the HTTP response body from the result of
@@ -83,12 +551,332 @@ func NewAuthURLResponseBody(res *auth.AuthURLResult) *AuthURLResponseBody {
 
 // NewOauthCallbackResponseBody builds the HTTP response body from the result
 // of the "oauth_callback" endpoint of the "auth" service.
-func NewOauthCallbackResponseBody(res *auth.OauthCallbackResult) *OauthCallbackResponseBody {
+func NewOauthCallbackResponseBody(res *auth.TokenResult) *OauthCallbackResponseBody {
 	body := &OauthCallbackResponseBody{
+		AccessToken:      res.AccessToken,
+		TokenType:        res.TokenType,
+		ExpiresIn:        res.ExpiresIn,
+		UserID:           res.UserID,
+		RefreshToken:     res.RefreshToken,
+		RefreshExpiresIn: res.RefreshExpiresIn,
+		WorkspaceID:      res.WorkspaceID,
+	}
+	return body
+}
+
+// NewRefreshResponseBody builds the HTTP response body from the result of the
+// "refresh" endpoint of the "auth" service.
+func NewRefreshResponseBody(res *auth.TokenResult) *RefreshResponseBody {
+	body := &RefreshResponseBody{
+		AccessToken:      res.AccessToken,
+		TokenType:        res.TokenType,
+		ExpiresIn:        res.ExpiresIn,
+		UserID:           res.UserID,
+		RefreshToken:     res.RefreshToken,
+		RefreshExpiresIn: res.RefreshExpiresIn,
+		WorkspaceID:      res.WorkspaceID,
+	}
+	return body
+}
+
+// NewListSessionsResponseBody builds the HTTP response body from the result of
+// the "list_sessions" endpoint of the "auth" service.
+func NewListSessionsResponseBody(res []*auth.Session) ListSessionsResponseBody {
+	body := make([]*SessionResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAuthSessionToSessionResponse(val)
+	}
+	return body
+}
+
+// NewLinkIdentityResponseBody builds the HTTP response body from the result of
+// the "link_identity" endpoint of the "auth" service.
+func NewLinkIdentityResponseBody(res *auth.LinkIdentityResult) *LinkIdentityResponseBody {
+	body := &LinkIdentityResponseBody{
+		AuthURL: res.AuthURL,
+		State:   res.State,
+	}
+	return body
+}
+
+// NewListIdentitiesResponseBody builds the HTTP response body from the result
+// of the "list_identities" endpoint of the "auth" service.
+func NewListIdentitiesResponseBody(res []*auth.LinkedIdentity) ListIdentitiesResponseBody {
+	body := make([]*LinkedIdentityResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAuthLinkedIdentityToLinkedIdentityResponse(val)
+	}
+	return body
+}
+
+// NewCreateWorkspaceResponseBody builds the HTTP response body from the result
+// of the "create_workspace" endpoint of the "auth" service.
+func NewCreateWorkspaceResponseBody(res *auth.Workspace) *CreateWorkspaceResponseBody {
+	body := &CreateWorkspaceResponseBody{
+		ID:        res.ID,
+		Name:      res.Name,
+		CreatedBy: res.CreatedBy,
+		CreatedAt: res.CreatedAt,
+	}
+	return body
+}
+
+// NewListWorkspacesResponseBody builds the HTTP response body from the result
+// of the "list_workspaces" endpoint of the "auth" service.
+func NewListWorkspacesResponseBody(res []*auth.Workspace) ListWorkspacesResponseBody {
+	body := make([]*WorkspaceResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAuthWorkspaceToWorkspaceResponse(val)
+	}
+	return body
+}
+
+// NewSwitchWorkspaceResponseBody builds the HTTP response body from the result
+// of the "switch_workspace" endpoint of the "auth" service.
+func NewSwitchWorkspaceResponseBody(res *auth.TokenResult) *SwitchWorkspaceResponseBody {
+	body := &SwitchWorkspaceResponseBody{
+		AccessToken:      res.AccessToken,
+		TokenType:        res.TokenType,
+		ExpiresIn:        res.ExpiresIn,
+		UserID:           res.UserID,
+		RefreshToken:     res.RefreshToken,
+		RefreshExpiresIn: res.RefreshExpiresIn,
+		WorkspaceID:      res.WorkspaceID,
+	}
+	return body
+}
+
+// NewSwitchDefaultWorkspaceResponseBody builds the HTTP response body from the
+// result of the "switch_default_workspace" endpoint of the "auth" service.
+func NewSwitchDefaultWorkspaceResponseBody(res *auth.TokenResult) *SwitchDefaultWorkspaceResponseBody {
+	body := &SwitchDefaultWorkspaceResponseBody{
+		AccessToken:      res.AccessToken,
+		TokenType:        res.TokenType,
+		ExpiresIn:        res.ExpiresIn,
+		UserID:           res.UserID,
+		RefreshToken:     res.RefreshToken,
+		RefreshExpiresIn: res.RefreshExpiresIn,
+		WorkspaceID:      res.WorkspaceID,
+	}
+	return body
+}
+
+// NewJwksResponseBody builds the HTTP response body from the result of the
+// "jwks" endpoint of the "auth" service.
+func NewJwksResponseBody(res *auth.JwksResult) *JwksResponseBody {
+	body := &JwksResponseBody{}
+	if res.Keys != nil {
+		body.Keys = make([]*JWKResponseBody, len(res.Keys))
+		for i, val := range res.Keys {
+			body.Keys[i] = marshalAuthJWKToJWKResponseBody(val)
+		}
+	} else {
+		body.Keys = []*JWKResponseBody{}
+	}
+	return body
+}
+
+// NewCreateTokenResponseBody builds the HTTP response body from the result of
+// the "create_token" endpoint of the "auth" service.
+func NewCreateTokenResponseBody(res *auth.CreatedToken) *CreateTokenResponseBody {
+	body := &CreateTokenResponseBody{
+		Token:       res.Token,
+		ID:          res.ID,
+		Name:        res.Name,
+		CreatedAt:   res.CreatedAt,
+		ExpiresAt:   res.ExpiresAt,
+		WorkspaceID: res.WorkspaceID,
+	}
+	if res.Scopes != nil {
+		body.Scopes = make([]string, len(res.Scopes))
+		for i, val := range res.Scopes {
+			body.Scopes[i] = val
+		}
+	} else {
+		body.Scopes = []string{}
+	}
+	return body
+}
+
+// NewListTokensResponseBody builds the HTTP response body from the result of
+// the "list_tokens" endpoint of the "auth" service.
+func NewListTokensResponseBody(res []*auth.PersonalAccessToken) ListTokensResponseBody {
+	body := make([]*PersonalAccessTokenResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAuthPersonalAccessTokenToPersonalAccessTokenResponse(val)
+	}
+	return body
+}
+
+// NewCreateBotResponseBody builds the HTTP response body from the result of
+// the "create_bot" endpoint of the "auth" service.
+func NewCreateBotResponseBody(res *auth.CreatedBot) *CreateBotResponseBody {
+	body := &CreateBotResponseBody{
+		APIKey:    res.APIKey,
+		ID:        res.ID,
+		Name:      res.Name,
+		UserID:    res.UserID,
+		CreatedAt: res.CreatedAt,
+	}
+	if res.Scopes != nil {
+		body.Scopes = make([]string, len(res.Scopes))
+		for i, val := range res.Scopes {
+			body.Scopes[i] = val
+		}
+	} else {
+		body.Scopes = []string{}
+	}
+	return body
+}
+
+// NewListBotsResponseBody builds the HTTP response body from the result of the
+// "list_bots" endpoint of the "auth" service.
+func NewListBotsResponseBody(res []*auth.Bot) ListBotsResponseBody {
+	body := make([]*BotResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAuthBotToBotResponse(val)
+	}
+	return body
+}
+
+// NewClientTokenResponseBody builds the HTTP response body from the result of
+// the "client_token" endpoint of the "auth" service.
+func NewClientTokenResponseBody(res *auth.ClientTokenResult) *ClientTokenResponseBody {
+	body := &ClientTokenResponseBody{
 		AccessToken: res.AccessToken,
 		TokenType:   res.TokenType,
 		ExpiresIn:   res.ExpiresIn,
-		UserID:      res.UserID,
+		Scope:       res.Scope,
+	}
+	return body
+}
+
+// NewTokenIntrospectResponseBody builds the HTTP response body from the result
+// of the "token_introspect" endpoint of the "auth" service.
+func NewTokenIntrospectResponseBody(res *auth.TokenIntrospection) *TokenIntrospectResponseBody {
+	body := &TokenIntrospectResponseBody{
+		Active:    res.Active,
+		Scope:     res.Scope,
+		ClientID:  res.ClientID,
+		Username:  res.Username,
+		TokenType: res.TokenType,
+		Exp:       res.Exp,
+		Iat:       res.Iat,
+		Sub:       res.Sub,
+	}
+	return body
+}
+
+// NewGetUserRolesResponseBody builds the HTTP response body from the result of
+// the "get_user_roles" endpoint of the "auth" service.
+func NewGetUserRolesResponseBody(res *auth.UserRoles) *GetUserRolesResponseBody {
+	body := &GetUserRolesResponseBody{
+		UserID: res.UserID,
+	}
+	if res.Roles != nil {
+		body.Roles = make([]string, len(res.Roles))
+		for i, val := range res.Roles {
+			body.Roles[i] = val
+		}
+	} else {
+		body.Roles = []string{}
+	}
+	if res.Scopes != nil {
+		body.Scopes = make([]string, len(res.Scopes))
+		for i, val := range res.Scopes {
+			body.Scopes[i] = val
+		}
+	} else {
+		body.Scopes = []string{}
+	}
+	return body
+}
+
+// NewGrantRoleResponseBody builds the HTTP response body from the result of
+// the "grant_role" endpoint of the "auth" service.
+func NewGrantRoleResponseBody(res *auth.UserRoles) *GrantRoleResponseBody {
+	body := &GrantRoleResponseBody{
+		UserID: res.UserID,
+	}
+	if res.Roles != nil {
+		body.Roles = make([]string, len(res.Roles))
+		for i, val := range res.Roles {
+			body.Roles[i] = val
+		}
+	} else {
+		body.Roles = []string{}
+	}
+	if res.Scopes != nil {
+		body.Scopes = make([]string, len(res.Scopes))
+		for i, val := range res.Scopes {
+			body.Scopes[i] = val
+		}
+	} else {
+		body.Scopes = []string{}
+	}
+	return body
+}
+
+// NewRevokeRoleResponseBody builds the HTTP response body from the result of
+// the "revoke_role" endpoint of the "auth" service.
+func NewRevokeRoleResponseBody(res *auth.UserRoles) *RevokeRoleResponseBody {
+	body := &RevokeRoleResponseBody{
+		UserID: res.UserID,
+	}
+	if res.Roles != nil {
+		body.Roles = make([]string, len(res.Roles))
+		for i, val := range res.Roles {
+			body.Roles[i] = val
+		}
+	} else {
+		body.Roles = []string{}
+	}
+	if res.Scopes != nil {
+		body.Scopes = make([]string, len(res.Scopes))
+		for i, val := range res.Scopes {
+			body.Scopes[i] = val
+		}
+	} else {
+		body.Scopes = []string{}
+	}
+	return body
+}
+
+// NewDeviceAuthorizationResponseBody builds the HTTP response body from the
+// result of the "device_authorization" endpoint of the "auth" service.
+func NewDeviceAuthorizationResponseBody(res *auth.DeviceAuthorizationResult) *DeviceAuthorizationResponseBody {
+	body := &DeviceAuthorizationResponseBody{
+		DeviceCode:              res.DeviceCode,
+		UserCode:                res.UserCode,
+		VerificationURI:         res.VerificationURI,
+		VerificationURIComplete: res.VerificationURIComplete,
+		ExpiresIn:               res.ExpiresIn,
+		Interval:                res.Interval,
+	}
+	return body
+}
+
+// NewDeviceTokenResponseBody builds the HTTP response body from the result of
+// the "device_token" endpoint of the "auth" service.
+func NewDeviceTokenResponseBody(res *auth.TokenResult) *DeviceTokenResponseBody {
+	body := &DeviceTokenResponseBody{
+		AccessToken:      res.AccessToken,
+		TokenType:        res.TokenType,
+		ExpiresIn:        res.ExpiresIn,
+		UserID:           res.UserID,
+		RefreshToken:     res.RefreshToken,
+		RefreshExpiresIn: res.RefreshExpiresIn,
+		WorkspaceID:      res.WorkspaceID,
+	}
+	return body
+}
+
+// NewListAuditEventsResponseBody builds the HTTP response body from the result
+// of the "list_audit_events" endpoint of the "auth" service.
+func NewListAuditEventsResponseBody(res []*auth.AuditEvent) ListAuditEventsResponseBody {
+	body := make([]*AuditEventResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAuthAuditEventToAuditEventResponse(val)
 	}
 	return body
 }
@@ -102,12 +890,349 @@ func NewIntrospectPayload(body *IntrospectRequestBody) *auth.IntrospectPayload {
 	return v
 }
 
+// NewAuthURLPayload builds a auth service auth_url endpoint payload.
+func NewAuthURLPayload(provider string, codeChallenge *string, codeChallengeMethod string) *auth.AuthURLPayload {
+	v := &auth.AuthURLPayload{}
+	v.Provider = provider
+	v.CodeChallenge = codeChallenge
+	v.CodeChallengeMethod = codeChallengeMethod
+
+	return v
+}
+
 // NewOauthCallbackPayload builds a auth service oauth_callback endpoint
 // payload.
-func NewOauthCallbackPayload(code string, state string) *auth.OauthCallbackPayload {
+func NewOauthCallbackPayload(provider string, code string, state string, codeVerifier *string) *auth.OauthCallbackPayload {
 	v := &auth.OauthCallbackPayload{}
+	v.Provider = provider
 	v.Code = code
 	v.State = state
+	v.CodeVerifier = codeVerifier
+
+	return v
+}
+
+// NewRefreshPayload builds a auth service refresh endpoint payload.
+func NewRefreshPayload(body *RefreshRequestBody) *auth.RefreshPayload {
+	v := &auth.RefreshPayload{
+		RefreshToken: *body.RefreshToken,
+	}
+
+	return v
+}
+
+// NewLogoutPayload builds a auth service logout endpoint payload.
+func NewLogoutPayload(token string) *auth.LogoutPayload {
+	v := &auth.LogoutPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewRevokePayload builds a auth service revoke endpoint payload.
+func NewRevokePayload(body *RevokeRequestBody) *auth.RevokePayload {
+	v := &auth.RevokePayload{
+		Token:         *body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+
+	return v
+}
+
+// NewRevokeUserSessionsPayload builds a auth service revoke_user_sessions
+// endpoint payload.
+func NewRevokeUserSessionsPayload(userID string, token string) *auth.RevokeUserSessionsPayload {
+	v := &auth.RevokeUserSessionsPayload{}
+	v.UserID = userID
+	v.Token = token
+
+	return v
+}
+
+// NewListSessionsPayload builds a auth service list_sessions endpoint payload.
+func NewListSessionsPayload(token string) *auth.ListSessionsPayload {
+	v := &auth.ListSessionsPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteSessionPayload builds a auth service delete_session endpoint
+// payload.
+func NewDeleteSessionPayload(id string, token string) *auth.DeleteSessionPayload {
+	v := &auth.DeleteSessionPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewLinkIdentityPayload builds a auth service link_identity endpoint payload.
+func NewLinkIdentityPayload(body *LinkIdentityRequestBody, provider string, token string) *auth.LinkIdentityPayload {
+	v := &auth.LinkIdentityPayload{
+		CodeChallenge: *body.CodeChallenge,
+	}
+	if body.CodeChallengeMethod != nil {
+		v.CodeChallengeMethod = *body.CodeChallengeMethod
+	}
+	if body.CodeChallengeMethod == nil {
+		v.CodeChallengeMethod = "S256"
+	}
+	v.Provider = provider
+	v.Token = token
+
+	return v
+}
+
+// NewListIdentitiesPayload builds a auth service list_identities endpoint
+// payload.
+func NewListIdentitiesPayload(token string) *auth.ListIdentitiesPayload {
+	v := &auth.ListIdentitiesPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewUnlinkIdentityPayload builds a auth service unlink_identity endpoint
+// payload.
+func NewUnlinkIdentityPayload(provider string, token string) *auth.UnlinkIdentityPayload {
+	v := &auth.UnlinkIdentityPayload{}
+	v.Provider = provider
+	v.Token = token
+
+	return v
+}
+
+// NewCreateWorkspacePayload builds a auth service create_workspace endpoint
+// payload.
+func NewCreateWorkspacePayload(body *CreateWorkspaceRequestBody, token string) *auth.CreateWorkspacePayload {
+	v := &auth.CreateWorkspacePayload{
+		Name: *body.Name,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewListWorkspacesPayload builds a auth service list_workspaces endpoint
+// payload.
+func NewListWorkspacesPayload(token string) *auth.ListWorkspacesPayload {
+	v := &auth.ListWorkspacesPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewAddWorkspaceMemberPayload builds a auth service add_workspace_member
+// endpoint payload.
+func NewAddWorkspaceMemberPayload(body *AddWorkspaceMemberRequestBody, workspaceID string, token string) *auth.AddWorkspaceMemberPayload {
+	v := &auth.AddWorkspaceMemberPayload{
+		UserID: *body.UserID,
+	}
+	v.WorkspaceID = workspaceID
+	v.Token = token
+
+	return v
+}
+
+// NewRemoveWorkspaceMemberPayload builds a auth service
+// remove_workspace_member endpoint payload.
+func NewRemoveWorkspaceMemberPayload(workspaceID string, userID string, token string) *auth.RemoveWorkspaceMemberPayload {
+	v := &auth.RemoveWorkspaceMemberPayload{}
+	v.WorkspaceID = workspaceID
+	v.UserID = userID
+	v.Token = token
+
+	return v
+}
+
+// NewSwitchWorkspacePayload builds a auth service switch_workspace endpoint
+// payload.
+func NewSwitchWorkspacePayload(workspaceID string, token string) *auth.SwitchWorkspacePayload {
+	v := &auth.SwitchWorkspacePayload{}
+	v.WorkspaceID = workspaceID
+	v.Token = token
+
+	return v
+}
+
+// NewSwitchDefaultWorkspacePayload builds a auth service
+// switch_default_workspace endpoint payload.
+func NewSwitchDefaultWorkspacePayload(token string) *auth.SwitchDefaultWorkspacePayload {
+	v := &auth.SwitchDefaultWorkspacePayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewCreateTokenPayload builds a auth service create_token endpoint payload.
+func NewCreateTokenPayload(body *CreateTokenRequestBody, token string) *auth.CreateTokenPayload {
+	v := &auth.CreateTokenPayload{
+		Name:      *body.Name,
+		ExpiresIn: body.ExpiresIn,
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewListTokensPayload builds a auth service list_tokens endpoint payload.
+func NewListTokensPayload(token string) *auth.ListTokensPayload {
+	v := &auth.ListTokensPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteTokenPayload builds a auth service delete_token endpoint payload.
+func NewDeleteTokenPayload(id string, token string) *auth.DeleteTokenPayload {
+	v := &auth.DeleteTokenPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewCreateBotPayload builds a auth service create_bot endpoint payload.
+func NewCreateBotPayload(body *CreateBotRequestBody, token string) *auth.CreateBotPayload {
+	v := &auth.CreateBotPayload{
+		Name: *body.Name,
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewListBotsPayload builds a auth service list_bots endpoint payload.
+func NewListBotsPayload(token string) *auth.ListBotsPayload {
+	v := &auth.ListBotsPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteBotPayload builds a auth service delete_bot endpoint payload.
+func NewDeleteBotPayload(id string, token string) *auth.DeleteBotPayload {
+	v := &auth.DeleteBotPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewClientTokenPayload builds a auth service client_token endpoint payload.
+func NewClientTokenPayload(body *ClientTokenRequestBody) *auth.ClientTokenPayload {
+	v := &auth.ClientTokenPayload{
+		GrantType: *body.GrantType,
+		Scope:     body.Scope,
+	}
+
+	return v
+}
+
+// NewTokenIntrospectPayload builds a auth service token_introspect endpoint
+// payload.
+func NewTokenIntrospectPayload(body *TokenIntrospectRequestBody) *auth.TokenIntrospectPayload {
+	v := &auth.TokenIntrospectPayload{
+		Token:         *body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+
+	return v
+}
+
+// NewGetUserRolesPayload builds a auth service get_user_roles endpoint payload.
+func NewGetUserRolesPayload(userID string, token string) *auth.GetUserRolesPayload {
+	v := &auth.GetUserRolesPayload{}
+	v.UserID = userID
+	v.Token = token
+
+	return v
+}
+
+// NewGrantRolePayload builds a auth service grant_role endpoint payload.
+func NewGrantRolePayload(body *GrantRoleRequestBody, userID string, token string) *auth.GrantRolePayload {
+	v := &auth.GrantRolePayload{
+		Role: *body.Role,
+	}
+	v.UserID = userID
+	v.Token = token
+
+	return v
+}
+
+// NewRevokeRolePayload builds a auth service revoke_role endpoint payload.
+func NewRevokeRolePayload(userID string, role string, token string) *auth.RevokeRolePayload {
+	v := &auth.RevokeRolePayload{}
+	v.UserID = userID
+	v.Role = role
+	v.Token = token
+
+	return v
+}
+
+// NewDeviceAuthorizationPayload builds a auth service device_authorization
+// endpoint payload.
+func NewDeviceAuthorizationPayload(body *DeviceAuthorizationRequestBody) *auth.DeviceAuthorizationPayload {
+	v := &auth.DeviceAuthorizationPayload{
+		ClientID: body.ClientID,
+	}
+	if body.Provider != nil {
+		v.Provider = *body.Provider
+	}
+	if body.Provider == nil {
+		v.Provider = "github"
+	}
+
+	return v
+}
+
+// NewDeviceVerifyPayload builds a auth service device_verify endpoint payload.
+func NewDeviceVerifyPayload(userCode string) *auth.DeviceVerifyPayload {
+	v := &auth.DeviceVerifyPayload{}
+	v.UserCode = userCode
+
+	return v
+}
+
+// NewDeviceConfirmPayload builds a auth service device_confirm endpoint
+// payload.
+func NewDeviceConfirmPayload(userCode string, consent string, consentCookie string) *auth.DeviceConfirmPayload {
+	v := &auth.DeviceConfirmPayload{}
+	v.UserCode = userCode
+	v.Consent = consent
+	v.ConsentCookie = consentCookie
+
+	return v
+}
+
+// NewDeviceTokenPayload builds a auth service device_token endpoint payload.
+func NewDeviceTokenPayload(body *DeviceTokenRequestBody) *auth.DeviceTokenPayload {
+	v := &auth.DeviceTokenPayload{
+		GrantType:  *body.GrantType,
+		DeviceCode: *body.DeviceCode,
+	}
+
+	return v
+}
+
+// NewListAuditEventsPayload builds a auth service list_audit_events endpoint
+// payload.
+func NewListAuditEventsPayload(userID *string, since *int64, until *int64, limit int, token string) *auth.ListAuditEventsPayload {
+	v := &auth.ListAuditEventsPayload{}
+	v.UserID = userID
+	v.Since = since
+	v.Until = until
+	v.Limit = limit
+	v.Token = token
 
 	return v
 }
@@ -120,3 +1245,204 @@ func ValidateIntrospectRequestBody(body *IntrospectRequestBody) (err error) {
 	}
 	return
 }
+
+// ValidateRefreshRequestBody runs the validations defined on RefreshRequestBody
+func ValidateRefreshRequestBody(body *RefreshRequestBody) (err error) {
+	if body.RefreshToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_token", "body"))
+	}
+	return
+}
+
+// ValidateRevokeRequestBody runs the validations defined on RevokeRequestBody
+func ValidateRevokeRequestBody(body *RevokeRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.TokenTypeHint != nil {
+		if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+		}
+	}
+	return
+}
+
+// ValidateLinkIdentityRequestBody runs the validations defined on
+// link_identity_request_body
+func ValidateLinkIdentityRequestBody(body *LinkIdentityRequestBody) (err error) {
+	if body.CodeChallenge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code_challenge", "body"))
+	}
+	if body.CodeChallenge != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.code_challenge", *body.CodeChallenge, "^[A-Za-z0-9_-]{43}$"))
+	}
+	if body.CodeChallengeMethod != nil {
+		if !(*body.CodeChallengeMethod == "S256") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code_challenge_method", *body.CodeChallengeMethod, []any{"S256"}))
+		}
+	}
+	return
+}
+
+// ValidateCreateWorkspaceRequestBody runs the validations defined on
+// create_workspace_request_body
+func ValidateCreateWorkspaceRequestBody(body *CreateWorkspaceRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	return
+}
+
+// ValidateAddWorkspaceMemberRequestBody runs the validations defined on
+// add_workspace_member_request_body
+func ValidateAddWorkspaceMemberRequestBody(body *AddWorkspaceMemberRequestBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	return
+}
+
+// ValidateCreateTokenRequestBody runs the validations defined on
+// create_token_request_body
+func ValidateCreateTokenRequestBody(body *CreateTokenRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	if len(body.Scopes) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.scopes", body.Scopes, len(body.Scopes), 1, true))
+	}
+	for _, e := range body.Scopes {
+		if !(e == "api:read" || e == "api:write" || e == "api:moderate" || e == "api:admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scopes[*]", e, []any{"api:read", "api:write", "api:moderate", "api:admin"}))
+		}
+	}
+	if body.ExpiresIn != nil {
+		if *body.ExpiresIn < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expires_in", *body.ExpiresIn, 1, true))
+		}
+	}
+	return
+}
+
+// ValidateCreateBotRequestBody runs the validations defined on
+// create_bot_request_body
+func ValidateCreateBotRequestBody(body *CreateBotRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	if len(body.Scopes) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.scopes", body.Scopes, len(body.Scopes), 1, true))
+	}
+	for _, e := range body.Scopes {
+		if !(e == "api:read" || e == "api:write" || e == "api:moderate" || e == "api:admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scopes[*]", e, []any{"api:read", "api:write", "api:moderate", "api:admin"}))
+		}
+	}
+	return
+}
+
+// ValidateClientTokenRequestBody runs the validations defined on
+// client_token_request_body
+func ValidateClientTokenRequestBody(body *ClientTokenRequestBody) (err error) {
+	if body.GrantType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("grant_type", "body"))
+	}
+	if body.GrantType != nil {
+		if !(*body.GrantType == "client_credentials") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", *body.GrantType, []any{"client_credentials"}))
+		}
+	}
+	return
+}
+
+// ValidateTokenIntrospectRequestBody runs the validations defined on
+// token_introspect_request_body
+func ValidateTokenIntrospectRequestBody(body *TokenIntrospectRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.TokenTypeHint != nil {
+		if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+		}
+	}
+	return
+}
+
+// ValidateGrantRoleRequestBody runs the validations defined on
+// grant_role_request_body
+func ValidateGrantRoleRequestBody(body *GrantRoleRequestBody) (err error) {
+	if body.Role == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("role", "body"))
+	}
+	if body.Role != nil {
+		if !(*body.Role == "moderator" || *body.Role == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"moderator", "admin"}))
+		}
+	}
+	return
+}
+
+// ValidateDeviceAuthorizationRequestBody runs the validations defined on
+// device_authorization_request_body
+func ValidateDeviceAuthorizationRequestBody(body *DeviceAuthorizationRequestBody) (err error) {
+	if body.ClientID != nil {
+		if utf8.RuneCountInString(*body.ClientID) > 64 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.client_id", *body.ClientID, utf8.RuneCountInString(*body.ClientID), 64, false))
+		}
+	}
+	return
+}
+
+// ValidateDeviceTokenRequestBody runs the validations defined on
+// device_token_request_body
+func ValidateDeviceTokenRequestBody(body *DeviceTokenRequestBody) (err error) {
+	if body.GrantType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("grant_type", "body"))
+	}
+	if body.DeviceCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("device_code", "body"))
+	}
+	if body.GrantType != nil {
+		if !(*body.GrantType == "urn:ietf:params:oauth:grant-type:device_code") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", *body.GrantType, []any{"urn:ietf:params:oauth:grant-type:device_code"}))
+		}
+	}
+	return
+}
@@ -22,14 +22,14 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `auth (introspect|auth-url|oauth-callback)
+	return `auth (introspect|auth-url|oauth-callback|refresh|logout|revoke|revoke-user-sessions|list-sessions|delete-session|link-identity|list-identities|unlink-identity|create-workspace|list-workspaces|add-workspace-member|remove-workspace-member|switch-workspace|switch-default-workspace|jwks|create-token|list-tokens|delete-token|create-bot|list-bots|delete-bot|client-token|token-introspect|get-user-roles|grant-role|revoke-role|device-authorization|device-verify|device-confirm|device-token|list-audit-events)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` auth introspect --body '{
-      "token": "Recusandae non cum perspiciatis error."
+      "token": "Doloremque omnis unde magnam quos magni."
    }'` + "\n" +
 		""
 }
@@ -49,16 +49,178 @@ func ParseEndpoint(
 		authIntrospectFlags    = flag.NewFlagSet("introspect", flag.ExitOnError)
 		authIntrospectBodyFlag = authIntrospectFlags.String("body", "REQUIRED", "")
 
-		authAuthURLFlags = flag.NewFlagSet("auth-url", flag.ExitOnError)
+		authAuthURLFlags                   = flag.NewFlagSet("auth-url", flag.ExitOnError)
+		authAuthURLProviderFlag            = authAuthURLFlags.String("provider", "REQUIRED", "Identity provider (github, gitlab, google, oidc)")
+		authAuthURLCodeChallengeFlag       = authAuthURLFlags.String("code-challenge", "", "")
+		authAuthURLCodeChallengeMethodFlag = authAuthURLFlags.String("code-challenge-method", "S256", "")
 
-		authOauthCallbackFlags     = flag.NewFlagSet("oauth-callback", flag.ExitOnError)
-		authOauthCallbackCodeFlag  = authOauthCallbackFlags.String("code", "REQUIRED", "")
-		authOauthCallbackStateFlag = authOauthCallbackFlags.String("state", "REQUIRED", "")
+		authOauthCallbackFlags            = flag.NewFlagSet("oauth-callback", flag.ExitOnError)
+		authOauthCallbackProviderFlag     = authOauthCallbackFlags.String("provider", "REQUIRED", "Identity provider (github, gitlab, google, oidc)")
+		authOauthCallbackCodeFlag         = authOauthCallbackFlags.String("code", "REQUIRED", "")
+		authOauthCallbackStateFlag        = authOauthCallbackFlags.String("state", "REQUIRED", "")
+		authOauthCallbackCodeVerifierFlag = authOauthCallbackFlags.String("code-verifier", "", "")
+
+		authRefreshFlags    = flag.NewFlagSet("refresh", flag.ExitOnError)
+		authRefreshBodyFlag = authRefreshFlags.String("body", "REQUIRED", "")
+
+		authLogoutFlags     = flag.NewFlagSet("logout", flag.ExitOnError)
+		authLogoutTokenFlag = authLogoutFlags.String("token", "REQUIRED", "")
+
+		authRevokeFlags    = flag.NewFlagSet("revoke", flag.ExitOnError)
+		authRevokeBodyFlag = authRevokeFlags.String("body", "REQUIRED", "")
+
+		authRevokeUserSessionsFlags      = flag.NewFlagSet("revoke-user-sessions", flag.ExitOnError)
+		authRevokeUserSessionsUserIDFlag = authRevokeUserSessionsFlags.String("user-id", "REQUIRED", "User whose sessions are revoked")
+		authRevokeUserSessionsTokenFlag  = authRevokeUserSessionsFlags.String("token", "REQUIRED", "")
+
+		authListSessionsFlags     = flag.NewFlagSet("list-sessions", flag.ExitOnError)
+		authListSessionsTokenFlag = authListSessionsFlags.String("token", "REQUIRED", "")
+
+		authDeleteSessionFlags     = flag.NewFlagSet("delete-session", flag.ExitOnError)
+		authDeleteSessionIDFlag    = authDeleteSessionFlags.String("id", "REQUIRED", "Session ID")
+		authDeleteSessionTokenFlag = authDeleteSessionFlags.String("token", "REQUIRED", "")
+
+		authLinkIdentityFlags        = flag.NewFlagSet("link-identity", flag.ExitOnError)
+		authLinkIdentityBodyFlag     = authLinkIdentityFlags.String("body", "REQUIRED", "")
+		authLinkIdentityProviderFlag = authLinkIdentityFlags.String("provider", "REQUIRED", "Identity provider (github, gitlab, google, oidc)")
+		authLinkIdentityTokenFlag    = authLinkIdentityFlags.String("token", "REQUIRED", "")
+
+		authListIdentitiesFlags     = flag.NewFlagSet("list-identities", flag.ExitOnError)
+		authListIdentitiesTokenFlag = authListIdentitiesFlags.String("token", "REQUIRED", "")
+
+		authUnlinkIdentityFlags        = flag.NewFlagSet("unlink-identity", flag.ExitOnError)
+		authUnlinkIdentityProviderFlag = authUnlinkIdentityFlags.String("provider", "REQUIRED", "Identity provider")
+		authUnlinkIdentityTokenFlag    = authUnlinkIdentityFlags.String("token", "REQUIRED", "")
+
+		authCreateWorkspaceFlags     = flag.NewFlagSet("create-workspace", flag.ExitOnError)
+		authCreateWorkspaceBodyFlag  = authCreateWorkspaceFlags.String("body", "REQUIRED", "")
+		authCreateWorkspaceTokenFlag = authCreateWorkspaceFlags.String("token", "REQUIRED", "")
+
+		authListWorkspacesFlags     = flag.NewFlagSet("list-workspaces", flag.ExitOnError)
+		authListWorkspacesTokenFlag = authListWorkspacesFlags.String("token", "REQUIRED", "")
+
+		authAddWorkspaceMemberFlags           = flag.NewFlagSet("add-workspace-member", flag.ExitOnError)
+		authAddWorkspaceMemberBodyFlag        = authAddWorkspaceMemberFlags.String("body", "REQUIRED", "")
+		authAddWorkspaceMemberWorkspaceIDFlag = authAddWorkspaceMemberFlags.String("workspace-id", "REQUIRED", "Workspace ID")
+		authAddWorkspaceMemberTokenFlag       = authAddWorkspaceMemberFlags.String("token", "REQUIRED", "")
+
+		authRemoveWorkspaceMemberFlags           = flag.NewFlagSet("remove-workspace-member", flag.ExitOnError)
+		authRemoveWorkspaceMemberWorkspaceIDFlag = authRemoveWorkspaceMemberFlags.String("workspace-id", "REQUIRED", "Workspace ID")
+		authRemoveWorkspaceMemberUserIDFlag      = authRemoveWorkspaceMemberFlags.String("user-id", "REQUIRED", "User to remove")
+		authRemoveWorkspaceMemberTokenFlag       = authRemoveWorkspaceMemberFlags.String("token", "REQUIRED", "")
+
+		authSwitchWorkspaceFlags           = flag.NewFlagSet("switch-workspace", flag.ExitOnError)
+		authSwitchWorkspaceWorkspaceIDFlag = authSwitchWorkspaceFlags.String("workspace-id", "REQUIRED", "Workspace ID")
+		authSwitchWorkspaceTokenFlag       = authSwitchWorkspaceFlags.String("token", "REQUIRED", "")
+
+		authSwitchDefaultWorkspaceFlags     = flag.NewFlagSet("switch-default-workspace", flag.ExitOnError)
+		authSwitchDefaultWorkspaceTokenFlag = authSwitchDefaultWorkspaceFlags.String("token", "REQUIRED", "")
+
+		authJwksFlags = flag.NewFlagSet("jwks", flag.ExitOnError)
+
+		authCreateTokenFlags     = flag.NewFlagSet("create-token", flag.ExitOnError)
+		authCreateTokenBodyFlag  = authCreateTokenFlags.String("body", "REQUIRED", "")
+		authCreateTokenTokenFlag = authCreateTokenFlags.String("token", "REQUIRED", "")
+
+		authListTokensFlags     = flag.NewFlagSet("list-tokens", flag.ExitOnError)
+		authListTokensTokenFlag = authListTokensFlags.String("token", "REQUIRED", "")
+
+		authDeleteTokenFlags     = flag.NewFlagSet("delete-token", flag.ExitOnError)
+		authDeleteTokenIDFlag    = authDeleteTokenFlags.String("id", "REQUIRED", "Token ID")
+		authDeleteTokenTokenFlag = authDeleteTokenFlags.String("token", "REQUIRED", "")
+
+		authCreateBotFlags     = flag.NewFlagSet("create-bot", flag.ExitOnError)
+		authCreateBotBodyFlag  = authCreateBotFlags.String("body", "REQUIRED", "")
+		authCreateBotTokenFlag = authCreateBotFlags.String("token", "REQUIRED", "")
+
+		authListBotsFlags     = flag.NewFlagSet("list-bots", flag.ExitOnError)
+		authListBotsTokenFlag = authListBotsFlags.String("token", "REQUIRED", "")
+
+		authDeleteBotFlags     = flag.NewFlagSet("delete-bot", flag.ExitOnError)
+		authDeleteBotIDFlag    = authDeleteBotFlags.String("id", "REQUIRED", "Bot ID")
+		authDeleteBotTokenFlag = authDeleteBotFlags.String("token", "REQUIRED", "")
+
+		authClientTokenFlags            = flag.NewFlagSet("client-token", flag.ExitOnError)
+		authClientTokenBodyFlag         = authClientTokenFlags.String("body", "REQUIRED", "")
+		authClientTokenClientIDFlag     = authClientTokenFlags.String("client-id", "REQUIRED", "Client ID")
+		authClientTokenClientSecretFlag = authClientTokenFlags.String("client-secret", "REQUIRED", "Client secret")
+
+		authTokenIntrospectFlags            = flag.NewFlagSet("token-introspect", flag.ExitOnError)
+		authTokenIntrospectBodyFlag         = authTokenIntrospectFlags.String("body", "REQUIRED", "")
+		authTokenIntrospectClientIDFlag     = authTokenIntrospectFlags.String("client-id", "REQUIRED", "Client ID")
+		authTokenIntrospectClientSecretFlag = authTokenIntrospectFlags.String("client-secret", "REQUIRED", "Client secret")
+
+		authGetUserRolesFlags      = flag.NewFlagSet("get-user-roles", flag.ExitOnError)
+		authGetUserRolesUserIDFlag = authGetUserRolesFlags.String("user-id", "REQUIRED", "User ID")
+		authGetUserRolesTokenFlag  = authGetUserRolesFlags.String("token", "REQUIRED", "")
+
+		authGrantRoleFlags      = flag.NewFlagSet("grant-role", flag.ExitOnError)
+		authGrantRoleBodyFlag   = authGrantRoleFlags.String("body", "REQUIRED", "")
+		authGrantRoleUserIDFlag = authGrantRoleFlags.String("user-id", "REQUIRED", "User ID")
+		authGrantRoleTokenFlag  = authGrantRoleFlags.String("token", "REQUIRED", "")
+
+		authRevokeRoleFlags      = flag.NewFlagSet("revoke-role", flag.ExitOnError)
+		authRevokeRoleUserIDFlag = authRevokeRoleFlags.String("user-id", "REQUIRED", "User ID")
+		authRevokeRoleRoleFlag   = authRevokeRoleFlags.String("role", "REQUIRED", "Role to revoke")
+		authRevokeRoleTokenFlag  = authRevokeRoleFlags.String("token", "REQUIRED", "")
+
+		authDeviceAuthorizationFlags    = flag.NewFlagSet("device-authorization", flag.ExitOnError)
+		authDeviceAuthorizationBodyFlag = authDeviceAuthorizationFlags.String("body", "REQUIRED", "")
+
+		authDeviceVerifyFlags        = flag.NewFlagSet("device-verify", flag.ExitOnError)
+		authDeviceVerifyUserCodeFlag = authDeviceVerifyFlags.String("user-code", "REQUIRED", "")
+
+		authDeviceConfirmFlags             = flag.NewFlagSet("device-confirm", flag.ExitOnError)
+		authDeviceConfirmUserCodeFlag      = authDeviceConfirmFlags.String("user-code", "REQUIRED", "")
+		authDeviceConfirmConsentFlag       = authDeviceConfirmFlags.String("consent", "REQUIRED", "")
+		authDeviceConfirmConsentCookieFlag = authDeviceConfirmFlags.String("consent-cookie", "REQUIRED", "")
+
+		authDeviceTokenFlags    = flag.NewFlagSet("device-token", flag.ExitOnError)
+		authDeviceTokenBodyFlag = authDeviceTokenFlags.String("body", "REQUIRED", "")
+
+		authListAuditEventsFlags      = flag.NewFlagSet("list-audit-events", flag.ExitOnError)
+		authListAuditEventsUserIDFlag = authListAuditEventsFlags.String("user-id", "", "")
+		authListAuditEventsSinceFlag  = authListAuditEventsFlags.String("since", "", "")
+		authListAuditEventsUntilFlag  = authListAuditEventsFlags.String("until", "", "")
+		authListAuditEventsLimitFlag  = authListAuditEventsFlags.String("limit", "100", "")
+		authListAuditEventsTokenFlag  = authListAuditEventsFlags.String("token", "REQUIRED", "")
 	)
 	authFlags.Usage = authUsage
 	authIntrospectFlags.Usage = authIntrospectUsage
 	authAuthURLFlags.Usage = authAuthURLUsage
 	authOauthCallbackFlags.Usage = authOauthCallbackUsage
+	authRefreshFlags.Usage = authRefreshUsage
+	authLogoutFlags.Usage = authLogoutUsage
+	authRevokeFlags.Usage = authRevokeUsage
+	authRevokeUserSessionsFlags.Usage = authRevokeUserSessionsUsage
+	authListSessionsFlags.Usage = authListSessionsUsage
+	authDeleteSessionFlags.Usage = authDeleteSessionUsage
+	authLinkIdentityFlags.Usage = authLinkIdentityUsage
+	authListIdentitiesFlags.Usage = authListIdentitiesUsage
+	authUnlinkIdentityFlags.Usage = authUnlinkIdentityUsage
+	authCreateWorkspaceFlags.Usage = authCreateWorkspaceUsage
+	authListWorkspacesFlags.Usage = authListWorkspacesUsage
+	authAddWorkspaceMemberFlags.Usage = authAddWorkspaceMemberUsage
+	authRemoveWorkspaceMemberFlags.Usage = authRemoveWorkspaceMemberUsage
+	authSwitchWorkspaceFlags.Usage = authSwitchWorkspaceUsage
+	authSwitchDefaultWorkspaceFlags.Usage = authSwitchDefaultWorkspaceUsage
+	authJwksFlags.Usage = authJwksUsage
+	authCreateTokenFlags.Usage = authCreateTokenUsage
+	authListTokensFlags.Usage = authListTokensUsage
+	authDeleteTokenFlags.Usage = authDeleteTokenUsage
+	authCreateBotFlags.Usage = authCreateBotUsage
+	authListBotsFlags.Usage = authListBotsUsage
+	authDeleteBotFlags.Usage = authDeleteBotUsage
+	authClientTokenFlags.Usage = authClientTokenUsage
+	authTokenIntrospectFlags.Usage = authTokenIntrospectUsage
+	authGetUserRolesFlags.Usage = authGetUserRolesUsage
+	authGrantRoleFlags.Usage = authGrantRoleUsage
+	authRevokeRoleFlags.Usage = authRevokeRoleUsage
+	authDeviceAuthorizationFlags.Usage = authDeviceAuthorizationUsage
+	authDeviceVerifyFlags.Usage = authDeviceVerifyUsage
+	authDeviceConfirmFlags.Usage = authDeviceConfirmUsage
+	authDeviceTokenFlags.Usage = authDeviceTokenUsage
+	authListAuditEventsFlags.Usage = authListAuditEventsUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -103,6 +265,102 @@ func ParseEndpoint(
 			case "oauth-callback":
 				epf = authOauthCallbackFlags
 
+			case "refresh":
+				epf = authRefreshFlags
+
+			case "logout":
+				epf = authLogoutFlags
+
+			case "revoke":
+				epf = authRevokeFlags
+
+			case "revoke-user-sessions":
+				epf = authRevokeUserSessionsFlags
+
+			case "list-sessions":
+				epf = authListSessionsFlags
+
+			case "delete-session":
+				epf = authDeleteSessionFlags
+
+			case "link-identity":
+				epf = authLinkIdentityFlags
+
+			case "list-identities":
+				epf = authListIdentitiesFlags
+
+			case "unlink-identity":
+				epf = authUnlinkIdentityFlags
+
+			case "create-workspace":
+				epf = authCreateWorkspaceFlags
+
+			case "list-workspaces":
+				epf = authListWorkspacesFlags
+
+			case "add-workspace-member":
+				epf = authAddWorkspaceMemberFlags
+
+			case "remove-workspace-member":
+				epf = authRemoveWorkspaceMemberFlags
+
+			case "switch-workspace":
+				epf = authSwitchWorkspaceFlags
+
+			case "switch-default-workspace":
+				epf = authSwitchDefaultWorkspaceFlags
+
+			case "jwks":
+				epf = authJwksFlags
+
+			case "create-token":
+				epf = authCreateTokenFlags
+
+			case "list-tokens":
+				epf = authListTokensFlags
+
+			case "delete-token":
+				epf = authDeleteTokenFlags
+
+			case "create-bot":
+				epf = authCreateBotFlags
+
+			case "list-bots":
+				epf = authListBotsFlags
+
+			case "delete-bot":
+				epf = authDeleteBotFlags
+
+			case "client-token":
+				epf = authClientTokenFlags
+
+			case "token-introspect":
+				epf = authTokenIntrospectFlags
+
+			case "get-user-roles":
+				epf = authGetUserRolesFlags
+
+			case "grant-role":
+				epf = authGrantRoleFlags
+
+			case "revoke-role":
+				epf = authRevokeRoleFlags
+
+			case "device-authorization":
+				epf = authDeviceAuthorizationFlags
+
+			case "device-verify":
+				epf = authDeviceVerifyFlags
+
+			case "device-confirm":
+				epf = authDeviceConfirmFlags
+
+			case "device-token":
+				epf = authDeviceTokenFlags
+
+			case "list-audit-events":
+				epf = authListAuditEventsFlags
+
 			}
 
 		}
@@ -133,9 +391,105 @@ func ParseEndpoint(
 				data, err = authc.BuildIntrospectPayload(*authIntrospectBodyFlag)
 			case "auth-url":
 				endpoint = c.AuthURL()
+				data, err = authc.BuildAuthURLPayload(*authAuthURLProviderFlag, *authAuthURLCodeChallengeFlag, *authAuthURLCodeChallengeMethodFlag)
 			case "oauth-callback":
 				endpoint = c.OauthCallback()
-				data, err = authc.BuildOauthCallbackPayload(*authOauthCallbackCodeFlag, *authOauthCallbackStateFlag)
+				data, err = authc.BuildOauthCallbackPayload(*authOauthCallbackProviderFlag, *authOauthCallbackCodeFlag, *authOauthCallbackStateFlag, *authOauthCallbackCodeVerifierFlag)
+			case "refresh":
+				endpoint = c.Refresh()
+				data, err = authc.BuildRefreshPayload(*authRefreshBodyFlag)
+			case "logout":
+				endpoint = c.Logout()
+				data, err = authc.BuildLogoutPayload(*authLogoutTokenFlag)
+			case "revoke":
+				endpoint = c.Revoke()
+				data, err = authc.BuildRevokePayload(*authRevokeBodyFlag)
+			case "revoke-user-sessions":
+				endpoint = c.RevokeUserSessions()
+				data, err = authc.BuildRevokeUserSessionsPayload(*authRevokeUserSessionsUserIDFlag, *authRevokeUserSessionsTokenFlag)
+			case "list-sessions":
+				endpoint = c.ListSessions()
+				data, err = authc.BuildListSessionsPayload(*authListSessionsTokenFlag)
+			case "delete-session":
+				endpoint = c.DeleteSession()
+				data, err = authc.BuildDeleteSessionPayload(*authDeleteSessionIDFlag, *authDeleteSessionTokenFlag)
+			case "link-identity":
+				endpoint = c.LinkIdentity()
+				data, err = authc.BuildLinkIdentityPayload(*authLinkIdentityBodyFlag, *authLinkIdentityProviderFlag, *authLinkIdentityTokenFlag)
+			case "list-identities":
+				endpoint = c.ListIdentities()
+				data, err = authc.BuildListIdentitiesPayload(*authListIdentitiesTokenFlag)
+			case "unlink-identity":
+				endpoint = c.UnlinkIdentity()
+				data, err = authc.BuildUnlinkIdentityPayload(*authUnlinkIdentityProviderFlag, *authUnlinkIdentityTokenFlag)
+			case "create-workspace":
+				endpoint = c.CreateWorkspace()
+				data, err = authc.BuildCreateWorkspacePayload(*authCreateWorkspaceBodyFlag, *authCreateWorkspaceTokenFlag)
+			case "list-workspaces":
+				endpoint = c.ListWorkspaces()
+				data, err = authc.BuildListWorkspacesPayload(*authListWorkspacesTokenFlag)
+			case "add-workspace-member":
+				endpoint = c.AddWorkspaceMember()
+				data, err = authc.BuildAddWorkspaceMemberPayload(*authAddWorkspaceMemberBodyFlag, *authAddWorkspaceMemberWorkspaceIDFlag, *authAddWorkspaceMemberTokenFlag)
+			case "remove-workspace-member":
+				endpoint = c.RemoveWorkspaceMember()
+				data, err = authc.BuildRemoveWorkspaceMemberPayload(*authRemoveWorkspaceMemberWorkspaceIDFlag, *authRemoveWorkspaceMemberUserIDFlag, *authRemoveWorkspaceMemberTokenFlag)
+			case "switch-workspace":
+				endpoint = c.SwitchWorkspace()
+				data, err = authc.BuildSwitchWorkspacePayload(*authSwitchWorkspaceWorkspaceIDFlag, *authSwitchWorkspaceTokenFlag)
+			case "switch-default-workspace":
+				endpoint = c.SwitchDefaultWorkspace()
+				data, err = authc.BuildSwitchDefaultWorkspacePayload(*authSwitchDefaultWorkspaceTokenFlag)
+			case "jwks":
+				endpoint = c.Jwks()
+			case "create-token":
+				endpoint = c.CreateToken()
+				data, err = authc.BuildCreateTokenPayload(*authCreateTokenBodyFlag, *authCreateTokenTokenFlag)
+			case "list-tokens":
+				endpoint = c.ListTokens()
+				data, err = authc.BuildListTokensPayload(*authListTokensTokenFlag)
+			case "delete-token":
+				endpoint = c.DeleteToken()
+				data, err = authc.BuildDeleteTokenPayload(*authDeleteTokenIDFlag, *authDeleteTokenTokenFlag)
+			case "create-bot":
+				endpoint = c.CreateBot()
+				data, err = authc.BuildCreateBotPayload(*authCreateBotBodyFlag, *authCreateBotTokenFlag)
+			case "list-bots":
+				endpoint = c.ListBots()
+				data, err = authc.BuildListBotsPayload(*authListBotsTokenFlag)
+			case "delete-bot":
+				endpoint = c.DeleteBot()
+				data, err = authc.BuildDeleteBotPayload(*authDeleteBotIDFlag, *authDeleteBotTokenFlag)
+			case "client-token":
+				endpoint = c.ClientToken()
+				data, err = authc.BuildClientTokenPayload(*authClientTokenBodyFlag, *authClientTokenClientIDFlag, *authClientTokenClientSecretFlag)
+			case "token-introspect":
+				endpoint = c.TokenIntrospect()
+				data, err = authc.BuildTokenIntrospectPayload(*authTokenIntrospectBodyFlag, *authTokenIntrospectClientIDFlag, *authTokenIntrospectClientSecretFlag)
+			case "get-user-roles":
+				endpoint = c.GetUserRoles()
+				data, err = authc.BuildGetUserRolesPayload(*authGetUserRolesUserIDFlag, *authGetUserRolesTokenFlag)
+			case "grant-role":
+				endpoint = c.GrantRole()
+				data, err = authc.BuildGrantRolePayload(*authGrantRoleBodyFlag, *authGrantRoleUserIDFlag, *authGrantRoleTokenFlag)
+			case "revoke-role":
+				endpoint = c.RevokeRole()
+				data, err = authc.BuildRevokeRolePayload(*authRevokeRoleUserIDFlag, *authRevokeRoleRoleFlag, *authRevokeRoleTokenFlag)
+			case "device-authorization":
+				endpoint = c.DeviceAuthorization()
+				data, err = authc.BuildDeviceAuthorizationPayload(*authDeviceAuthorizationBodyFlag)
+			case "device-verify":
+				endpoint = c.DeviceVerify()
+				data, err = authc.BuildDeviceVerifyPayload(*authDeviceVerifyUserCodeFlag)
+			case "device-confirm":
+				endpoint = c.DeviceConfirm()
+				data, err = authc.BuildDeviceConfirmPayload(*authDeviceConfirmUserCodeFlag, *authDeviceConfirmConsentFlag, *authDeviceConfirmConsentCookieFlag)
+			case "device-token":
+				endpoint = c.DeviceToken()
+				data, err = authc.BuildDeviceTokenPayload(*authDeviceTokenBodyFlag)
+			case "list-audit-events":
+				endpoint = c.ListAuditEvents()
+				data, err = authc.BuildListAuditEventsPayload(*authListAuditEventsUserIDFlag, *authListAuditEventsSinceFlag, *authListAuditEventsUntilFlag, *authListAuditEventsLimitFlag, *authListAuditEventsTokenFlag)
 			}
 		}
 	}
@@ -154,8 +508,40 @@ Usage:
 
 COMMAND:
     introspect: Introspect opaque token and return internal JWT token for Kong Gateway
-    auth-url: Get GitHub OAuth authorization URL with state parameter
-    oauth-callback: Handle GitHub OAuth callback and return opaque token
+    auth-url: Get the OAuth authorization URL of an identity provider with state parameter
+    oauth-callback: Handle the OAuth callback of an identity provider and return opaque token
+    refresh: Exchange a refresh token for a new access token and refresh token
+    logout: Revoke the access token of the request and its refresh token
+    revoke: Revoke an access token or a refresh token (RFC 7009)
+    revoke-user-sessions: Revoke every token of a user
+    list-sessions: List the active sessions of the current user
+    delete-session: Terminate a session of the current user
+    link-identity: Get the OAuth authorization URL linking an identity of the provider to the current user
+    list-identities: List the identities linked to the current user
+    unlink-identity: Unlink the identity of the provider from the current user
+    create-workspace: Create a workspace with the current user as its first member
+    list-workspaces: List the workspaces of the current user
+    add-workspace-member: Add a user to a workspace created by the current user
+    remove-workspace-member: Remove a user from a workspace, either by its creator or by the user leaving it
+    switch-workspace: Issue tokens of the current session acting in a workspace of the current user, revoking the presented ones
+    switch-default-workspace: Issue tokens of the current session acting in the default workspace, revoking the presented ones
+    jwks: Public keys verifying the internal JWT tokens
+    create-token: Create a personal access token for the current user
+    list-tokens: List the personal access tokens of the current user
+    delete-token: Revoke a personal access token of the current user
+    create-bot: Create a bot identity with an API key
+    list-bots: List the bot identities
+    delete-bot: Delete a bot identity and revoke its API key
+    client-token: Exchange the credentials of a service client for a short lived service JWT (client credentials grant)
+    token-introspect: Introspect an access token, refresh token or personal access token as per RFC 7662, for resource servers authenticated with their client credentials
+    get-user-roles: Get the roles of a user
+    grant-role: Grant a role to a user, it applies to the tokens issued from then on
+    revoke-role: Revoke a role of a user, it applies to the tokens issued from then on
+    device-authorization: Start a device authorization (RFC 8628) for a client without a browser
+    device-verify: Consent page of a device authorization, showing the user code and the requesting client
+    device-confirm: Confirm a device authorization from its consent page, redirects the user to the login page of the identity provider
+    device-token: Poll for the tokens of a device authorization
+    list-audit-events: List the audit events, newest first
 
 Additional help:
     %[1]s auth COMMAND --help
@@ -169,29 +555,455 @@ Introspect opaque token and return internal JWT token for Kong Gateway
 
 Example:
     %[1]s auth introspect --body '{
-      "token": "Recusandae non cum perspiciatis error."
+      "token": "Doloremque omnis unde magnam quos magni."
    }'
 `, os.Args[0])
 }
 
 func authAuthURLUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth auth-url
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth auth-url -provider STRING -code-challenge STRING -code-challenge-method STRING
 
-Get GitHub OAuth authorization URL with state parameter
+Get the OAuth authorization URL of an identity provider with state parameter
+    -provider STRING: Identity provider (github, gitlab, google, oidc)
+    -code-challenge STRING: 
+    -code-challenge-method STRING: 
 
 Example:
-    %[1]s auth auth-url
+    %[1]s auth auth-url --provider "Laboriosam recusandae ut aliquid sit atque accusantium." --code-challenge "EnGuHjpuE8ZlPSiCQj3tP_VNjgtmZCHhk_u2wGrJbPK" --code-challenge-method "S256"
 `, os.Args[0])
 }
 
 func authOauthCallbackUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth oauth-callback -code STRING -state STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth oauth-callback -provider STRING -code STRING -state STRING -code-verifier STRING
 
-Handle GitHub OAuth callback and return opaque token
+Handle the OAuth callback of an identity provider and return opaque token
+    -provider STRING: Identity provider (github, gitlab, google, oidc)
     -code STRING: 
     -state STRING: 
+    -code-verifier STRING: 
+
+Example:
+    %[1]s auth oauth-callback --provider "Et odio nesciunt." --code "Perferendis qui nam quae." --state "Non magnam dolorem." --code-verifier "TDmt~u96gQ_RnYn4O4E2Ecqlv-nszpQlHjH08k2Fgbg"
+`, os.Args[0])
+}
+
+func authRefreshUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth refresh -body JSON
+
+Exchange a refresh token for a new access token and refresh token
+    -body JSON: 
+
+Example:
+    %[1]s auth refresh --body '{
+      "refresh_token": "Provident sed qui dolore illo eligendi autem."
+   }'
+`, os.Args[0])
+}
+
+func authLogoutUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth logout -token STRING
+
+Revoke the access token of the request and its refresh token
+    -token STRING: 
+
+Example:
+    %[1]s auth logout --token "Sit et accusamus nobis itaque."
+`, os.Args[0])
+}
+
+func authRevokeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth revoke -body JSON
+
+Revoke an access token or a refresh token (RFC 7009)
+    -body JSON: 
+
+Example:
+    %[1]s auth revoke --body '{
+      "token": "Modi molestias.",
+      "token_type_hint": "refresh_token"
+   }'
+`, os.Args[0])
+}
+
+func authRevokeUserSessionsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth revoke-user-sessions -user-id STRING -token STRING
+
+Revoke every token of a user
+    -user-id STRING: User whose sessions are revoked
+    -token STRING: 
+
+Example:
+    %[1]s auth revoke-user-sessions --user-id "Excepturi explicabo nostrum et dolorum officia ut." --token "Qui eligendi aliquam."
+`, os.Args[0])
+}
+
+func authListSessionsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth list-sessions -token STRING
+
+List the active sessions of the current user
+    -token STRING: 
+
+Example:
+    %[1]s auth list-sessions --token "Occaecati et et veniam consequatur omnis perspiciatis."
+`, os.Args[0])
+}
+
+func authDeleteSessionUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth delete-session -id STRING -token STRING
+
+Terminate a session of the current user
+    -id STRING: Session ID
+    -token STRING: 
+
+Example:
+    %[1]s auth delete-session --id "Eum est in." --token "Temporibus aut iure suscipit et sit."
+`, os.Args[0])
+}
+
+func authLinkIdentityUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth link-identity -body JSON -provider STRING -token STRING
+
+Get the OAuth authorization URL linking an identity of the provider to the current user
+    -body JSON: 
+    -provider STRING: Identity provider (github, gitlab, google, oidc)
+    -token STRING: 
+
+Example:
+    %[1]s auth link-identity --body '{
+      "code_challenge": "-qFPlHkRkh09mEn8_ATOw3qnC0QOZMISMkxhuZLU0Kf",
+      "code_challenge_method": "S256"
+   }' --provider "Iste rerum rem doloribus." --token "Et ipsam."
+`, os.Args[0])
+}
+
+func authListIdentitiesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth list-identities -token STRING
+
+List the identities linked to the current user
+    -token STRING: 
+
+Example:
+    %[1]s auth list-identities --token "In culpa et esse iste."
+`, os.Args[0])
+}
+
+func authUnlinkIdentityUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth unlink-identity -provider STRING -token STRING
+
+Unlink the identity of the provider from the current user
+    -provider STRING: Identity provider
+    -token STRING: 
+
+Example:
+    %[1]s auth unlink-identity --provider "Sapiente sapiente et quod vero architecto." --token "Incidunt placeat neque."
+`, os.Args[0])
+}
+
+func authCreateWorkspaceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth create-workspace -body JSON -token STRING
+
+Create a workspace with the current user as its first member
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s auth create-workspace --body '{
+      "name": "2i"
+   }' --token "Consequatur corrupti voluptatem nam."
+`, os.Args[0])
+}
+
+func authListWorkspacesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth list-workspaces -token STRING
+
+List the workspaces of the current user
+    -token STRING: 
+
+Example:
+    %[1]s auth list-workspaces --token "Maxime corporis unde velit."
+`, os.Args[0])
+}
+
+func authAddWorkspaceMemberUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth add-workspace-member -body JSON -workspace-id STRING -token STRING
+
+Add a user to a workspace created by the current user
+    -body JSON: 
+    -workspace-id STRING: Workspace ID
+    -token STRING: 
+
+Example:
+    %[1]s auth add-workspace-member --body '{
+      "user_id": "Sint doloremque unde earum amet dicta blanditiis."
+   }' --workspace-id "Et quia non corporis." --token "Necessitatibus aut consequatur."
+`, os.Args[0])
+}
+
+func authRemoveWorkspaceMemberUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth remove-workspace-member -workspace-id STRING -user-id STRING -token STRING
+
+Remove a user from a workspace, either by its creator or by the user leaving it
+    -workspace-id STRING: Workspace ID
+    -user-id STRING: User to remove
+    -token STRING: 
+
+Example:
+    %[1]s auth remove-workspace-member --workspace-id "Eum ut sed totam animi mollitia ea." --user-id "Repellendus iste eligendi." --token "Fuga doloribus similique dolores."
+`, os.Args[0])
+}
+
+func authSwitchWorkspaceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth switch-workspace -workspace-id STRING -token STRING
+
+Issue tokens of the current session acting in a workspace of the current user, revoking the presented ones
+    -workspace-id STRING: Workspace ID
+    -token STRING: 
+
+Example:
+    %[1]s auth switch-workspace --workspace-id "Veritatis debitis nobis sint omnis ad deserunt." --token "Provident facere voluptatem alias enim non explicabo."
+`, os.Args[0])
+}
+
+func authSwitchDefaultWorkspaceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth switch-default-workspace -token STRING
+
+Issue tokens of the current session acting in the default workspace, revoking the presented ones
+    -token STRING: 
+
+Example:
+    %[1]s auth switch-default-workspace --token "Iusto et fugiat fugit officia."
+`, os.Args[0])
+}
+
+func authJwksUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth jwks
+
+Public keys verifying the internal JWT tokens
+
+Example:
+    %[1]s auth jwks
+`, os.Args[0])
+}
+
+func authCreateTokenUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth create-token -body JSON -token STRING
+
+Create a personal access token for the current user
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s auth create-token --body '{
+      "expires_in": 4819743648855942566,
+      "name": "6r",
+      "scopes": [
+         "api:admin",
+         "api:read"
+      ]
+   }' --token "Aut et natus."
+`, os.Args[0])
+}
+
+func authListTokensUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth list-tokens -token STRING
+
+List the personal access tokens of the current user
+    -token STRING: 
+
+Example:
+    %[1]s auth list-tokens --token "Exercitationem rerum quo qui nihil."
+`, os.Args[0])
+}
+
+func authDeleteTokenUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth delete-token -id STRING -token STRING
+
+Revoke a personal access token of the current user
+    -id STRING: Token ID
+    -token STRING: 
+
+Example:
+    %[1]s auth delete-token --id "Illo sit quis." --token "Ipsam adipisci eum excepturi dolores."
+`, os.Args[0])
+}
+
+func authCreateBotUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth create-bot -body JSON -token STRING
+
+Create a bot identity with an API key
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s auth create-bot --body '{
+      "name": "tke",
+      "scopes": [
+         "api:write",
+         "api:moderate",
+         "api:admin"
+      ]
+   }' --token "Vero tenetur placeat."
+`, os.Args[0])
+}
+
+func authListBotsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth list-bots -token STRING
+
+List the bot identities
+    -token STRING: 
+
+Example:
+    %[1]s auth list-bots --token "Facilis et."
+`, os.Args[0])
+}
+
+func authDeleteBotUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth delete-bot -id STRING -token STRING
+
+Delete a bot identity and revoke its API key
+    -id STRING: Bot ID
+    -token STRING: 
+
+Example:
+    %[1]s auth delete-bot --id "Eaque voluptatem." --token "Accusamus eligendi dolore."
+`, os.Args[0])
+}
+
+func authClientTokenUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth client-token -body JSON -client-id STRING -client-secret STRING
+
+Exchange the credentials of a service client for a short lived service JWT (client credentials grant)
+    -body JSON: 
+    -client-id STRING: Client ID
+    -client-secret STRING: Client secret
+
+Example:
+    %[1]s auth client-token --body '{
+      "grant_type": "client_credentials",
+      "scope": "Quasi sit in ea suscipit nihil."
+   }' --client-id "Velit numquam repellat eos eveniet esse eligendi." --client-secret "Laborum molestiae error consectetur dolores voluptate."
+`, os.Args[0])
+}
+
+func authTokenIntrospectUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth token-introspect -body JSON -client-id STRING -client-secret STRING
+
+Introspect an access token, refresh token or personal access token as per RFC 7662, for resource servers authenticated with their client credentials
+    -body JSON: 
+    -client-id STRING: Client ID
+    -client-secret STRING: Client secret
+
+Example:
+    %[1]s auth token-introspect --body '{
+      "token": "Atque qui ab.",
+      "token_type_hint": "refresh_token"
+   }' --client-id "Unde dolorum quia." --client-secret "Dolorem at inventore ea temporibus doloribus."
+`, os.Args[0])
+}
+
+func authGetUserRolesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth get-user-roles -user-id STRING -token STRING
+
+Get the roles of a user
+    -user-id STRING: User ID
+    -token STRING: 
+
+Example:
+    %[1]s auth get-user-roles --user-id "Doloremque harum omnis." --token "Illo veritatis."
+`, os.Args[0])
+}
+
+func authGrantRoleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth grant-role -body JSON -user-id STRING -token STRING
+
+Grant a role to a user, it applies to the tokens issued from then on
+    -body JSON: 
+    -user-id STRING: User ID
+    -token STRING: 
+
+Example:
+    %[1]s auth grant-role --body '{
+      "role": "moderator"
+   }' --user-id "Harum odit ducimus quo exercitationem voluptatem quis." --token "Eius modi nobis aut aut voluptatem optio."
+`, os.Args[0])
+}
+
+func authRevokeRoleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth revoke-role -user-id STRING -role STRING -token STRING
+
+Revoke a role of a user, it applies to the tokens issued from then on
+    -user-id STRING: User ID
+    -role STRING: Role to revoke
+    -token STRING: 
+
+Example:
+    %[1]s auth revoke-role --user-id "Ut nesciunt dolores." --role "admin" --token "Corrupti modi."
+`, os.Args[0])
+}
+
+func authDeviceAuthorizationUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth device-authorization -body JSON
+
+Start a device authorization (RFC 8628) for a client without a browser
+    -body JSON: 
+
+Example:
+    %[1]s auth device-authorization --body '{
+      "client_id": "gop",
+      "provider": "Delectus ut."
+   }'
+`, os.Args[0])
+}
+
+func authDeviceVerifyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth device-verify -user-code STRING
+
+Consent page of a device authorization, showing the user code and the requesting client
+    -user-code STRING: 
+
+Example:
+    %[1]s auth device-verify --user-code "Est hic eum in eos nihil."
+`, os.Args[0])
+}
+
+func authDeviceConfirmUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth device-confirm -user-code STRING -consent STRING -consent-cookie STRING
+
+Confirm a device authorization from its consent page, redirects the user to the login page of the identity provider
+    -user-code STRING: 
+    -consent STRING: 
+    -consent-cookie STRING: 
+
+Example:
+    %[1]s auth device-confirm --user-code "Dicta qui ab magnam hic dolor expedita." --consent "Sint necessitatibus nulla dolor consequatur earum." --consent-cookie "Unde recusandae deleniti atque alias ab."
+`, os.Args[0])
+}
+
+func authDeviceTokenUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth device-token -body JSON
+
+Poll for the tokens of a device authorization
+    -body JSON: 
+
+Example:
+    %[1]s auth device-token --body '{
+      "device_code": "Temporibus ducimus saepe.",
+      "grant_type": "urn:ietf:params:oauth:grant-type:device_code"
+   }'
+`, os.Args[0])
+}
+
+func authListAuditEventsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] auth list-audit-events -user-id STRING -since INT64 -until INT64 -limit INT -token STRING
+
+List the audit events, newest first
+    -user-id STRING: 
+    -since INT64: 
+    -until INT64: 
+    -limit INT: 
+    -token STRING: 
 
 Example:
-    %[1]s auth oauth-callback --code "Laborum quam." --state "Quia sapiente est sed accusamus temporibus."
+    %[1]s auth list-audit-events --user-id "Vitae voluptates ut ipsam corporis corrupti vitae." --since 3119794155022510682 --until 7153577435612760808 --limit 903 --token "Quia non sit suscipit."
 `, os.Args[0])
 }
@@ -0,0 +1,180 @@
+package authapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// errIdentityConflict is returned when the identity cannot be linked to the
+// user.
+var errIdentityConflict = errors.New("identity linked to another user or provider already linked")
+
+// resolveUser returns the internal user ID of the identity. An identity not
+// linked yet is linked to a new user, or to its legacy provider namespaced ID
+// if that user already signed in before identities were linked, so that its
+// roles and profile are kept.
+func (s *authsrvc) resolveUser(ctx context.Context, provider Provider, identity *Identity) (string, error) {
+	uid, err := s.store.IdentityUser(ctx, provider.Name(), identity.ID)
+	if err != nil || uid != "" {
+		return uid, err
+	}
+
+	uid = uuid.New().String()
+	legacyID := legacyUserID(provider, identity)
+	registered, err := s.store.ProfileRegistered(ctx, legacyID)
+	if err != nil {
+		return "", err
+	}
+	roles, err := s.store.UserRoles(ctx, legacyID)
+	if err != nil {
+		return "", err
+	}
+	if registered || len(roles) > 0 {
+		uid = legacyID
+	}
+
+	linked, err := s.store.LinkIdentity(ctx, identityEntry(provider, identity, uid))
+	if err != nil {
+		return "", err
+	}
+	if !linked {
+		// Linked concurrently by another login.
+		return s.store.IdentityUser(ctx, provider.Name(), identity.ID)
+	}
+	return uid, nil
+}
+
+// linkIdentity links the identity to the user who started the link. A user
+// has at most one identity per provider.
+func (s *authsrvc) linkIdentity(ctx context.Context, provider Provider, identity *Identity, uid string) error {
+	identities, err := s.store.UserIdentities(ctx, uid)
+	if err != nil {
+		return err
+	}
+	for _, entry := range identities {
+		if entry.Provider == provider.Name() && entry.Subject != identity.ID {
+			return errIdentityConflict
+		}
+	}
+
+	linked, err := s.store.LinkIdentity(ctx, identityEntry(provider, identity, uid))
+	if err != nil {
+		return err
+	}
+	if !linked {
+		return errIdentityConflict
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditIdentityLinked, UserID: uid, Provider: provider.Name()})
+	return nil
+}
+
+func identityEntry(provider Provider, identity *Identity, uid string) *IdentityEntry {
+	return &IdentityEntry{
+		Provider:  provider.Name(),
+		Subject:   identity.ID,
+		UserID:    uid,
+		Login:     identity.Login,
+		Name:      identity.Name,
+		Email:     identity.Email,
+		AvatarURL: identity.AvatarURL,
+		LinkedAt:  time.Now(),
+	}
+}
+
+// Get the OAuth authorization URL linking an identity of the provider to the
+// current user
+func (s *authsrvc) LinkIdentity(ctx context.Context, p *auth.LinkIdentityPayload) (res *auth.LinkIdentityResult, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	provider, err := s.provider(p.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The callback requires the code verifier, so that only the client that
+	// started the link can complete it.
+	state, authURL, err := s.startLogin(ctx, provider, &StateEntry{LinkUserID: token.Entry.UserID, CodeChallenge: p.CodeChallenge})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, log.KV{"auth.link_identity", fmt.Sprintf("user %s started linking a %s identity", token.Entry.Login, provider.Name())})
+	return &auth.LinkIdentityResult{AuthURL: authURL, State: state}, nil
+}
+
+// List the identities linked to the current user
+func (s *authsrvc) ListIdentities(ctx context.Context, p *auth.ListIdentitiesPayload) (res []*auth.LinkedIdentity, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	identities, err := s.store.UserIdentities(ctx, token.Entry.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.list_identities", "ERROR: failed to load identities"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	sort.Slice(identities, func(i, j int) bool {
+		return identities[i].LinkedAt.Before(identities[j].LinkedAt)
+	})
+
+	res = make([]*auth.LinkedIdentity, 0, len(identities))
+	for _, identity := range identities {
+		res = append(res, &auth.LinkedIdentity{
+			Provider: identity.Provider,
+			Login:    identity.Login,
+			Email:    optional(identity.Email),
+			LinkedAt: identity.LinkedAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
+
+// Unlink the identity of the provider from the current user
+func (s *authsrvc) UnlinkIdentity(ctx context.Context, p *auth.UnlinkIdentityPayload) (err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return auth.Unauthorized("Token is invalid or expired")
+	}
+
+	identities, err := s.store.UserIdentities(ctx, token.Entry.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.unlink_identity", "ERROR: failed to load identities"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	var identity *IdentityEntry
+	for _, entry := range identities {
+		if entry.Provider == p.Provider {
+			identity = entry
+		}
+	}
+	if identity == nil {
+		return auth.NotFound("No identity of the provider is linked")
+	}
+	// The user could not log in anymore.
+	if len(identities) == 1 {
+		return auth.LastIdentity("The last identity of a user cannot be unlinked")
+	}
+
+	if err := s.store.UnlinkIdentity(ctx, identity); err != nil {
+		log.Print(ctx, log.KV{"auth.unlink_identity", "ERROR: failed to unlink identity"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditIdentityUnlinked, UserID: identity.UserID, Provider: identity.Provider})
+	log.Info(ctx, log.KV{"auth.unlink_identity", fmt.Sprintf("user %s unlinked the %s identity %s", token.Entry.Login, identity.Provider, identity.Login)})
+	return nil
+}
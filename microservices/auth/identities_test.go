@@ -0,0 +1,64 @@
+package authapi
+
+import (
+	"context"
+	"testing"
+
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+func TestLinkIdentityRequiresVerifier(t *testing.T) {
+	s := newTestService(t)
+	res := devLogin(t, s, &Identity{ID: "1", Login: "alice"})
+	ctx := tokenContext(t, s, res.AccessToken)
+	verifier, challenge := pkcePair("v")
+	second := &Identity{ID: "2", Login: "alice-2"}
+
+	link, err := s.LinkIdentity(ctx, &auth.LinkIdentityPayload{Provider: "other", CodeChallenge: challenge})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Whoever completes the callback of someone else's link lacks the
+	// verifier.
+	for _, wrong := range []string{"", "w"} {
+		attempt := ""
+		if wrong != "" {
+			attempt, _ = pkcePair(wrong)
+		}
+		if _, err := providerCallback(context.Background(), s, "other", link.State, second, attempt); errorName(err) != "invalid_verifier" {
+			t.Fatalf("callback with verifier %q: err = %v, want invalid_verifier", attempt, err)
+		}
+		link, err = s.LinkIdentity(ctx, &auth.LinkIdentityPayload{Provider: "other", CodeChallenge: challenge})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if uid, _ := s.store.IdentityUser(context.Background(), "other", second.ID); uid != "" {
+		t.Fatalf("identity linked to %s without the verifier", uid)
+	}
+
+	if _, err := providerCallback(context.Background(), s, "other", link.State, second, verifier); err != nil {
+		t.Fatal(err)
+	}
+	uid, err := s.store.IdentityUser(context.Background(), "other", second.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid != res.UserID {
+		t.Errorf("identity linked to %q, want %q", uid, res.UserID)
+	}
+}
+
+func TestLinkStateWithoutChallengeRejected(t *testing.T) {
+	s := newTestService(t)
+	res := devLogin(t, s, &Identity{ID: "1", Login: "alice"})
+
+	// A link state saved without a challenge, e.g. before links required one.
+	state, _, err := s.startLogin(context.Background(), s.providers["other"], &StateEntry{LinkUserID: res.UserID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := providerCallback(context.Background(), s, "other", state, &Identity{ID: "2", Login: "mallory"}, ""); errorName(err) != "invalid_verifier" {
+		t.Errorf("err = %v, want invalid_verifier", err)
+	}
+}
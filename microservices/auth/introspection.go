@@ -0,0 +1,116 @@
+package authapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// Introspect an access token, refresh token or personal access token as per
+// RFC 7662
+func (s *authsrvc) TokenIntrospect(ctx context.Context, p *auth.TokenIntrospectPayload) (res *auth.TokenIntrospection, err error) {
+	client, ok := contextClient(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Invalid client credentials")
+	}
+
+	// The hint only decides which kind of token is looked up first.
+	lookups := []func(context.Context, string) (*auth.TokenIntrospection, error){s.introspectAccessToken, s.introspectRefreshToken, s.introspectPersonalAccessToken}
+	if p.TokenTypeHint != nil && *p.TokenTypeHint == "refresh_token" {
+		lookups[0], lookups[1] = lookups[1], lookups[0]
+	}
+
+	for _, lookup := range lookups {
+		res, err := lookup(ctx, p.Token)
+		if err != nil {
+			log.Print(ctx, log.KV{"auth.token_introspect", "ERROR: failed to load token"}, log.KV{"error", err.Error()})
+			return nil, auth.InternalError("Internal server error")
+		}
+		if res != nil {
+			log.Info(ctx, log.KV{"auth.token_introspect", fmt.Sprintf("client %s introspected a token of user %s", client.ClientID, *res.Sub)})
+			return res, nil
+		}
+	}
+
+	// Unknown, expired and revoked tokens are all merely inactive.
+	return &auth.TokenIntrospection{Active: false}, nil
+}
+
+func (s *authsrvc) introspectAccessToken(ctx context.Context, token string) (*auth.TokenIntrospection, error) {
+	entry, err := s.store.GetToken(ctx, token)
+	if err != nil || entry == nil || time.Now().After(entry.ExpiresAt) {
+		return nil, err
+	}
+	if member, err := s.workspaceMember(ctx, entry.WorkspaceID, entry.UserID); err != nil || !member {
+		return nil, err
+	}
+	return s.activeIntrospection(ctx, entry.UserID, entry.Login, "Bearer", entry.Scopes, entry.CreatedAt, &entry.ExpiresAt)
+}
+
+func (s *authsrvc) introspectRefreshToken(ctx context.Context, token string) (*auth.TokenIntrospection, error) {
+	entry, err := s.store.GetRefreshToken(ctx, token)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	// Rotated refresh tokens are kept to detect their reuse, but no longer
+	// grant anything.
+	if used, err := s.store.RefreshTokenUsed(ctx, token); err != nil || used {
+		return nil, err
+	}
+	if member, err := s.workspaceMember(ctx, entry.WorkspaceID, entry.UserID); err != nil || !member {
+		return nil, err
+	}
+	// Refresh tokens are no bearer tokens, so the token type is left out.
+	return s.activeIntrospection(ctx, entry.UserID, entry.Login, "", entry.Scopes, entry.CreatedAt, &entry.ExpiresAt)
+}
+
+func (s *authsrvc) introspectPersonalAccessToken(ctx context.Context, token string) (*auth.TokenIntrospection, error) {
+	entry, err := s.personalToken(ctx, token)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	if member, err := s.workspaceMember(ctx, entry.WorkspaceID, entry.UserID); err != nil || !member {
+		return nil, err
+	}
+	res, err := s.activeIntrospection(ctx, entry.UserID, entry.Login, "Bearer", entry.Scopes, entry.CreatedAt, entry.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	// client_id is the client the token was issued to (RFC 7662 section
+	// 2.2): the tokens of users have none, a bot API key is issued to the
+	// bot. Client credentials tokens are JWTs and never introspected here.
+	if botID, ok := strings.CutPrefix(entry.UserID, "bot:"); ok {
+		res.ClientID = &botID
+	}
+	return res, nil
+}
+
+// activeIntrospection returns the introspection of an active token with the
+// scopes its owner still holds, without exp if it never expires.
+func (s *authsrvc) activeIntrospection(ctx context.Context, userID, login, tokenType string, scopes []string, createdAt time.Time, expiresAt *time.Time) (*auth.TokenIntrospection, error) {
+	scopes, _, err := s.heldScopes(ctx, userID, scopes)
+	if err != nil {
+		return nil, err
+	}
+	scope := strings.Join(scopes, " ")
+	iat := createdAt.Unix()
+	res := &auth.TokenIntrospection{
+		Active:   true,
+		Scope:    &scope,
+		Username: &login,
+		Iat:      &iat,
+		Sub:      &userID,
+	}
+	if tokenType != "" {
+		res.TokenType = &tokenType
+	}
+	if expiresAt != nil {
+		exp := expiresAt.Unix()
+		res.Exp = &exp
+	}
+	return res, nil
+}
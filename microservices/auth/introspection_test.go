@@ -0,0 +1,102 @@
+package authapi
+
+import (
+	"context"
+	"testing"
+
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+func tokenIntrospect(t *testing.T, s *authsrvc, token string) *auth.TokenIntrospection {
+	t.Helper()
+	ctx := contextWithClient(context.Background(), &ServiceClient{ClientID: "bff"})
+	res, err := s.TokenIntrospect(ctx, &auth.TokenIntrospectPayload{Token: token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestTokenIntrospect(t *testing.T) {
+	s := newTestService(t)
+	access, refresh := login(t, s, "user", "")
+
+	for _, token := range []string{access, refresh} {
+		res := tokenIntrospect(t, s, token)
+		if !res.Active {
+			t.Fatal("token inactive")
+		}
+		if res.Sub == nil || *res.Sub != "user" {
+			t.Errorf("sub = %v, want user", res.Sub)
+		}
+		// The tokens of users are issued to no client, least of all to the
+		// one introspecting them.
+		if res.ClientID != nil {
+			t.Errorf("client_id = %v, want none", *res.ClientID)
+		}
+	}
+
+	if res := tokenIntrospect(t, s, "unknown"); res.Active || res.ClientID != nil {
+		t.Errorf("unknown token introspected as %+v", res)
+	}
+}
+
+func TestTokenIntrospectBotClientID(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	if err := s.store.GrantRole(ctx, "admin", roleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	access, _ := login(t, s, "admin", "")
+	adminCtx := tokenContext(t, s, access)
+
+	bot, err := s.CreateBot(adminCtx, &auth.CreateBotPayload{Name: "bot", Scopes: []string{"api:read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := tokenIntrospect(t, s, bot.APIKey); res.ClientID == nil || *res.ClientID != bot.ID {
+		t.Errorf("bot API key client_id = %v, want %s", res.ClientID, bot.ID)
+	}
+
+	pat, err := s.CreateToken(adminCtx, &auth.CreateTokenPayload{Name: "ci", Scopes: []string{"api:read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := tokenIntrospect(t, s, pat.Token); res.ClientID != nil {
+		t.Errorf("personal access token client_id = %v, want none", *res.ClientID)
+	}
+}
+
+func TestTokenIntrospectRotatedRefreshToken(t *testing.T) {
+	s := newTestService(t)
+	_, refresh := login(t, s, "user", "")
+
+	if _, _, err := s.store.UseRefreshToken(context.Background(), refresh); err != nil {
+		t.Fatal(err)
+	}
+	if res := tokenIntrospect(t, s, refresh); res.Active {
+		t.Error("rotated refresh token is active")
+	}
+}
+
+func TestTokenIntrospectWorkspaceMembership(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	if err := s.store.AddWorkspaceMember(ctx, "ws", "user"); err != nil {
+		t.Fatal(err)
+	}
+	access, refresh := login(t, s, "user", "ws")
+
+	if res := tokenIntrospect(t, s, access); !res.Active {
+		t.Fatal("token of a member inactive")
+	}
+
+	if err := s.store.RemoveWorkspaceMember(ctx, "ws", "user"); err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range []string{access, refresh} {
+		if res := tokenIntrospect(t, s, token); res.Active {
+			t.Error("token of a removed member is active")
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package authapi
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+const (
+	signingKeyBits             = 2048
+	defaultKeyRotationInterval = 24 * time.Hour
+	keyRefreshInterval         = time.Minute
+	keyRotationLock            = "signing_key_rotation"
+)
+
+// signingKey is a parsed JWT signing key.
+type signingKey struct {
+	id        string
+	key       *rsa.PrivateKey
+	createdAt time.Time
+}
+
+// keyring holds the RS256 keys signing the internal JWTs. The keys live in
+// the store so that every replica signs with the same key. A new key is
+// generated every rotation interval and retired keys stay published for
+// another interval so that JWTs they signed can still be verified.
+type keyring struct {
+	store            Store
+	rotationInterval time.Duration
+	// kek seals the private keys in the store.
+	kek cipher.AEAD
+
+	mu   sync.RWMutex
+	keys []*signingKey // newest first
+}
+
+func newKeyring(store Store, kek cipher.AEAD) *keyring {
+	rotationInterval := defaultKeyRotationInterval
+	if v := os.Getenv("JWT_KEY_ROTATION_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Print(context.Background(), log.KV{"auth.keyring", fmt.Sprintf("ERROR: invalid JWT_KEY_ROTATION_INTERVAL %q, using %s", v, defaultKeyRotationInterval)})
+		} else {
+			rotationInterval = d
+		}
+	}
+
+	return &keyring{store: store, rotationInterval: rotationInterval, kek: kek}
+}
+
+// loadKeyEncryptionKey returns the AES-256-GCM key sealing the signing keys,
+// from the base64 encoded JWT_KEY_ENCRYPTION_KEY. Every replica must share
+// it.
+func loadKeyEncryptionKey() (cipher.AEAD, error) {
+	v := os.Getenv("JWT_KEY_ENCRYPTION_KEY")
+	if v == "" {
+		return nil, errors.New("JWT_KEY_ENCRYPTION_KEY environment variable is not set")
+	}
+	key, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("JWT_KEY_ENCRYPTION_KEY must be 32 bytes encoded in base64")
+	}
+	return newKeyEncryptionKey(key)
+}
+
+func newKeyEncryptionKey(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// run refreshes the keys until ctx is done.
+func (k *keyring) run(ctx context.Context) {
+	ticker := time.NewTicker(keyRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.refresh(ctx); err != nil {
+				log.Print(ctx, log.KV{"auth.keyring", "ERROR: failed to refresh signing keys"}, log.KV{"error", err.Error()})
+			}
+		}
+	}
+}
+
+// refresh loads the keys from the store, rotating and retiring them when due.
+func (k *keyring) refresh(ctx context.Context) error {
+	stored, err := k.store.SigningKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	sort.Slice(stored, func(i, j int) bool {
+		return stored[i].CreatedAt.After(stored[j].CreatedAt)
+	})
+
+	now := time.Now()
+	keys := make([]*signingKey, 0, len(stored))
+	for i, entry := range stored {
+		if i > 0 && now.Sub(entry.CreatedAt) >= 2*k.rotationInterval {
+			if err := k.store.DeleteSigningKey(ctx, entry.ID); err != nil {
+				return fmt.Errorf("failed to delete signing key: %w", err)
+			}
+			continue
+		}
+
+		// A key sealed with another key encryption key is left for its
+		// retirement, a new key is rotated in if no other one is usable.
+		key, err := k.open(entry)
+		if err != nil {
+			log.Print(ctx, log.KV{"auth.keyring", fmt.Sprintf("ERROR: skipped signing key %s", entry.ID)}, log.KV{"error", err.Error()})
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 || now.Sub(keys[0].createdAt) >= k.rotationInterval {
+		// Only one replica rotates, the others pick the key up on their next
+		// refresh.
+		locked, err := k.store.TryLock(ctx, keyRotationLock, time.Minute)
+		if err != nil {
+			return fmt.Errorf("failed to lock key rotation: %w", err)
+		}
+		if locked {
+			entry, key, err := k.generate(now)
+			if err != nil {
+				return err
+			}
+			if err := k.store.SaveSigningKey(ctx, entry); err != nil {
+				return fmt.Errorf("failed to save signing key: %w", err)
+			}
+			keys = append([]*signingKey{key}, keys...)
+			log.Info(ctx, log.KV{"auth.keyring", fmt.Sprintf("rotated signing key to %s", entry.ID)})
+		}
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.mu.Unlock()
+
+	return nil
+}
+
+// current returns the key signing new JWTs, nil if no key is loaded yet.
+func (k *keyring) current() *signingKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 {
+		return nil
+	}
+	return k.keys[0]
+}
+
+// sign signs the claims with the current key.
+func (k *keyring) sign(ctx context.Context, claims jwt.Claims) (string, error) {
+	key := k.current()
+	if key == nil {
+		if err := k.refresh(ctx); err != nil {
+			return "", err
+		}
+		if key = k.current(); key == nil {
+			return "", fmt.Errorf("no signing key available")
+		}
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = key.id
+	return token.SignedString(key.key)
+}
+
+// jwks returns the public keys verifying the JWTs.
+func (k *keyring) jwks() []*auth.JWK {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	res := make([]*auth.JWK, 0, len(k.keys))
+	for _, key := range k.keys {
+		res = append(res, &auth.JWK{
+			Kty: "RSA",
+			Kid: key.id,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.key.E)).Bytes()),
+		})
+	}
+
+	return res
+}
+
+// generate returns a new signing key and its sealed storage entry.
+func (k *keyring) generate(now time.Time) (*SigningKey, *signingKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	id := uuid.New().String()
+	nonce := make([]byte, k.kek.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	// The ID is authenticated with the key so that sealed keys cannot be
+	// swapped between entries.
+	sealed := k.kek.Seal(nonce, nonce, x509.MarshalPKCS1PrivateKey(key), []byte(id))
+
+	entry := &SigningKey{ID: id, EncryptedKey: sealed, CreatedAt: now}
+	return entry, &signingKey{id: id, key: key, createdAt: now}, nil
+}
+
+// open unseals the signing key of the storage entry.
+func (k *keyring) open(entry *SigningKey) (*signingKey, error) {
+	nonceSize := k.kek.NonceSize()
+	if len(entry.EncryptedKey) < nonceSize {
+		return nil, fmt.Errorf("signing key %s is not sealed", entry.ID)
+	}
+	der, err := k.kek.Open(nil, entry.EncryptedKey[:nonceSize], entry.EncryptedKey[nonceSize:], []byte(entry.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unseal signing key %s: %w", entry.ID, err)
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key %s: %w", entry.ID, err)
+	}
+
+	return &signingKey{id: entry.ID, key: key, createdAt: entry.CreatedAt}, nil
+}
@@ -0,0 +1,50 @@
+package authapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"testing"
+)
+
+func TestSigningKeysSealed(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	key := s.keys.current()
+
+	stored, err := s.store.SigningKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 {
+		t.Fatalf("%d signing keys stored, want 1", len(stored))
+	}
+	if bytes.Contains(stored[0].EncryptedKey, x509.MarshalPKCS1PrivateKey(key.key)) {
+		t.Error("signing key stored in plaintext")
+	}
+
+	// Another replica with the same key encryption key signs with the key.
+	replica := newKeyring(s.store, testKEK(t, 1))
+	if err := replica.refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := replica.current(); got == nil || got.id != key.id || !got.key.Equal(key.key) {
+		t.Error("replica does not sign with the stored key")
+	}
+
+	// A sealed key cannot be swapped into another entry.
+	swapped := *stored[0]
+	swapped.ID = "other"
+	if _, err := replica.open(&swapped); err == nil {
+		t.Error("key unsealed under another ID")
+	}
+
+	// A replica with another key encryption key cannot use the key.
+	other := newKeyring(s.store, testKEK(t, 2))
+	if err := other.refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := other.current(); got != nil && got.id == key.id {
+		t.Error("key unsealed with another key encryption key")
+	}
+}
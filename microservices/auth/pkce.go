@@ -0,0 +1,15 @@
+package authapi
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+// verifyCodeChallenge reports whether the PKCE code verifier matches the S256
+// code challenge (RFC 7636 section 4.6).
+func verifyCodeChallenge(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
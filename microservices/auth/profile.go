@@ -0,0 +1,90 @@
+package authapi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	profilepb "object-t.com/hackz-giganoto/microservices/profile/gen/grpc/profile/pb"
+	"object-t.com/hackz-giganoto/pkg/telemetry"
+)
+
+const (
+	// profileClientID is the client ID of the service JWT auth calls the
+	// profile service with.
+	profileClientID = "auth"
+	profileTimeout  = 5 * time.Second
+)
+
+// newProfileClient returns the client of the profile service at
+// PROFILE_SERVICE_ADDR.
+func newProfileClient() (profilepb.ProfileClient, error) {
+	addr := os.Getenv("PROFILE_SERVICE_ADDR")
+	if addr == "" {
+		addr = "localhost:50052"
+	}
+
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		telemetry.GRPCClientInterceptor(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to profile service: %w", err)
+	}
+	return profilepb.NewProfileClient(conn), nil
+}
+
+// registerProfile creates the profile of the user in the workspace on its
+// first login or first switch to the workspace, seeded from the identity
+// provider. The profile service leaves existing profiles unchanged, so a
+// retry after a failure is harmless.
+func (s *authsrvc) registerProfile(ctx context.Context, userID, workspaceID string, identity *Identity) error {
+	if s.profiles == nil {
+		return nil
+	}
+
+	registered, err := s.store.ProfileRegistered(ctx, registrationID(userID, workspaceID))
+	if err != nil || registered {
+		return err
+	}
+
+	token, err := s.serviceToken(ctx, profileClientID, []string{"api:register"})
+	if err != nil {
+		return fmt.Errorf("failed to sign service token: %w", err)
+	}
+
+	req := &profilepb.RegisterProfileRequest{
+		UserId: userID,
+		Name:   identity.Name,
+		Login:  identity.Login,
+	}
+	if identity.AvatarURL != "" {
+		req.AvatarUrl = &identity.AvatarURL
+	}
+	if workspaceID != "" {
+		req.WorkspaceId = &workspaceID
+	}
+
+	grpcCtx, cancel := context.WithTimeout(ctx, profileTimeout)
+	defer cancel()
+	grpcCtx = metadata.NewOutgoingContext(grpcCtx, metadata.Pairs("authorization", "Bearer "+token))
+	if _, err := s.profiles.RegisterProfile(grpcCtx, req); err != nil {
+		return fmt.Errorf("failed to register profile: %w", err)
+	}
+
+	return s.store.SetProfileRegistered(ctx, registrationID(userID, workspaceID))
+}
+
+// registrationID identifies the profile of the user in the workspace, the
+// user ID alone for the default workspace.
+func registrationID(userID, workspaceID string) string {
+	if workspaceID == "" {
+		return userID
+	}
+	return workspaceID + "/" + userID
+}
@@ -0,0 +1,311 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+// Identity is the user authenticated by an identity provider.
+type Identity struct {
+	// ID is the user ID at the provider, unique per provider only.
+	ID    string
+	Login string
+	Name  string
+	Email string
+	// AvatarURL is the picture of the user, empty if the provider has none.
+	AvatarURL string
+}
+
+// Provider is an OAuth identity provider users log in with.
+type Provider interface {
+	// Name identifies the provider in routes and namespaced user IDs.
+	Name() string
+	// AuthCodeURL returns the URL of the consent page of the provider.
+	AuthCodeURL(ctx context.Context, state string, opts ...oauth2.AuthCodeOption) (string, error)
+	// Exchange converts the authorization code into a provider token.
+	Exchange(ctx context.Context, code string, opts ...oauth2.AuthCodeOption) (*oauth2.Token, error)
+	// Identify returns the user owning the provider token.
+	Identify(ctx context.Context, token *oauth2.Token) (*Identity, error)
+}
+
+// legacyUserID returns the provider namespaced ID users had before their
+// identities were linked to internal user IDs.
+func legacyUserID(provider Provider, identity *Identity) string {
+	return provider.Name() + ":" + identity.ID
+}
+
+// newProviders returns the providers configured with the environment, by
+// name. A provider is enabled when its client ID is set.
+func newProviders() map[string]Provider {
+	providers := make(map[string]Provider)
+	add := func(p Provider) {
+		providers[p.Name()] = p
+	}
+
+	if cfg := providerConfig("GITHUB", []string{"read:user", "user:email"}); cfg != nil {
+		cfg.Endpoint = github.Endpoint
+		add(&oauthProvider{name: "github", config: cfg, userInfoURL: "https://api.github.com/user", parse: parseGitHubUser})
+	}
+
+	if cfg := providerConfig("GITLAB", []string{"read_user"}); cfg != nil {
+		baseURL := strings.TrimSuffix(os.Getenv("GITLAB_URL"), "/")
+		if baseURL == "" {
+			baseURL = "https://gitlab.com"
+		}
+		cfg.Endpoint = oauth2.Endpoint{
+			AuthURL:  baseURL + "/oauth/authorize",
+			TokenURL: baseURL + "/oauth/token",
+		}
+		add(&oauthProvider{name: "gitlab", config: cfg, userInfoURL: baseURL + "/api/v4/user", parse: parseGitLabUser})
+	}
+
+	if cfg := providerConfig("GOOGLE", []string{"openid", "profile", "email"}); cfg != nil {
+		add(newOIDCProvider("google", "https://accounts.google.com", cfg))
+	}
+
+	if cfg := providerConfig("OIDC", []string{"openid", "profile", "email"}); cfg != nil {
+		name := os.Getenv("OIDC_PROVIDER_NAME")
+		if name == "" {
+			name = "oidc"
+		}
+		add(newOIDCProvider(name, os.Getenv("OIDC_ISSUER_URL"), cfg))
+	}
+
+	if DevLoginEnabled() {
+		add(newDevProvider())
+	}
+
+	return providers
+}
+
+// providerConfig reads the OAuth client of the provider from the environment
+// variables with the given prefix, nil if no client ID is set.
+func providerConfig(prefix string, scopes []string) *oauth2.Config {
+	clientID := os.Getenv(prefix + "_CLIENT_ID")
+	if clientID == "" {
+		return nil
+	}
+
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: os.Getenv(prefix + "_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv(prefix + "_REDIRECT_URL"),
+		Scopes:       scopes,
+	}
+}
+
+// oauthProvider is a plain OAuth 2.0 provider with a JSON user endpoint.
+type oauthProvider struct {
+	name        string
+	config      *oauth2.Config
+	userInfoURL string
+	parse       func(body []byte) (*Identity, error)
+}
+
+func (p *oauthProvider) Name() string {
+	return p.name
+}
+
+func (p *oauthProvider) AuthCodeURL(ctx context.Context, state string, opts ...oauth2.AuthCodeOption) (string, error) {
+	return p.config.AuthCodeURL(state, opts...), nil
+}
+
+func (p *oauthProvider) Exchange(ctx context.Context, code string, opts ...oauth2.AuthCodeOption) (*oauth2.Token, error) {
+	return p.config.Exchange(ctx, code, opts...)
+}
+
+func (p *oauthProvider) Identify(ctx context.Context, token *oauth2.Token) (*Identity, error) {
+	body, err := getJSON(ctx, p.config.Client(ctx, token), p.userInfoURL)
+	if err != nil {
+		return nil, err
+	}
+	return p.parse(body)
+}
+
+// GitHub user profile response
+type GitHubUser struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+func parseGitHubUser(body []byte) (*Identity, error) {
+	var user GitHubUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse user info: %w", err)
+	}
+
+	return &Identity{ID: strconv.FormatInt(user.ID, 10), Login: user.Login, Name: user.Name, Email: user.Email, AvatarURL: user.AvatarURL}, nil
+}
+
+// GitLab user profile response
+type GitLabUser struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+func parseGitLabUser(body []byte) (*Identity, error) {
+	var user GitLabUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse user info: %w", err)
+	}
+
+	return &Identity{ID: strconv.FormatInt(user.ID, 10), Login: user.Username, Name: user.Name, Email: user.Email, AvatarURL: user.AvatarURL}, nil
+}
+
+// oidcProvider is an OpenID Connect provider configured from the discovery
+// document of its issuer. The user is read from the userinfo endpoint.
+type oidcProvider struct {
+	name   string
+	issuer string
+	config *oauth2.Config
+
+	mu          sync.Mutex
+	discovered  bool
+	userInfoURL string
+}
+
+// oidcDiscovery is the part of the OpenID Provider Metadata the provider
+// uses.
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// OIDC userinfo response
+type OIDCUser struct {
+	Subject           string `json:"sub"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+	Email             string `json:"email"`
+	Picture           string `json:"picture"`
+}
+
+func newOIDCProvider(name, issuer string, config *oauth2.Config) *oidcProvider {
+	return &oidcProvider{name: name, issuer: strings.TrimSuffix(issuer, "/"), config: config}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.name
+}
+
+// discover loads the endpoints from the discovery document once.
+func (p *oidcProvider) discover(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovered {
+		return nil
+	}
+
+	body, err := getJSON(ctx, http.DefaultClient, p.issuer+"/.well-known/openid-configuration")
+	if err != nil {
+		return fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+
+	var doc oidcDiscovery
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return fmt.Errorf("failed to parse discovery document: %w", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != p.issuer {
+		return fmt.Errorf("discovery document issuer %q does not match %q", doc.Issuer, p.issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.UserinfoEndpoint == "" {
+		return fmt.Errorf("discovery document lacks required endpoints")
+	}
+
+	p.config.Endpoint = oauth2.Endpoint{
+		AuthURL:  doc.AuthorizationEndpoint,
+		TokenURL: doc.TokenEndpoint,
+	}
+	p.userInfoURL = doc.UserinfoEndpoint
+	p.discovered = true
+
+	return nil
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state string, opts ...oauth2.AuthCodeOption) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	return p.config.AuthCodeURL(state, opts...), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code string, opts ...oauth2.AuthCodeOption) (*oauth2.Token, error) {
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+	return p.config.Exchange(ctx, code, opts...)
+}
+
+func (p *oidcProvider) Identify(ctx context.Context, token *oauth2.Token) (*Identity, error) {
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+
+	body, err := getJSON(ctx, p.config.Client(ctx, token), p.userInfoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var user OIDCUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse user info: %w", err)
+	}
+	if user.Subject == "" {
+		return nil, fmt.Errorf("user info lacks the sub claim")
+	}
+
+	login := user.PreferredUsername
+	if login == "" {
+		login = user.Email
+	}
+	if login == "" {
+		login = user.Subject
+	}
+
+	return &Identity{ID: user.Subject, Login: login, Name: user.Name, Email: user.Email, AvatarURL: user.Picture}, nil
+}
+
+// getJSON returns the body of a successful GET request.
+func getJSON(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned %d: %s", url, resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,300 @@
+package authapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/oauth2"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// testIssuer is an httptest stand-in of an OpenID Connect provider.
+type testIssuer struct {
+	*httptest.Server
+	// discovery overrides fields of the discovery document.
+	discovery   map[string]string
+	discoveries atomic.Int64
+	// claims is the userinfo response of the access token.
+	claims map[string]any
+
+	mu       sync.Mutex
+	verifier string
+}
+
+const (
+	testIssuerCode        = "code-1"
+	testIssuerAccessToken = "access-1"
+)
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	i := &testIssuer{
+		claims: map[string]any{
+			"sub":                "sub-1",
+			"preferred_username": "alice",
+			"name":               "Alice",
+			"email":              "alice@example.com",
+			"picture":            "https://example.com/alice.png",
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		i.discoveries.Add(1)
+		doc := map[string]string{
+			"issuer":                 i.URL,
+			"authorization_endpoint": i.URL + "/authorize",
+			"token_endpoint":         i.URL + "/token",
+			"userinfo_endpoint":      i.URL + "/userinfo",
+		}
+		for k, v := range i.discovery {
+			doc[k] = v
+		}
+		json.NewEncoder(w).Encode(doc)
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, ok := r.BasicAuth()
+		if !ok {
+			clientID, secret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+		}
+		if clientID != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		if r.PostFormValue("grant_type") != "authorization_code" || r.PostFormValue("code") != testIssuerCode {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		i.mu.Lock()
+		i.verifier = r.PostFormValue("code_verifier")
+		i.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"access_token": testIssuerAccessToken, "token_type": "Bearer", "expires_in": 3600})
+	})
+	mux.HandleFunc("GET /userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testIssuerAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(i.claims)
+	})
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Close)
+
+	return i
+}
+
+// sentVerifier returns the code verifier of the last token request.
+func (i *testIssuer) sentVerifier() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.verifier
+}
+
+// provider returns a provider of the issuer.
+func (i *testIssuer) provider() *oidcProvider {
+	return newOIDCProvider("oidc", i.URL+"/", &oauth2.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost/auth/oidc/callback",
+		Scopes:       []string{"openid", "profile", "email"},
+	})
+}
+
+func TestOIDCDiscovery(t *testing.T) {
+	issuer := newTestIssuer(t)
+	p := issuer.provider()
+
+	for range 2 {
+		authURL, err := p.AuthCodeURL(context.Background(), "state-1", oauth2.S256ChallengeOption("verifier"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := url.Parse(authURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := u.Scheme + "://" + u.Host + u.Path; got != issuer.URL+"/authorize" {
+			t.Errorf("auth URL at %s, want the authorization endpoint", got)
+		}
+		q := u.Query()
+		if q.Get("client_id") != "client" || q.Get("state") != "state-1" || q.Get("scope") != "openid profile email" {
+			t.Errorf("auth URL query = %v", q)
+		}
+		if q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256" {
+			t.Errorf("auth URL lacks the PKCE challenge: %v", q)
+		}
+	}
+	if got := issuer.discoveries.Load(); got != 1 {
+		t.Errorf("discovery document fetched %d times, want 1", got)
+	}
+}
+
+func TestOIDCDiscoveryRejected(t *testing.T) {
+	cases := []struct {
+		name      string
+		discovery map[string]string
+	}{
+		{"issuer mismatch", map[string]string{"issuer": "https://evil.example.com"}},
+		{"no token endpoint", map[string]string{"token_endpoint": ""}},
+		{"no userinfo endpoint", map[string]string{"userinfo_endpoint": ""}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			issuer := newTestIssuer(t)
+			issuer.discovery = c.discovery
+			p := issuer.provider()
+
+			if _, err := p.AuthCodeURL(context.Background(), "state-1"); err == nil {
+				t.Fatal("AuthCodeURL succeeded")
+			}
+			if _, err := p.Exchange(context.Background(), testIssuerCode); err == nil {
+				t.Fatal("Exchange succeeded")
+			}
+			// A rejected document is fetched again, the provider may be fixed.
+			if got := issuer.discoveries.Load(); got != 2 {
+				t.Errorf("discovery document fetched %d times, want 2", got)
+			}
+		})
+	}
+
+	p := newOIDCProvider("oidc", "http://127.0.0.1:1", &oauth2.Config{})
+	if _, err := p.AuthCodeURL(context.Background(), "state-1"); err == nil {
+		t.Error("AuthCodeURL of an unreachable issuer succeeded")
+	}
+}
+
+func TestOIDCExchange(t *testing.T) {
+	issuer := newTestIssuer(t)
+	p := issuer.provider()
+
+	token, err := p.Exchange(context.Background(), testIssuerCode, oauth2.VerifierOption("verifier-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != testIssuerAccessToken {
+		t.Errorf("access token = %q, want %q", token.AccessToken, testIssuerAccessToken)
+	}
+	if got := issuer.sentVerifier(); got != "verifier-1" {
+		t.Errorf("code verifier sent = %q, want verifier-1", got)
+	}
+
+	if _, err := p.Exchange(context.Background(), "wrong-code"); err == nil {
+		t.Error("exchange of a wrong code succeeded")
+	}
+
+	p.config.ClientSecret = "wrong"
+	if _, err := p.Exchange(context.Background(), testIssuerCode); err == nil {
+		t.Error("exchange with a wrong client secret succeeded")
+	}
+}
+
+func TestOIDCIdentifyClaims(t *testing.T) {
+	cases := []struct {
+		name   string
+		claims map[string]any
+		want   *Identity
+	}{
+		{
+			"all claims",
+			map[string]any{"sub": "sub-1", "preferred_username": "alice", "name": "Alice", "email": "alice@example.com", "picture": "https://example.com/alice.png"},
+			&Identity{ID: "sub-1", Login: "alice", Name: "Alice", Email: "alice@example.com", AvatarURL: "https://example.com/alice.png"},
+		},
+		{
+			"login from email",
+			map[string]any{"sub": "sub-1", "email": "alice@example.com"},
+			&Identity{ID: "sub-1", Login: "alice@example.com", Email: "alice@example.com"},
+		},
+		{
+			"login from subject",
+			map[string]any{"sub": "sub-1"},
+			&Identity{ID: "sub-1", Login: "sub-1"},
+		},
+		{
+			"no subject",
+			map[string]any{"preferred_username": "alice"},
+			nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			issuer := newTestIssuer(t)
+			issuer.claims = c.claims
+			p := issuer.provider()
+
+			identity, err := p.Identify(context.Background(), &oauth2.Token{AccessToken: testIssuerAccessToken, TokenType: "Bearer"})
+			if c.want == nil {
+				if err == nil {
+					t.Fatalf("identity %+v without the sub claim", identity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *identity != *c.want {
+				t.Errorf("identity = %+v, want %+v", identity, c.want)
+			}
+		})
+	}
+
+	issuer := newTestIssuer(t)
+	if _, err := issuer.provider().Identify(context.Background(), &oauth2.Token{AccessToken: "other", TokenType: "Bearer"}); err == nil {
+		t.Error("userinfo of an unknown access token succeeded")
+	}
+}
+
+func TestOIDCLogin(t *testing.T) {
+	issuer := newTestIssuer(t)
+	s := newTestService(t)
+	s.providers["oidc"] = issuer.provider()
+	ctx := context.Background()
+
+	start, err := s.AuthURL(ctx, &auth.AuthURLPayload{Provider: "oidc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	challenge := queryParam(t, start.AuthURL, "code_challenge")
+
+	res, err := s.OauthCallback(ctx, &auth.OauthCallbackPayload{Provider: "oidc", Code: testIssuerCode, State: start.State})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The verifier of the state answers the challenge of the auth URL.
+	sum := sha256.Sum256([]byte(issuer.sentVerifier()))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+		t.Error("the code verifier sent to the provider does not match the challenge")
+	}
+
+	uid, err := s.store.IdentityUser(ctx, "oidc", "sub-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid == "" || uid != res.UserID {
+		t.Errorf("identity of user %q, want %q", uid, res.UserID)
+	}
+
+	// The state is consumed by the callback.
+	if _, err := s.OauthCallback(ctx, &auth.OauthCallbackPayload{Provider: "oidc", Code: testIssuerCode, State: start.State}); errorName(err) != "invalid_state" {
+		t.Errorf("replayed callback: err = %v, want invalid_state", err)
+	}
+}
+
+// queryParam returns the query parameter of the URL.
+func queryParam(t *testing.T, rawURL, name string) string {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u.Query().Get(name)
+}
@@ -0,0 +1,168 @@
+package authapi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+const (
+	rateLimitWindow = time.Minute
+	// failureWindow is the window the failures locking a client out are
+	// counted in.
+	failureWindow = 5 * time.Minute
+	// lockoutLevelTTL is how long the previous lockouts keep doubling the
+	// next one.
+	lockoutLevelTTL = 24 * time.Hour
+)
+
+// limits configures the rate limits, per minute, and the lockout of the
+// clients sending invalid tokens or states.
+type limits struct {
+	introspectPerIP    int64
+	introspectPerToken int64
+	loginPerIP         int64
+	failureThreshold   int64
+	lockoutBase        time.Duration
+	lockoutMax         time.Duration
+}
+
+// loadLimits reads the limits from the environment.
+func loadLimits() limits {
+	return limits{
+		introspectPerIP:    envInt("AUTH_RATE_LIMIT_INTROSPECT_IP", 1200),
+		introspectPerToken: envInt("AUTH_RATE_LIMIT_INTROSPECT_TOKEN", 600),
+		loginPerIP:         envInt("AUTH_RATE_LIMIT_LOGIN_IP", 30),
+		failureThreshold:   envInt("AUTH_LOCKOUT_THRESHOLD", 20),
+		lockoutBase:        envDuration("AUTH_LOCKOUT_BASE", time.Minute),
+		lockoutMax:         envDuration("AUTH_LOCKOUT_MAX", time.Hour),
+	}
+}
+
+func envInt(name string, def int64) int64 {
+	if v, err := strconv.ParseInt(os.Getenv(name), 10, 64); err == nil {
+		return v
+	}
+	return def
+}
+
+func envDuration(name string, def time.Duration) time.Duration {
+	if v, err := time.ParseDuration(os.Getenv(name)); err == nil {
+		return v
+	}
+	return def
+}
+
+// limitTrips counts the requests rejected by a rate limit or a lockout.
+var limitTrips, _ = otel.Meter("auth").Int64Counter(
+	"auth.limit.trips",
+	metric.WithDescription("Requests rejected by a rate limit or a lockout"),
+)
+
+// rateLimit rejects the request once the subject made more than limit
+// requests to the endpoint in the current window. A limit of 0 disables it.
+// Store failures let the request through rather than taking auth down.
+func (s *authsrvc) rateLimit(ctx context.Context, endpoint, subject string, limit int64) error {
+	if limit <= 0 || subject == "" {
+		return nil
+	}
+
+	window := time.Now().Truncate(rateLimitWindow).Unix()
+	count, err := s.store.Incr(ctx, fmt.Sprintf("rate:%s:%s:%d", endpoint, subject, window), rateLimitWindow)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.rate_limit", "ERROR: failed to count request"}, log.KV{"error", err.Error()})
+		return nil
+	}
+	if count <= limit {
+		return nil
+	}
+
+	limitTrips.Add(ctx, 1, metric.WithAttributes(attribute.String("endpoint", endpoint), attribute.String("kind", "rate_limit")))
+	// Audit the first rejection of the window only.
+	if count == limit+1 {
+		s.audit(ctx, &AuditEntry{Type: auditRateLimited, Reason: endpoint + " " + subject})
+		log.Print(ctx, log.KV{"auth.rate_limit", fmt.Sprintf("ERROR: rate limit of %s exceeded by %s", endpoint, subject)})
+	}
+	return auth.TooManyRequests("Rate limit exceeded, retry later")
+}
+
+// checkLockout rejects the request if the client is locked out.
+func (s *authsrvc) checkLockout(ctx context.Context, endpoint string) error {
+	subject := lockoutSubject(ctx)
+	if subject == "" {
+		return nil
+	}
+
+	remaining, err := s.store.Lockout(ctx, subject)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.lockout", "ERROR: failed to load lockout"}, log.KV{"error", err.Error()})
+		return nil
+	}
+	if remaining == 0 {
+		return nil
+	}
+
+	limitTrips.Add(ctx, 1, metric.WithAttributes(attribute.String("endpoint", endpoint), attribute.String("kind", "lockout")))
+	return auth.TooManyRequests(fmt.Sprintf("Too many invalid requests, retry in %d seconds", int64(remaining.Seconds())+1))
+}
+
+// recordFailure counts an invalid token or state of the client, and locks it
+// out once the threshold is reached. Every lockout within a day doubles the
+// duration of the next one, up to the maximum.
+func (s *authsrvc) recordFailure(ctx context.Context, endpoint, reason string) {
+	subject := lockoutSubject(ctx)
+	if subject == "" || s.limits.failureThreshold <= 0 {
+		return
+	}
+
+	window := time.Now().Truncate(failureWindow).Unix()
+	failures, err := s.store.Incr(ctx, fmt.Sprintf("failures:%s:%d", subject, window), failureWindow)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.lockout", "ERROR: failed to count failure"}, log.KV{"error", err.Error()})
+		return
+	}
+	if failures < s.limits.failureThreshold {
+		return
+	}
+
+	level, err := s.store.Incr(ctx, "lockout_level:"+subject, lockoutLevelTTL)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.lockout", "ERROR: failed to count lockout"}, log.KV{"error", err.Error()})
+		return
+	}
+	duration := s.limits.lockoutBase
+	for i := int64(1); i < level && duration < s.limits.lockoutMax; i++ {
+		duration *= 2
+	}
+	duration = min(duration, s.limits.lockoutMax)
+
+	if err := s.store.SetLockout(ctx, subject, duration); err != nil {
+		log.Print(ctx, log.KV{"auth.lockout", "ERROR: failed to lock out"}, log.KV{"error", err.Error()})
+		return
+	}
+
+	limitTrips.Add(ctx, 1, metric.WithAttributes(attribute.String("endpoint", endpoint), attribute.String("kind", "lockout")))
+	s.audit(ctx, &AuditEntry{Type: auditLockedOut, Reason: fmt.Sprintf("%s after %d failures (%s), for %s", endpoint, failures, reason, duration)})
+	log.Print(ctx, log.KV{"auth.lockout", fmt.Sprintf("ERROR: locked out %s for %s", subject, duration)})
+}
+
+// lockoutSubject identifies the client by its IP.
+func lockoutSubject(ctx context.Context) string {
+	if ip := contextClientInfo(ctx).IP; ip != "" {
+		return "ip:" + ip
+	}
+	return ""
+}
+
+// tokenSubject identifies the token without keeping it in the store.
+func tokenSubject(token string) string {
+	return "token:" + hashPersonalToken(token)[:16]
+}
@@ -0,0 +1,196 @@
+package authapi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// Roles of the users, from the least to the most privileged. Every user has
+// the user role, the others are granted by admins.
+const (
+	roleUser      = "user"
+	roleModerator = "moderator"
+	roleAdmin     = "admin"
+)
+
+var roleOrder = []string{roleUser, roleModerator, roleAdmin}
+
+// roleScopes maps the roles to the scopes they grant.
+var roleScopes = map[string][]string{
+	roleUser:      {"api:read", "api:write"},
+	roleModerator: {"api:read", "api:write", "api:moderate"},
+	roleAdmin:     {"api:read", "api:write", "api:moderate", "api:admin"},
+}
+
+// userRoles returns the roles of the user, ordered by privilege.
+func (s *authsrvc) userRoles(ctx context.Context, userID string) ([]string, error) {
+	stored, err := s.store.UserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := map[string]bool{roleUser: true}
+	for _, role := range stored {
+		granted[role] = true
+	}
+
+	roles := make([]string, 0, len(granted))
+	for _, role := range roleOrder {
+		if granted[role] {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles, nil
+}
+
+// scopes returns the scopes granted to the user by its roles.
+func (s *authsrvc) scopes(ctx context.Context, userID string) ([]string, error) {
+	roles, err := s.userRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return scopesOf(roles), nil
+}
+
+// scopeOwner returns the user whose roles bound the scopes of the tokens of
+// the user: the bots are bound by the roles of their creator.
+func (s *authsrvc) scopeOwner(ctx context.Context, userID string) (string, error) {
+	botID, ok := strings.CutPrefix(userID, "bot:")
+	if !ok {
+		return userID, nil
+	}
+	bot, err := s.store.GetBot(ctx, botID)
+	if err != nil || bot == nil {
+		return "", err
+	}
+	return bot.CreatedBy, nil
+}
+
+// heldScopes returns the scopes of a token of the user that its owner still
+// holds. Tokens keep the scopes they were issued with, so that a revoked role
+// applies to them at once.
+func (s *authsrvc) heldScopes(ctx context.Context, userID string, scopes []string) (held []string, ownerID string, err error) {
+	ownerID, err = s.scopeOwner(ctx, userID)
+	if err != nil || ownerID == "" {
+		return nil, ownerID, err
+	}
+	ownerScopes, err := s.scopes(ctx, ownerID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	held = make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if grantable([]string{scope}, ownerScopes) {
+			held = append(held, scope)
+		}
+	}
+	return held, ownerID, nil
+}
+
+func scopesOf(roles []string) []string {
+	var scopes []string
+	for _, role := range roles {
+		scopes = append(scopes, roleScopes[role]...)
+	}
+	return dedupScopes(scopes)
+}
+
+// bootstrapAdmin grants the admin role to the GitHub user configured with
+// AUTH_BOOTSTRAP_ADMIN_LOGIN, so that a fresh deployment has an admin.
+func (s *authsrvc) bootstrapAdmin(ctx context.Context, provider Provider, identity *Identity, uid string) error {
+	login := os.Getenv("AUTH_BOOTSTRAP_ADMIN_LOGIN")
+	if login == "" || provider.Name() != "github" || !strings.EqualFold(identity.Login, login) {
+		return nil
+	}
+
+	roles, err := s.store.UserRoles(ctx, uid)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role == roleAdmin {
+			return nil
+		}
+	}
+
+	if err := s.store.GrantRole(ctx, uid, roleAdmin); err != nil {
+		return err
+	}
+	s.audit(ctx, &AuditEntry{Type: auditRoleGranted, UserID: uid, Provider: provider.Name(), Reason: roleAdmin})
+
+	log.Info(ctx, log.KV{"auth.bootstrap_admin", fmt.Sprintf("granted the admin role to %s", identity.Login)})
+	return nil
+}
+
+// Get the roles of a user
+func (s *authsrvc) GetUserRoles(ctx context.Context, p *auth.GetUserRolesPayload) (res *auth.UserRoles, err error) {
+	res, err = s.rolesResult(ctx, p.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.get_user_roles", "ERROR: failed to load roles"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	return res, nil
+}
+
+// Grant a role to a user
+func (s *authsrvc) GrantRole(ctx context.Context, p *auth.GrantRolePayload) (res *auth.UserRoles, err error) {
+	if err := s.store.GrantRole(ctx, p.UserID, p.Role); err != nil {
+		log.Print(ctx, log.KV{"auth.grant_role", "ERROR: failed to grant role"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	res, err = s.rolesResult(ctx, p.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.grant_role", "ERROR: failed to load roles"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditRoleGranted, UserID: p.UserID, Reason: p.Role})
+	if token, ok := contextToken(ctx); ok {
+		log.Info(ctx, log.KV{"auth.grant_role", fmt.Sprintf("user %s granted role %s to user %s", token.Entry.Login, p.Role, p.UserID)})
+	}
+	return res, nil
+}
+
+// Revoke a role of a user
+func (s *authsrvc) RevokeRole(ctx context.Context, p *auth.RevokeRolePayload) (res *auth.UserRoles, err error) {
+	if err := s.store.RevokeRole(ctx, p.UserID, p.Role); err != nil {
+		log.Print(ctx, log.KV{"auth.revoke_role", "ERROR: failed to revoke role"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	// The scopes of the tokens are checked again when their JWTs are minted.
+	s.revoke(ctx, &Revocation{OwnerID: p.UserID})
+
+	res, err = s.rolesResult(ctx, p.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.revoke_role", "ERROR: failed to load roles"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditRoleRevoked, UserID: p.UserID, Reason: p.Role})
+	if token, ok := contextToken(ctx); ok {
+		log.Info(ctx, log.KV{"auth.revoke_role", fmt.Sprintf("user %s revoked role %s of user %s", token.Entry.Login, p.Role, p.UserID)})
+	}
+	return res, nil
+}
+
+func (s *authsrvc) rolesResult(ctx context.Context, userID string) (*auth.UserRoles, error) {
+	roles, err := s.userRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.UserRoles{
+		UserID: userID,
+		Roles:  roles,
+		Scopes: scopesOf(roles),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package authapi
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"goa.design/goa/v3/security"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+func introspectScopes(t *testing.T, s *authsrvc, token string) []string {
+	t.Helper()
+	res, err := s.Introspect(context.Background(), &auth.IntrospectPayload{Token: token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.Scopes
+}
+
+func TestRevokeRoleAppliesToIssuedTokens(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	if err := s.store.GrantRole(ctx, "admin", roleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	access, _ := login(t, s, "admin", "")
+	adminCtx := tokenContext(t, s, access)
+
+	pat, err := s.CreateToken(adminCtx, &auth.CreateTokenPayload{Name: "ci", Scopes: []string{"api:read", "api:admin"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bot, err := s.CreateBot(adminCtx, &auth.CreateBotPayload{Name: "bot", Scopes: []string{"api:read", "api:admin"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := map[string]string{"access token": access, "personal access token": pat.Token, "bot API key": bot.APIKey}
+	for kind, token := range tokens {
+		// Introspect once so that the JWTs are cached.
+		if scopes := introspectScopes(t, s, token); !slices.Contains(scopes, "api:admin") {
+			t.Fatalf("%s scopes = %v before the revocation, want api:admin", kind, scopes)
+		}
+	}
+
+	if _, err := s.RevokeRole(ctx, &auth.RevokeRolePayload{UserID: "admin", Role: roleAdmin}); err != nil {
+		t.Fatal(err)
+	}
+
+	for kind, token := range tokens {
+		scopes := introspectScopes(t, s, token)
+		if slices.Contains(scopes, "api:admin") {
+			t.Errorf("%s scopes = %v after the revocation, want no api:admin", kind, scopes)
+		}
+		if !slices.Contains(scopes, "api:read") {
+			t.Errorf("%s scopes = %v, want the scopes still held", kind, scopes)
+		}
+	}
+
+	admin := &security.OAuth2Scheme{RequiredScopes: []string{"api:admin"}}
+	if _, err := s.OAuth2Auth(ctx, access, admin); errorName(err) != "forbidden" {
+		t.Errorf("admin endpoint authorized after the revocation: err = %v", err)
+	}
+
+	res, err := s.TokenIntrospect(contextWithClient(ctx, &ServiceClient{ClientID: "bff"}), &auth.TokenIntrospectPayload{Token: pat.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Scope == nil || *res.Scope != "api:read" {
+		t.Errorf("RFC 7662 scope = %v, want api:read", res.Scope)
+	}
+}
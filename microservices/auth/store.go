@@ -0,0 +1,136 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	stateKey       = "auth_state"
+	tokenKey       = "auth_token"
+	refreshKey     = "auth_refresh"
+	refreshUsedKey = "auth_refresh_used"
+	familyKey      = "auth_family"
+	userKey        = "auth_user"
+	sessionKey     = "auth_session"
+	signingKeysKey = "auth_signing_keys"
+	lockKey        = "auth_lock"
+	patKey         = "auth_pat"
+	patHashKey     = "auth_pat_hash"
+	userPatsKey    = "auth_user_pats"
+	botsKey        = "auth_bots"
+	rolesKey       = "auth_roles"
+	deviceKey      = "auth_device"
+	deviceUserKey  = "auth_device_user"
+	// deviceApprovalKey keeps the approvals apart from the device
+	// authorizations, which polls rewrite.
+	deviceApprovalKey = "auth_device_approval"
+	auditKey          = "auth_audit"
+	registeredKey     = "auth_registered"
+	counterKey        = "auth_counter"
+	lockoutKey        = "auth_lockout"
+	identityKey       = "auth_identity"
+	identitiesKey     = "auth_user_identities"
+	workspacesKey     = "auth_workspaces"
+	membersKey        = "auth_workspace_members"
+	userSpacesKey     = "auth_user_workspaces"
+	// revocationsChannel is the pub/sub channel of the revocations, as JSON
+	// Revocation messages.
+	revocationsChannel = "auth_revocations"
+)
+
+// Store keeps the entries of the auth service, one interface per concern.
+// Lookups return nil without error when the entry does not exist or expired.
+type Store interface {
+	StateStore
+	TokenStore
+	SessionStore
+	PersonalTokenStore
+	BotStore
+	IdentityStore
+	WorkspaceStore
+	RoleStore
+	DeviceStore
+	AuditStore
+	KeyStore
+	LimitStore
+	RevocationStore
+}
+
+type redisStore struct {
+	redis *redis.Client
+}
+
+// NewRedisStore returns a Store backed by Redis. Entries expire with Redis
+// TTLs, so every auth replica shares them.
+func NewRedisStore(redis *redis.Client) Store {
+	return &redisStore{redis: redis}
+}
+
+func (s *redisStore) set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	if err := s.redis.Set(ctx, key, valueJSON, ttl).Err(); err != nil {
+		return fmt.Errorf("redis Set failed: %w", err)
+	}
+
+	return nil
+}
+
+// memoryStore keeps the entries of every concern in maps guarded by mu. A
+// single mutex keeps the operations spanning several maps, like revoking the
+// sessions of a token family, atomic.
+type memoryStore struct {
+	mu          sync.RWMutex
+	states      map[string]memoryState
+	tokens      map[string]TokenEntry
+	refreshes   map[string]*memoryRefresh
+	sessions    map[string]SessionEntry
+	pats        map[string]PersonalTokenEntry
+	bots        map[string]BotEntry
+	identities  map[string]string
+	userIdents  map[string]map[string]IdentityEntry
+	workspaces  map[string]WorkspaceEntry
+	members     map[string]map[string]bool
+	roles       map[string]map[string]bool
+	devices     map[string]DeviceEntry
+	registered  map[string]bool
+	audit       []AuditEntry
+	signingKeys map[string]SigningKey
+	locks       map[string]time.Time
+	counters    map[string]*memoryCounter
+	lockouts    map[string]time.Time
+	subscribers []chan *Revocation
+}
+
+// NewMemoryStore returns a Store keeping the entries in process maps. It is
+// meant for tests and single replica development setups.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		states:      make(map[string]memoryState),
+		tokens:      make(map[string]TokenEntry),
+		refreshes:   make(map[string]*memoryRefresh),
+		sessions:    make(map[string]SessionEntry),
+		pats:        make(map[string]PersonalTokenEntry),
+		bots:        make(map[string]BotEntry),
+		identities:  make(map[string]string),
+		userIdents:  make(map[string]map[string]IdentityEntry),
+		workspaces:  make(map[string]WorkspaceEntry),
+		members:     make(map[string]map[string]bool),
+		roles:       make(map[string]map[string]bool),
+		devices:     make(map[string]DeviceEntry),
+		registered:  make(map[string]bool),
+		signingKeys: make(map[string]SigningKey),
+		locks:       make(map[string]time.Time),
+		counters:    make(map[string]*memoryCounter),
+		lockouts:    make(map[string]time.Time),
+	}
+}
@@ -0,0 +1,147 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// auditMaxLen is the approximate number of audit entries kept in the stream,
+// the oldest ones are trimmed.
+const auditMaxLen = 100000
+
+// Audit log entry. ID is assigned by the store and orders the entries.
+type AuditEntry struct {
+	ID        string
+	Type      string
+	UserID    string
+	ActorID   string
+	IP        string
+	Provider  string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// AuditFilter selects the audit entries. Zero values match every entry.
+type AuditFilter struct {
+	// UserID matches the entries about the user or performed by the user.
+	UserID string
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+}
+
+func (f *AuditFilter) match(entry *AuditEntry) bool {
+	if f.UserID != "" && entry.UserID != f.UserID && entry.ActorID != f.UserID {
+		return false
+	}
+	if !f.Since.IsZero() && entry.CreatedAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && entry.CreatedAt.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// AuditStore keeps the audit log.
+type AuditStore interface {
+	// AppendAudit appends the entry to the audit log and sets its ID.
+	AppendAudit(ctx context.Context, entry *AuditEntry) error
+	// AuditEntries returns the audit entries matching the filter, newest
+	// first.
+	AuditEntries(ctx context.Context, filter AuditFilter) ([]*AuditEntry, error)
+}
+
+func (s *redisStore) AppendAudit(ctx context.Context, entry *AuditEntry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	id, err := s.redis.XAdd(ctx, &redis.XAddArgs{
+		Stream: auditKey,
+		MaxLen: auditMaxLen,
+		Approx: true,
+		Values: map[string]any{"entry": entryJSON},
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("redis XAdd failed: %w", err)
+	}
+	entry.ID = id
+
+	return nil
+}
+
+func (s *redisStore) AuditEntries(ctx context.Context, filter AuditFilter) ([]*AuditEntry, error) {
+	// Stream IDs start with the millisecond timestamp, so the time range is
+	// an ID range.
+	start, end := "-", "+"
+	if !filter.Since.IsZero() {
+		start = fmt.Sprint(filter.Since.UnixMilli())
+	}
+	if !filter.Until.IsZero() {
+		end = fmt.Sprint(filter.Until.UnixMilli())
+	}
+
+	const batch = 500
+	var entries []*AuditEntry
+	for {
+		messages, err := s.redis.XRevRangeN(ctx, auditKey, end, start, batch).Result()
+		if err != nil {
+			return nil, fmt.Errorf("redis XRevRange failed: %w", err)
+		}
+
+		for _, message := range messages {
+			entryJSON, _ := message.Values["entry"].(string)
+			var entry AuditEntry
+			if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+				return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+			}
+			entry.ID = message.ID
+			if !filter.match(&entry) {
+				continue
+			}
+			entries = append(entries, &entry)
+			if filter.Limit > 0 && len(entries) == filter.Limit {
+				return entries, nil
+			}
+		}
+
+		if len(messages) < batch {
+			return entries, nil
+		}
+		end = "(" + messages[len(messages)-1].ID
+	}
+}
+
+func (s *memoryStore) AppendAudit(ctx context.Context, entry *AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry.ID = fmt.Sprintf("%d-%d", entry.CreatedAt.UnixMilli(), len(s.audit))
+	s.audit = append(s.audit, *entry)
+	return nil
+}
+
+func (s *memoryStore) AuditEntries(ctx context.Context, filter AuditFilter) ([]*AuditEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries []*AuditEntry
+	for i := len(s.audit) - 1; i >= 0; i-- {
+		entry := s.audit[i]
+		if !filter.match(&entry) {
+			continue
+		}
+		entries = append(entries, &entry)
+		if filter.Limit > 0 && len(entries) == filter.Limit {
+			break
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,125 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Bot storage entry
+type BotEntry struct {
+	ID        string
+	Name      string
+	UserID    string
+	Scopes    []string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// BotStore keeps the bot users.
+type BotStore interface {
+	// SaveBot stores the bot.
+	SaveBot(ctx context.Context, entry *BotEntry) error
+	// GetBot returns the bot.
+	GetBot(ctx context.Context, id string) (*BotEntry, error)
+	// Bots returns every bot.
+	Bots(ctx context.Context) ([]*BotEntry, error)
+	// DeleteBot removes the bot.
+	DeleteBot(ctx context.Context, id string) error
+}
+
+func (s *redisStore) SaveBot(ctx context.Context, entry *BotEntry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	if err := s.redis.HSet(ctx, botsKey, entry.ID, entryJSON).Err(); err != nil {
+		return fmt.Errorf("redis HSet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) GetBot(ctx context.Context, id string) (*BotEntry, error) {
+	entryJSON, err := s.redis.HGet(ctx, botsKey, id).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis HGet failed: %w", err)
+	}
+
+	var entry BotEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (s *redisStore) Bots(ctx context.Context) ([]*BotEntry, error) {
+	entriesJSON, err := s.redis.HGetAll(ctx, botsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis HGetAll failed: %w", err)
+	}
+
+	entries := make([]*BotEntry, 0, len(entriesJSON))
+	for _, entryJSON := range entriesJSON {
+		var entry BotEntry
+		if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+func (s *redisStore) DeleteBot(ctx context.Context, id string) error {
+	return s.redis.HDel(ctx, botsKey, id).Err()
+}
+
+func (s *memoryStore) SaveBot(ctx context.Context, entry *BotEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bots[entry.ID] = *entry
+	return nil
+}
+
+func (s *memoryStore) GetBot(ctx context.Context, id string) (*BotEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.bots[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &entry, nil
+}
+
+func (s *memoryStore) Bots(ctx context.Context) ([]*BotEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]*BotEntry, 0, len(s.bots))
+	for _, entry := range s.bots {
+		e := entry
+		entries = append(entries, &e)
+	}
+
+	return entries, nil
+}
+
+func (s *memoryStore) DeleteBot(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.bots, id)
+	return nil
+}
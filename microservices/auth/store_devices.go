@@ -0,0 +1,226 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Device authorization storage entry (RFC 8628)
+type DeviceEntry struct {
+	DeviceCode string
+	UserCode   string
+	Provider   string
+	// ClientID is the client requesting the authorization, as it claims.
+	ClientID     string
+	Interval     time.Duration
+	LastPolledAt time.Time
+	ExpiresAt    time.Time
+	// Approved is set with the user once the user logged in. The approval
+	// is stored apart from the entry so that polls cannot overwrite it.
+	Approved bool   `json:"-"`
+	UserID   string `json:"-"`
+	Login    string `json:"-"`
+}
+
+// deviceApproval is the approval of a device authorization.
+type deviceApproval struct {
+	UserID string
+	Login  string
+}
+
+// DeviceStore keeps the device authorizations until they expire.
+type DeviceStore interface {
+	// SaveDevice stores the new device authorization until it expires.
+	SaveDevice(ctx context.Context, entry *DeviceEntry) error
+	// GetDevice returns the device authorization with the given device code.
+	GetDevice(ctx context.Context, deviceCode string) (*DeviceEntry, error)
+	// DeviceByUserCode returns the device authorization with the given user
+	// code.
+	DeviceByUserCode(ctx context.Context, userCode string) (*DeviceEntry, error)
+	// PollDevice records the last poll and the interval of the device
+	// authorization, leaving its approval untouched.
+	PollDevice(ctx context.Context, entry *DeviceEntry) error
+	// ApproveDevice grants the device authorization to the user. It reports
+	// false if it expired or was already approved.
+	ApproveDevice(ctx context.Context, entry *DeviceEntry, userID, login string) (bool, error)
+	// ConsumeDevice deletes the device authorization. It reports false if it
+	// did not exist anymore, so that its tokens are issued only once.
+	ConsumeDevice(ctx context.Context, entry *DeviceEntry) (bool, error)
+}
+
+func (s *redisStore) SaveDevice(ctx context.Context, entry *DeviceEntry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	ttl := time.Until(entry.ExpiresAt)
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, deviceKey+":"+entry.DeviceCode, entryJSON, ttl)
+		pipe.Set(ctx, deviceUserKey+":"+entry.UserCode, entry.DeviceCode, ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) GetDevice(ctx context.Context, deviceCode string) (*DeviceEntry, error) {
+	values, err := s.redis.MGet(ctx, deviceKey+":"+deviceCode, deviceApprovalKey+":"+deviceCode).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis MGet failed: %w", err)
+	}
+	entryJSON, ok := values[0].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	var entry DeviceEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+	if approvalJSON, ok := values[1].(string); ok {
+		var approval deviceApproval
+		if err := json.Unmarshal([]byte(approvalJSON), &approval); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+		}
+		entry.Approved = true
+		entry.UserID = approval.UserID
+		entry.Login = approval.Login
+	}
+
+	return &entry, nil
+}
+
+func (s *redisStore) DeviceByUserCode(ctx context.Context, userCode string) (*DeviceEntry, error) {
+	deviceCode, err := s.redis.Get(ctx, deviceUserKey+":"+userCode).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	return s.GetDevice(ctx, deviceCode)
+}
+
+func (s *redisStore) PollDevice(ctx context.Context, entry *DeviceEntry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	// XX leaves an entry consumed meanwhile deleted.
+	err = s.redis.SetArgs(ctx, deviceKey+":"+entry.DeviceCode, entryJSON, redis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("redis Set failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) ApproveDevice(ctx context.Context, entry *DeviceEntry, userID, login string) (bool, error) {
+	approvalJSON, err := json.Marshal(&deviceApproval{UserID: userID, Login: login})
+	if err != nil {
+		return false, fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	ttl := time.Until(entry.ExpiresAt)
+	if ttl <= 0 {
+		return false, nil
+	}
+	approved, err := s.redis.SetNX(ctx, deviceApprovalKey+":"+entry.DeviceCode, approvalJSON, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+
+	return approved, nil
+}
+
+func (s *redisStore) ConsumeDevice(ctx context.Context, entry *DeviceEntry) (bool, error) {
+	deleted, err := s.redis.Del(ctx, deviceKey+":"+entry.DeviceCode).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis Del failed: %w", err)
+	}
+	s.redis.Del(ctx, deviceUserKey+":"+entry.UserCode, deviceApprovalKey+":"+entry.DeviceCode)
+
+	return deleted > 0, nil
+}
+
+func (s *memoryStore) SaveDevice(ctx context.Context, entry *DeviceEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.devices[entry.DeviceCode] = *entry
+	return nil
+}
+
+func (s *memoryStore) GetDevice(ctx context.Context, deviceCode string) (*DeviceEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.devices[deviceCode]
+	if !ok || time.Now().After(entry.ExpiresAt) {
+		return nil, nil
+	}
+
+	return &entry, nil
+}
+
+func (s *memoryStore) DeviceByUserCode(ctx context.Context, userCode string) (*DeviceEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, entry := range s.devices {
+		if entry.UserCode == userCode && time.Now().Before(entry.ExpiresAt) {
+			return &entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *memoryStore) PollDevice(ctx context.Context, entry *DeviceEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.devices[entry.DeviceCode]
+	if !ok {
+		return nil
+	}
+	stored.LastPolledAt = entry.LastPolledAt
+	stored.Interval = entry.Interval
+	s.devices[entry.DeviceCode] = stored
+	return nil
+}
+
+func (s *memoryStore) ApproveDevice(ctx context.Context, entry *DeviceEntry, userID, login string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.devices[entry.DeviceCode]
+	if !ok || stored.Approved || time.Now().After(stored.ExpiresAt) {
+		return false, nil
+	}
+	stored.Approved = true
+	stored.UserID = userID
+	stored.Login = login
+	s.devices[entry.DeviceCode] = stored
+	return true, nil
+}
+
+func (s *memoryStore) ConsumeDevice(ctx context.Context, entry *DeviceEntry) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.devices[entry.DeviceCode]
+	delete(s.devices, entry.DeviceCode)
+
+	return ok, nil
+}
@@ -0,0 +1,180 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Identity storage entry, linking the account of an identity provider to an
+// internal user.
+type IdentityEntry struct {
+	Provider string
+	// Subject is the ID of the account at the provider.
+	Subject   string
+	UserID    string
+	Login     string
+	Name      string
+	Email     string
+	AvatarURL string
+	LinkedAt  time.Time
+}
+
+// IdentityStore keeps the identities linked to the users and whether their
+// profiles were registered.
+type IdentityStore interface {
+	// IdentityUser returns the user the identity is linked to, empty if it
+	// is not linked.
+	IdentityUser(ctx context.Context, provider, subject string) (string, error)
+	// LinkIdentity links the identity to its user, or updates the link. It
+	// reports false if the identity is linked to another user.
+	LinkIdentity(ctx context.Context, entry *IdentityEntry) (bool, error)
+	// UserIdentities returns the identities linked to the user.
+	UserIdentities(ctx context.Context, userID string) ([]*IdentityEntry, error)
+	// UnlinkIdentity removes the link of the identity.
+	UnlinkIdentity(ctx context.Context, entry *IdentityEntry) error
+	// ProfileRegistered reports whether the profile of the user was
+	// registered.
+	ProfileRegistered(ctx context.Context, userID string) (bool, error)
+	// SetProfileRegistered records that the profile of the user was
+	// registered.
+	SetProfileRegistered(ctx context.Context, userID string) error
+}
+
+func (s *redisStore) IdentityUser(ctx context.Context, provider, subject string) (string, error) {
+	userID, err := s.redis.Get(ctx, identityKey+":"+provider+":"+subject).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("redis Get failed: %w", err)
+	}
+	return userID, nil
+}
+
+func (s *redisStore) LinkIdentity(ctx context.Context, entry *IdentityEntry) (bool, error) {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return false, fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	key := identityKey + ":" + entry.Provider + ":" + entry.Subject
+	linked, err := s.redis.SetNX(ctx, key, entry.UserID, 0).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+	if !linked {
+		userID, err := s.redis.Get(ctx, key).Result()
+		if err != nil && err != redis.Nil {
+			return false, fmt.Errorf("redis Get failed: %w", err)
+		}
+		if userID != entry.UserID {
+			return false, nil
+		}
+	}
+
+	if err := s.redis.HSet(ctx, identitiesKey+":"+entry.UserID, entry.Provider, entryJSON).Err(); err != nil {
+		return false, fmt.Errorf("redis HSet failed: %w", err)
+	}
+	return true, nil
+}
+
+func (s *redisStore) UserIdentities(ctx context.Context, userID string) ([]*IdentityEntry, error) {
+	entriesJSON, err := s.redis.HGetAll(ctx, identitiesKey+":"+userID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis HGetAll failed: %w", err)
+	}
+
+	entries := make([]*IdentityEntry, 0, len(entriesJSON))
+	for _, entryJSON := range entriesJSON {
+		var entry IdentityEntry
+		if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+func (s *redisStore) UnlinkIdentity(ctx context.Context, entry *IdentityEntry) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, identityKey+":"+entry.Provider+":"+entry.Subject)
+		pipe.HDel(ctx, identitiesKey+":"+entry.UserID, entry.Provider)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+	return nil
+}
+
+func (s *redisStore) ProfileRegistered(ctx context.Context, userID string) (bool, error) {
+	return s.redis.SIsMember(ctx, registeredKey, userID).Result()
+}
+
+func (s *redisStore) SetProfileRegistered(ctx context.Context, userID string) error {
+	return s.redis.SAdd(ctx, registeredKey, userID).Err()
+}
+
+func (s *memoryStore) IdentityUser(ctx context.Context, provider, subject string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.identities[provider+":"+subject], nil
+}
+
+func (s *memoryStore) LinkIdentity(ctx context.Context, entry *IdentityEntry) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := entry.Provider + ":" + entry.Subject
+	if userID, ok := s.identities[key]; ok && userID != entry.UserID {
+		return false, nil
+	}
+	s.identities[key] = entry.UserID
+	if s.userIdents[entry.UserID] == nil {
+		s.userIdents[entry.UserID] = make(map[string]IdentityEntry)
+	}
+	s.userIdents[entry.UserID][entry.Provider] = *entry
+	return true, nil
+}
+
+func (s *memoryStore) UserIdentities(ctx context.Context, userID string) ([]*IdentityEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]*IdentityEntry, 0, len(s.userIdents[userID]))
+	for _, entry := range s.userIdents[userID] {
+		entry := entry
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
+
+func (s *memoryStore) UnlinkIdentity(ctx context.Context, entry *IdentityEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.identities, entry.Provider+":"+entry.Subject)
+	delete(s.userIdents[entry.UserID], entry.Provider)
+	return nil
+}
+
+func (s *memoryStore) ProfileRegistered(ctx context.Context, userID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.registered[userID], nil
+}
+
+func (s *memoryStore) SetProfileRegistered(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.registered[userID] = true
+	return nil
+}
@@ -0,0 +1,91 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Signing key storage entry. The PKCS#1 private key is sealed with the key
+// encryption key of the auth replicas, so that reading the store does not
+// allow minting JWTs.
+type SigningKey struct {
+	ID           string
+	EncryptedKey []byte
+	CreatedAt    time.Time
+}
+
+// KeyStore keeps the JWT signing keys.
+type KeyStore interface {
+	// SaveSigningKey stores the JWT signing key.
+	SaveSigningKey(ctx context.Context, key *SigningKey) error
+	// SigningKeys returns every stored JWT signing key.
+	SigningKeys(ctx context.Context) ([]*SigningKey, error)
+	// DeleteSigningKey removes the JWT signing key.
+	DeleteSigningKey(ctx context.Context, id string) error
+}
+
+func (s *redisStore) SaveSigningKey(ctx context.Context, key *SigningKey) error {
+	keyJSON, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	if err := s.redis.HSet(ctx, signingKeysKey, key.ID, keyJSON).Err(); err != nil {
+		return fmt.Errorf("redis HSet failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) SigningKeys(ctx context.Context) ([]*SigningKey, error) {
+	keysJSON, err := s.redis.HGetAll(ctx, signingKeysKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis HGetAll failed: %w", err)
+	}
+
+	keys := make([]*SigningKey, 0, len(keysJSON))
+	for _, keyJSON := range keysJSON {
+		var key SigningKey
+		if err := json.Unmarshal([]byte(keyJSON), &key); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+		}
+		keys = append(keys, &key)
+	}
+
+	return keys, nil
+}
+
+func (s *redisStore) DeleteSigningKey(ctx context.Context, id string) error {
+	return s.redis.HDel(ctx, signingKeysKey, id).Err()
+}
+
+func (s *memoryStore) SaveSigningKey(ctx context.Context, key *SigningKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.signingKeys[key.ID] = *key
+	return nil
+}
+
+func (s *memoryStore) SigningKeys(ctx context.Context) ([]*SigningKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]*SigningKey, 0, len(s.signingKeys))
+	for _, key := range s.signingKeys {
+		k := key
+		keys = append(keys, &k)
+	}
+
+	return keys, nil
+}
+
+func (s *memoryStore) DeleteSigningKey(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.signingKeys, id)
+	return nil
+}
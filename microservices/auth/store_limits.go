@@ -0,0 +1,110 @@
+package authapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LimitStore keeps the counters, lockouts and locks shared by every auth
+// replica.
+type LimitStore interface {
+	// Incr increments the named counter and returns its new value. The
+	// counter expires ttl after its first increment.
+	Incr(ctx context.Context, name string, ttl time.Duration) (int64, error)
+	// SetLockout locks the subject out for ttl.
+	SetLockout(ctx context.Context, subject string, ttl time.Duration) error
+	// Lockout returns the remaining lockout of the subject, 0 if it is not
+	// locked out.
+	Lockout(ctx context.Context, subject string) (time.Duration, error)
+	// TryLock acquires the named lock for ttl. It reports false if another
+	// holder has it.
+	TryLock(ctx context.Context, name string, ttl time.Duration) (bool, error)
+}
+
+func (s *redisStore) TryLock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	return s.redis.SetNX(ctx, lockKey+":"+name, 1, ttl).Result()
+}
+
+func (s *redisStore) Incr(ctx context.Context, name string, ttl time.Duration) (int64, error) {
+	key := counterKey + ":" + name
+	count, err := s.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis Incr failed: %w", err)
+	}
+	if count == 1 {
+		if err := s.redis.PExpire(ctx, key, ttl).Err(); err != nil {
+			return 0, fmt.Errorf("redis PExpire failed: %w", err)
+		}
+	}
+
+	return count, nil
+}
+
+func (s *redisStore) SetLockout(ctx context.Context, subject string, ttl time.Duration) error {
+	return s.redis.Set(ctx, lockoutKey+":"+subject, 1, ttl).Err()
+}
+
+func (s *redisStore) Lockout(ctx context.Context, subject string) (time.Duration, error) {
+	ttl, err := s.redis.PTTL(ctx, lockoutKey+":"+subject).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis PTTL failed: %w", err)
+	}
+	// PTTL is negative when the key does not exist.
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+type memoryCounter struct {
+	count     int64
+	expiresAt time.Time
+}
+
+func (s *memoryStore) TryLock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if expiresAt, ok := s.locks[name]; ok && time.Now().Before(expiresAt) {
+		return false, nil
+	}
+	s.locks[name] = time.Now().Add(ttl)
+
+	return true, nil
+}
+
+func (s *memoryStore) Incr(ctx context.Context, name string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counter, ok := s.counters[name]
+	if !ok || time.Now().After(counter.expiresAt) {
+		counter = &memoryCounter{expiresAt: time.Now().Add(ttl)}
+		s.counters[name] = counter
+	}
+	counter.count++
+
+	return counter.count, nil
+}
+
+func (s *memoryStore) SetLockout(ctx context.Context, subject string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lockouts[subject] = time.Now().Add(ttl)
+	return nil
+}
+
+func (s *memoryStore) Lockout(ctx context.Context, subject string) (time.Duration, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	remaining := time.Until(s.lockouts[subject])
+	if remaining < 0 {
+		return 0, nil
+	}
+
+	return remaining, nil
+}
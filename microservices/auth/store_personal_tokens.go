@@ -0,0 +1,230 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Personal access token storage entry. Only the SHA-256 hash of the secret is
+// stored. Bot API keys are personal access tokens of the bot user.
+type PersonalTokenEntry struct {
+	ID     string
+	Hash   string
+	UserID string
+	Login  string
+	Name   string
+	Scopes []string
+	// WorkspaceID is the workspace the token was created in and acts in,
+	// empty for the default workspace.
+	WorkspaceID string
+	CreatedAt   time.Time
+	ExpiresAt   *time.Time
+	LastUsedAt  *time.Time
+}
+
+func (e *PersonalTokenEntry) expired(now time.Time) bool {
+	return e.ExpiresAt != nil && now.After(*e.ExpiresAt)
+}
+
+// ttl returns the Redis TTL of the entry, 0 if it never expires.
+func (e *PersonalTokenEntry) ttl() time.Duration {
+	if e.ExpiresAt == nil {
+		return 0
+	}
+	return time.Until(*e.ExpiresAt)
+}
+
+// PersonalTokenStore keeps the personal access tokens and bot API keys.
+type PersonalTokenStore interface {
+	// SavePersonalToken stores the personal access token until it expires.
+	SavePersonalToken(ctx context.Context, entry *PersonalTokenEntry) error
+	// GetPersonalToken returns the personal access token with the given ID.
+	GetPersonalToken(ctx context.Context, id string) (*PersonalTokenEntry, error)
+	// PersonalTokenByHash returns the personal access token with the given
+	// secret hash.
+	PersonalTokenByHash(ctx context.Context, hash string) (*PersonalTokenEntry, error)
+	// UserPersonalTokens returns the personal access tokens of the user.
+	UserPersonalTokens(ctx context.Context, userID string) ([]*PersonalTokenEntry, error)
+	// DeletePersonalToken removes the personal access token.
+	DeletePersonalToken(ctx context.Context, entry *PersonalTokenEntry) error
+	// TouchPersonalToken records that the token was used at the given time.
+	TouchPersonalToken(ctx context.Context, id string, at time.Time) error
+}
+
+func (s *redisStore) SavePersonalToken(ctx context.Context, entry *PersonalTokenEntry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	ttl := entry.ttl()
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, patKey+":"+entry.ID, entryJSON, ttl)
+		pipe.Set(ctx, patHashKey+":"+entry.Hash, entry.ID, ttl)
+		pipe.SAdd(ctx, userPatsKey+":"+entry.UserID, entry.ID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) GetPersonalToken(ctx context.Context, id string) (*PersonalTokenEntry, error) {
+	entryJSON, err := s.redis.Get(ctx, patKey+":"+id).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var entry PersonalTokenEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (s *redisStore) PersonalTokenByHash(ctx context.Context, hash string) (*PersonalTokenEntry, error) {
+	id, err := s.redis.Get(ctx, patHashKey+":"+hash).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	return s.GetPersonalToken(ctx, id)
+}
+
+func (s *redisStore) UserPersonalTokens(ctx context.Context, userID string) ([]*PersonalTokenEntry, error) {
+	ids, err := s.redis.SMembers(ctx, userPatsKey+":"+userID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis SMembers failed: %w", err)
+	}
+
+	entries := make([]*PersonalTokenEntry, 0, len(ids))
+	for _, id := range ids {
+		entry, err := s.GetPersonalToken(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if entry == nil {
+			// Expired, forget it.
+			s.redis.SRem(ctx, userPatsKey+":"+userID, id)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (s *redisStore) DeletePersonalToken(ctx context.Context, entry *PersonalTokenEntry) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, patKey+":"+entry.ID, patHashKey+":"+entry.Hash)
+		pipe.SRem(ctx, userPatsKey+":"+entry.UserID, entry.ID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) TouchPersonalToken(ctx context.Context, id string, at time.Time) error {
+	entry, err := s.GetPersonalToken(ctx, id)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	entry.LastUsedAt = &at
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	// XX so that a token revoked in between is not brought back.
+	err = s.redis.SetArgs(ctx, patKey+":"+id, entryJSON, redis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("redis Set failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *memoryStore) SavePersonalToken(ctx context.Context, entry *PersonalTokenEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pats[entry.ID] = *entry
+	return nil
+}
+
+func (s *memoryStore) GetPersonalToken(ctx context.Context, id string) (*PersonalTokenEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.pats[id]
+	if !ok || entry.expired(time.Now()) {
+		return nil, nil
+	}
+
+	return &entry, nil
+}
+
+func (s *memoryStore) PersonalTokenByHash(ctx context.Context, hash string) (*PersonalTokenEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, entry := range s.pats {
+		if entry.Hash == hash && !entry.expired(time.Now()) {
+			return &entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *memoryStore) UserPersonalTokens(ctx context.Context, userID string) ([]*PersonalTokenEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries []*PersonalTokenEntry
+	for _, entry := range s.pats {
+		if entry.UserID != userID || entry.expired(time.Now()) {
+			continue
+		}
+		e := entry
+		entries = append(entries, &e)
+	}
+
+	return entries, nil
+}
+
+func (s *memoryStore) DeletePersonalToken(ctx context.Context, entry *PersonalTokenEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.pats, entry.ID)
+	return nil
+}
+
+func (s *memoryStore) TouchPersonalToken(ctx context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, ok := s.pats[id]; ok {
+		entry.LastUsedAt = &at
+		s.pats[id] = entry
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Revocation identifies revoked tokens: a single token by the hash of its
+// secret, a token family or every token of a user. OwnerID does not revoke
+// any token but evicts the JWTs of every token whose scopes are bound to the
+// roles of the user, so that a revoked role applies at once.
+type Revocation struct {
+	TokenHash string `json:"token_hash,omitempty"`
+	FamilyID  string `json:"family_id,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+	OwnerID   string `json:"owner_id,omitempty"`
+}
+
+// RevocationStore notifies every auth replica of the revocations.
+type RevocationStore interface {
+	// PublishRevocation notifies every auth replica of the revocation.
+	PublishRevocation(ctx context.Context, revocation *Revocation) error
+	// Revocations returns the revocations published until ctx is done.
+	Revocations(ctx context.Context) <-chan *Revocation
+}
+
+func (s *redisStore) PublishRevocation(ctx context.Context, revocation *Revocation) error {
+	revocationJSON, err := json.Marshal(revocation)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	if err := s.redis.Publish(ctx, revocationsChannel, revocationJSON).Err(); err != nil {
+		return fmt.Errorf("redis Publish failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) Revocations(ctx context.Context) <-chan *Revocation {
+	revocations := make(chan *Revocation)
+	pubsub := s.redis.Subscribe(ctx, revocationsChannel)
+
+	go func() {
+		defer close(revocations)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				var revocation Revocation
+				if err := json.Unmarshal([]byte(message.Payload), &revocation); err != nil {
+					continue
+				}
+				select {
+				case revocations <- &revocation:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return revocations
+}
+
+func (s *memoryStore) PublishRevocation(ctx context.Context, revocation *Revocation) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, subscriber := range s.subscribers {
+		subscriber <- revocation
+	}
+	return nil
+}
+
+func (s *memoryStore) Revocations(ctx context.Context) <-chan *Revocation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Buffered so that publishers are not blocked by a slow subscriber.
+	revocations := make(chan *Revocation, 64)
+	s.subscribers = append(s.subscribers, revocations)
+	return revocations
+}
@@ -0,0 +1,56 @@
+package authapi
+
+import "context"
+
+// RoleStore keeps the roles granted to the users.
+type RoleStore interface {
+	// UserRoles returns the roles granted to the user.
+	UserRoles(ctx context.Context, userID string) ([]string, error)
+	// GrantRole grants the role to the user.
+	GrantRole(ctx context.Context, userID, role string) error
+	// RevokeRole revokes the role of the user.
+	RevokeRole(ctx context.Context, userID, role string) error
+}
+
+func (s *redisStore) UserRoles(ctx context.Context, userID string) ([]string, error) {
+	return s.redis.SMembers(ctx, rolesKey+":"+userID).Result()
+}
+
+func (s *redisStore) GrantRole(ctx context.Context, userID, role string) error {
+	return s.redis.SAdd(ctx, rolesKey+":"+userID, role).Err()
+}
+
+func (s *redisStore) RevokeRole(ctx context.Context, userID, role string) error {
+	return s.redis.SRem(ctx, rolesKey+":"+userID, role).Err()
+}
+
+func (s *memoryStore) UserRoles(ctx context.Context, userID string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	roles := make([]string, 0, len(s.roles[userID]))
+	for role := range s.roles[userID] {
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+func (s *memoryStore) GrantRole(ctx context.Context, userID, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.roles[userID] == nil {
+		s.roles[userID] = make(map[string]bool)
+	}
+	s.roles[userID][role] = true
+	return nil
+}
+
+func (s *memoryStore) RevokeRole(ctx context.Context, userID, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.roles[userID], role)
+	return nil
+}
@@ -0,0 +1,148 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Session storage entry. A session is identified by the token family of the
+// login.
+type SessionEntry struct {
+	ID         string
+	UserID     string
+	Login      string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// SessionStore keeps the login sessions until they expire.
+type SessionStore interface {
+	// SaveSession stores the session until the entry expires.
+	SaveSession(ctx context.Context, entry *SessionEntry) error
+	// GetSession returns the session.
+	GetSession(ctx context.Context, id string) (*SessionEntry, error)
+	// UserSessions returns the active sessions of the user.
+	UserSessions(ctx context.Context, userID string) ([]*SessionEntry, error)
+	// TouchSession records that the session was used at the given time.
+	TouchSession(ctx context.Context, id string, at time.Time) error
+}
+
+func (s *redisStore) SaveSession(ctx context.Context, entry *SessionEntry) error {
+	return s.set(ctx, sessionKey+":"+entry.ID, entry, time.Until(entry.ExpiresAt))
+}
+
+func (s *redisStore) GetSession(ctx context.Context, id string) (*SessionEntry, error) {
+	entryJSON, err := s.redis.Get(ctx, sessionKey+":"+id).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var entry SessionEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (s *redisStore) UserSessions(ctx context.Context, userID string) ([]*SessionEntry, error) {
+	familyIDs, err := s.redis.SMembers(ctx, userKey+":"+userID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis SMembers failed: %w", err)
+	}
+
+	sessions := make([]*SessionEntry, 0, len(familyIDs))
+	for _, familyID := range familyIDs {
+		session, err := s.GetSession(ctx, familyID)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (s *redisStore) TouchSession(ctx context.Context, id string, at time.Time) error {
+	session, err := s.GetSession(ctx, id)
+	if err != nil || session == nil {
+		return err
+	}
+
+	session.LastUsedAt = at
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	// XX so that a session revoked in between is not brought back.
+	err = s.redis.SetArgs(ctx, sessionKey+":"+id, sessionJSON, redis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("redis Set failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *memoryStore) SaveSession(ctx context.Context, entry *SessionEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions[entry.ID] = *entry
+	return nil
+}
+
+func (s *memoryStore) GetSession(ctx context.Context, id string) (*SessionEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	session, ok := s.sessions[id]
+	if !ok || time.Now().After(session.ExpiresAt) {
+		return nil, nil
+	}
+
+	return &session, nil
+}
+
+func (s *memoryStore) UserSessions(ctx context.Context, userID string) ([]*SessionEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var sessions []*SessionEntry
+	for _, session := range s.sessions {
+		if session.UserID != userID || time.Now().After(session.ExpiresAt) {
+			continue
+		}
+		entry := session
+		sessions = append(sessions, &entry)
+	}
+
+	return sessions, nil
+}
+
+func (s *memoryStore) TouchSession(ctx context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil
+	}
+	session.LastUsedAt = at
+	s.sessions[id] = session
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// OAuth state entry
+type StateEntry struct {
+	Provider string
+	// CodeChallenge is the S256 PKCE challenge of the client, empty if the
+	// client did not send one.
+	CodeChallenge string
+	// ProviderVerifier is the PKCE verifier used towards the provider.
+	ProviderVerifier string
+	// DeviceCode is the device authorization approved by the login, empty
+	// for regular logins.
+	DeviceCode string
+	// LinkUserID is the user the identity is linked to, empty for logins.
+	LinkUserID string
+	CreatedAt  time.Time
+}
+
+// StateStore keeps the OAuth states until they are consumed or expire.
+type StateStore interface {
+	// SaveState stores the OAuth state for ttl.
+	SaveState(ctx context.Context, state string, entry *StateEntry, ttl time.Duration) error
+	// ConsumeState returns the OAuth state and deletes it so that it can be
+	// used only once.
+	ConsumeState(ctx context.Context, state string) (*StateEntry, error)
+}
+
+func (s *redisStore) SaveState(ctx context.Context, state string, entry *StateEntry, ttl time.Duration) error {
+	return s.set(ctx, stateKey+":"+state, entry, ttl)
+}
+
+func (s *redisStore) ConsumeState(ctx context.Context, state string) (*StateEntry, error) {
+	entryJSON, err := s.redis.GetDel(ctx, stateKey+":"+state).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis GetDel failed: %w", err)
+	}
+
+	var entry StateEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &entry, nil
+}
+
+type memoryState struct {
+	entry     StateEntry
+	expiresAt time.Time
+}
+
+func (s *memoryStore) SaveState(ctx context.Context, state string, entry *StateEntry, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[state] = memoryState{entry: *entry, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *memoryStore) ConsumeState(ctx context.Context, state string) (*StateEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.states[state]
+	if !ok {
+		return nil, nil
+	}
+	delete(s.states, state)
+	if time.Now().After(stored.expiresAt) {
+		return nil, nil
+	}
+
+	return &stored.entry, nil
+}
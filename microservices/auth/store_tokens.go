@@ -0,0 +1,312 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Token storage entry. WorkspaceID is the workspace the token acts in, empty
+// for the default workspace. RefreshToken is the refresh token issued with
+// the access token.
+type TokenEntry struct {
+	UserID       string
+	Login        string
+	FamilyID     string
+	WorkspaceID  string
+	RefreshToken string
+	Scopes       []string
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// Refresh token storage entry. All the tokens issued from one login share the
+// same family so that they can be revoked together.
+type RefreshEntry struct {
+	UserID      string
+	Login       string
+	FamilyID    string
+	WorkspaceID string
+	Scopes      []string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// TokenStore keeps the opaque access and refresh tokens of the logins
+// until they expire.
+type TokenStore interface {
+	// SaveToken stores the opaque token until the entry expires.
+	SaveToken(ctx context.Context, token string, entry *TokenEntry) error
+	// GetToken returns the entry of the opaque token.
+	GetToken(ctx context.Context, token string) (*TokenEntry, error)
+	// DeleteToken removes the opaque token.
+	DeleteToken(ctx context.Context, token string) error
+	// SaveRefreshToken stores the refresh token until the entry expires.
+	SaveRefreshToken(ctx context.Context, token string, entry *RefreshEntry) error
+	// GetRefreshToken returns the entry of the refresh token.
+	GetRefreshToken(ctx context.Context, token string) (*RefreshEntry, error)
+	// UseRefreshToken returns the entry of the refresh token and marks it as
+	// used. reused reports whether it had been used before.
+	UseRefreshToken(ctx context.Context, token string) (entry *RefreshEntry, reused bool, err error)
+	// RefreshTokenUsed reports whether the refresh token was already rotated.
+	RefreshTokenUsed(ctx context.Context, token string) (bool, error)
+	// RevokeFamily removes every access and refresh token of the family.
+	RevokeFamily(ctx context.Context, familyID string) error
+	// RevokeUser removes every access and refresh token of the user.
+	RevokeUser(ctx context.Context, userID string) error
+}
+
+func (s *redisStore) SaveToken(ctx context.Context, token string, entry *TokenEntry) error {
+	if err := s.set(ctx, tokenKey+":"+token, entry, time.Until(entry.ExpiresAt)); err != nil {
+		return err
+	}
+	return s.addToFamily(ctx, entry.UserID, entry.FamilyID, tokenKey+":"+token, entry.ExpiresAt)
+}
+
+func (s *redisStore) GetToken(ctx context.Context, token string) (*TokenEntry, error) {
+	entryJSON, err := s.redis.Get(ctx, tokenKey+":"+token).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var entry TokenEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (s *redisStore) DeleteToken(ctx context.Context, token string) error {
+	return s.redis.Del(ctx, tokenKey+":"+token).Err()
+}
+
+func (s *redisStore) SaveRefreshToken(ctx context.Context, token string, entry *RefreshEntry) error {
+	if err := s.set(ctx, refreshKey+":"+token, entry, time.Until(entry.ExpiresAt)); err != nil {
+		return err
+	}
+	return s.addToFamily(ctx, entry.UserID, entry.FamilyID, refreshKey+":"+token, entry.ExpiresAt)
+}
+
+func (s *redisStore) GetRefreshToken(ctx context.Context, token string) (*RefreshEntry, error) {
+	entryJSON, err := s.redis.Get(ctx, refreshKey+":"+token).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var entry RefreshEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (s *redisStore) UseRefreshToken(ctx context.Context, token string) (*RefreshEntry, bool, error) {
+	entry, err := s.GetRefreshToken(ctx, token)
+	if err != nil || entry == nil {
+		return nil, false, err
+	}
+
+	// Only the first caller sets the marker, concurrent uses count as reuse.
+	first, err := s.redis.SetNX(ctx, refreshUsedKey+":"+token, 1, time.Until(entry.ExpiresAt)).Result()
+	if err != nil {
+		return nil, false, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+
+	return entry, !first, nil
+}
+
+func (s *redisStore) RefreshTokenUsed(ctx context.Context, token string) (bool, error) {
+	n, err := s.redis.Exists(ctx, refreshUsedKey+":"+token).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis Exists failed: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (s *redisStore) RevokeFamily(ctx context.Context, familyID string) error {
+	keys, err := s.redis.SMembers(ctx, familyKey+":"+familyID).Result()
+	if err != nil {
+		return fmt.Errorf("redis SMembers failed: %w", err)
+	}
+
+	keys = append(keys, familyKey+":"+familyID, sessionKey+":"+familyID)
+	if err := s.redis.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("redis Del failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) RevokeUser(ctx context.Context, userID string) error {
+	familyIDs, err := s.redis.SMembers(ctx, userKey+":"+userID).Result()
+	if err != nil {
+		return fmt.Errorf("redis SMembers failed: %w", err)
+	}
+
+	for _, familyID := range familyIDs {
+		if err := s.RevokeFamily(ctx, familyID); err != nil {
+			return err
+		}
+	}
+
+	return s.redis.Del(ctx, userKey+":"+userID).Err()
+}
+
+// addToFamily records key as a member of the family and the family as one of
+// the user. The sets live as long as their longest lived member.
+func (s *redisStore) addToFamily(ctx context.Context, userID, familyID, key string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, familyKey+":"+familyID, key)
+		pipe.ExpireGT(ctx, familyKey+":"+familyID, ttl)
+		pipe.ExpireNX(ctx, familyKey+":"+familyID, ttl)
+		pipe.SAdd(ctx, userKey+":"+userID, familyID)
+		pipe.ExpireGT(ctx, userKey+":"+userID, ttl)
+		pipe.ExpireNX(ctx, userKey+":"+userID, ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+type memoryRefresh struct {
+	entry RefreshEntry
+	used  bool
+}
+
+func (s *memoryStore) SaveToken(ctx context.Context, token string, entry *TokenEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[token] = *entry
+	return nil
+}
+
+func (s *memoryStore) GetToken(ctx context.Context, token string) (*TokenEntry, error) {
+	s.mu.RLock()
+	entry, ok := s.tokens[token]
+	s.mu.RUnlock()
+
+	if !ok {
+		return nil, nil
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		s.DeleteToken(ctx, token)
+		return nil, nil
+	}
+
+	return &entry, nil
+}
+
+func (s *memoryStore) DeleteToken(ctx context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tokens, token)
+	return nil
+}
+
+func (s *memoryStore) SaveRefreshToken(ctx context.Context, token string, entry *RefreshEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshes[token] = &memoryRefresh{entry: *entry}
+	return nil
+}
+
+func (s *memoryStore) GetRefreshToken(ctx context.Context, token string) (*RefreshEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.refreshes[token]
+	if !ok || time.Now().After(stored.entry.ExpiresAt) {
+		return nil, nil
+	}
+	entry := stored.entry
+
+	return &entry, nil
+}
+
+func (s *memoryStore) UseRefreshToken(ctx context.Context, token string) (*RefreshEntry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.refreshes[token]
+	if !ok {
+		return nil, false, nil
+	}
+	if time.Now().After(stored.entry.ExpiresAt) {
+		delete(s.refreshes, token)
+		return nil, false, nil
+	}
+
+	reused := stored.used
+	stored.used = true
+	entry := stored.entry
+
+	return &entry, reused, nil
+}
+
+func (s *memoryStore) RefreshTokenUsed(ctx context.Context, token string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stored, ok := s.refreshes[token]
+	return ok && stored.used, nil
+}
+
+func (s *memoryStore) RevokeFamily(ctx context.Context, familyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token, entry := range s.tokens {
+		if entry.FamilyID == familyID {
+			delete(s.tokens, token)
+		}
+	}
+	for token, stored := range s.refreshes {
+		if stored.entry.FamilyID == familyID {
+			delete(s.refreshes, token)
+		}
+	}
+	delete(s.sessions, familyID)
+
+	return nil
+}
+
+func (s *memoryStore) RevokeUser(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token, entry := range s.tokens {
+		if entry.UserID == userID {
+			delete(s.tokens, token)
+		}
+	}
+	for token, stored := range s.refreshes {
+		if stored.entry.UserID == userID {
+			delete(s.refreshes, token)
+		}
+	}
+	for id, session := range s.sessions {
+		if session.UserID == userID {
+			delete(s.sessions, id)
+		}
+	}
+
+	return nil
+}
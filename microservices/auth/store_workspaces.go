@@ -0,0 +1,152 @@
+package authapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Workspace storage entry. The members of a workspace are stored apart from
+// it.
+type WorkspaceEntry struct {
+	ID        string
+	Name      string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// WorkspaceStore keeps the workspaces and their members.
+type WorkspaceStore interface {
+	// SaveWorkspace stores the workspace.
+	SaveWorkspace(ctx context.Context, entry *WorkspaceEntry) error
+	// GetWorkspace returns the workspace.
+	GetWorkspace(ctx context.Context, id string) (*WorkspaceEntry, error)
+	// AddWorkspaceMember adds the user to the members of the workspace.
+	AddWorkspaceMember(ctx context.Context, workspaceID, userID string) error
+	// RemoveWorkspaceMember removes the user from the members of the
+	// workspace.
+	RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string) error
+	// IsWorkspaceMember reports whether the user is a member of the workspace.
+	IsWorkspaceMember(ctx context.Context, workspaceID, userID string) (bool, error)
+	// UserWorkspaces returns the IDs of the workspaces of the user.
+	UserWorkspaces(ctx context.Context, userID string) ([]string, error)
+}
+
+func (s *redisStore) SaveWorkspace(ctx context.Context, entry *WorkspaceEntry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	return s.redis.HSet(ctx, workspacesKey, entry.ID, entryJSON).Err()
+}
+
+func (s *redisStore) GetWorkspace(ctx context.Context, id string) (*WorkspaceEntry, error) {
+	entryJSON, err := s.redis.HGet(ctx, workspacesKey, id).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis HGet failed: %w", err)
+	}
+
+	var entry WorkspaceEntry
+	if err := json.Unmarshal([]byte(entryJSON), &entry); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (s *redisStore) AddWorkspaceMember(ctx context.Context, workspaceID, userID string) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, membersKey+":"+workspaceID, userID)
+		pipe.SAdd(ctx, userSpacesKey+":"+userID, workspaceID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+	return nil
+}
+
+func (s *redisStore) RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SRem(ctx, membersKey+":"+workspaceID, userID)
+		pipe.SRem(ctx, userSpacesKey+":"+userID, workspaceID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+	return nil
+}
+
+func (s *redisStore) IsWorkspaceMember(ctx context.Context, workspaceID, userID string) (bool, error) {
+	return s.redis.SIsMember(ctx, membersKey+":"+workspaceID, userID).Result()
+}
+
+func (s *redisStore) UserWorkspaces(ctx context.Context, userID string) ([]string, error) {
+	return s.redis.SMembers(ctx, userSpacesKey+":"+userID).Result()
+}
+
+func (s *memoryStore) SaveWorkspace(ctx context.Context, entry *WorkspaceEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.workspaces[entry.ID] = *entry
+	return nil
+}
+
+func (s *memoryStore) GetWorkspace(ctx context.Context, id string) (*WorkspaceEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.workspaces[id]
+	if !ok {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+func (s *memoryStore) AddWorkspaceMember(ctx context.Context, workspaceID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.members[workspaceID] == nil {
+		s.members[workspaceID] = make(map[string]bool)
+	}
+	s.members[workspaceID][userID] = true
+	return nil
+}
+
+func (s *memoryStore) RemoveWorkspaceMember(ctx context.Context, workspaceID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.members[workspaceID], userID)
+	return nil
+}
+
+func (s *memoryStore) IsWorkspaceMember(ctx context.Context, workspaceID, userID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.members[workspaceID][userID], nil
+}
+
+func (s *memoryStore) UserWorkspaces(ctx context.Context, userID string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var ids []string
+	for workspaceID, members := range s.members {
+		if members[userID] {
+			ids = append(ids, workspaceID)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,341 @@
+package authapi
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// Prefixes of the personal access tokens and bot API keys, so that leaked
+// secrets are easy to recognize.
+const (
+	personalTokenPrefix = "hgp_"
+	botKeyPrefix        = "hgb_"
+)
+
+// newPersonalToken returns a new secret with the given prefix and its hash.
+func newPersonalToken(prefix string) (token, hash string, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token = prefix + base64.RawURLEncoding.EncodeToString(secret)
+	return token, hashPersonalToken(token), nil
+}
+
+func hashPersonalToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// grantable reports whether every requested scope is held.
+func grantable(requested, held []string) bool {
+	for _, scope := range requested {
+		found := false
+		for _, h := range held {
+			if h == scope {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// dedupScopes returns the scopes without duplicates, in their original order.
+func dedupScopes(scopes []string) []string {
+	seen := make(map[string]bool, len(scopes))
+	res := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		res = append(res, scope)
+	}
+	return res
+}
+
+// Create a personal access token for the current user
+func (s *authsrvc) CreateToken(ctx context.Context, p *auth.CreateTokenPayload) (res *auth.CreatedToken, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	scopes := dedupScopes(p.Scopes)
+	if !grantable(scopes, token.Entry.Scopes) {
+		return nil, auth.Forbidden("Cannot grant scopes the user does not hold")
+	}
+
+	secret, hash, err := newPersonalToken(personalTokenPrefix)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.create_token", "ERROR: failed to generate token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	now := time.Now()
+	entry := &PersonalTokenEntry{
+		ID:     uuid.New().String(),
+		Hash:   hash,
+		UserID: token.Entry.UserID,
+		Login:  token.Entry.Login,
+		Name:   p.Name,
+		Scopes: scopes,
+		// The token acts in the workspace of the login creating it.
+		WorkspaceID: token.Entry.WorkspaceID,
+		CreatedAt:   now,
+	}
+	if p.ExpiresIn != nil {
+		expiresAt := now.Add(time.Duration(*p.ExpiresIn) * time.Second)
+		entry.ExpiresAt = &expiresAt
+	}
+
+	if err := s.store.SavePersonalToken(ctx, entry); err != nil {
+		log.Print(ctx, log.KV{"auth.create_token", "ERROR: failed to store token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	pat := personalAccessToken(entry)
+	res = &auth.CreatedToken{
+		Token:       secret,
+		ID:          pat.ID,
+		Name:        pat.Name,
+		Scopes:      pat.Scopes,
+		CreatedAt:   pat.CreatedAt,
+		ExpiresAt:   pat.ExpiresAt,
+		WorkspaceID: pat.WorkspaceID,
+	}
+
+	log.Info(ctx, log.KV{"auth.create_token", fmt.Sprintf("user %s created personal access token %s", token.Entry.Login, entry.ID)})
+	return res, nil
+}
+
+// List the personal access tokens of the current user
+func (s *authsrvc) ListTokens(ctx context.Context, p *auth.ListTokensPayload) (res []*auth.PersonalAccessToken, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	entries, err := s.store.UserPersonalTokens(ctx, token.Entry.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.list_tokens", "ERROR: failed to load tokens"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+
+	res = make([]*auth.PersonalAccessToken, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, personalAccessToken(entry))
+	}
+
+	return res, nil
+}
+
+// Revoke a personal access token of the current user
+func (s *authsrvc) DeleteToken(ctx context.Context, p *auth.DeleteTokenPayload) (err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return auth.Unauthorized("Token is invalid or expired")
+	}
+
+	entry, err := s.store.GetPersonalToken(ctx, p.ID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.delete_token", "ERROR: failed to load token"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	if entry == nil || entry.UserID != token.Entry.UserID {
+		return auth.NotFound("Token not found")
+	}
+
+	if err := s.deletePersonalToken(ctx, entry); err != nil {
+		log.Print(ctx, log.KV{"auth.delete_token", "ERROR: failed to delete token"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditTokenRevoked, UserID: entry.UserID, Reason: "personal_token_deleted"})
+	log.Info(ctx, log.KV{"auth.delete_token", fmt.Sprintf("user %s revoked personal access token %s", token.Entry.Login, entry.ID)})
+	return nil
+}
+
+// Create a bot identity with an API key
+func (s *authsrvc) CreateBot(ctx context.Context, p *auth.CreateBotPayload) (res *auth.CreatedBot, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	scopes := dedupScopes(p.Scopes)
+	if !grantable(scopes, token.Entry.Scopes) {
+		return nil, auth.Forbidden("Cannot grant scopes the user does not hold")
+	}
+
+	apiKey, hash, err := newPersonalToken(botKeyPrefix)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.create_bot", "ERROR: failed to generate API key"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	now := time.Now()
+	bot := &BotEntry{
+		ID:        uuid.New().String(),
+		Name:      p.Name,
+		Scopes:    scopes,
+		CreatedBy: token.Entry.UserID,
+		CreatedAt: now,
+	}
+	bot.UserID = "bot:" + bot.ID
+
+	if err := s.store.SaveBot(ctx, bot); err != nil {
+		log.Print(ctx, log.KV{"auth.create_bot", "ERROR: failed to store bot"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	// The API key is a personal access token of the bot user that never
+	// expires.
+	err = s.store.SavePersonalToken(ctx, &PersonalTokenEntry{
+		ID:        uuid.New().String(),
+		Hash:      hash,
+		UserID:    bot.UserID,
+		Login:     bot.Name,
+		Name:      "api-key",
+		Scopes:    scopes,
+		CreatedAt: now,
+	})
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.create_bot", "ERROR: failed to store API key"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	res = &auth.CreatedBot{
+		APIKey:    apiKey,
+		ID:        bot.ID,
+		Name:      bot.Name,
+		UserID:    bot.UserID,
+		Scopes:    bot.Scopes,
+		CreatedAt: bot.CreatedAt.Unix(),
+	}
+
+	log.Info(ctx, log.KV{"auth.create_bot", fmt.Sprintf("user %s created bot %s", token.Entry.Login, bot.ID)})
+	return res, nil
+}
+
+// List the bot identities
+func (s *authsrvc) ListBots(ctx context.Context, p *auth.ListBotsPayload) (res []*auth.Bot, err error) {
+	bots, err := s.store.Bots(ctx)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.list_bots", "ERROR: failed to load bots"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	sort.Slice(bots, func(i, j int) bool {
+		return bots[i].CreatedAt.After(bots[j].CreatedAt)
+	})
+
+	res = make([]*auth.Bot, 0, len(bots))
+	for _, bot := range bots {
+		res = append(res, &auth.Bot{
+			ID:        bot.ID,
+			Name:      bot.Name,
+			UserID:    bot.UserID,
+			Scopes:    bot.Scopes,
+			CreatedAt: bot.CreatedAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
+
+// Delete a bot identity and revoke its API key
+func (s *authsrvc) DeleteBot(ctx context.Context, p *auth.DeleteBotPayload) (err error) {
+	bot, err := s.store.GetBot(ctx, p.ID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.delete_bot", "ERROR: failed to load bot"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	if bot == nil {
+		return auth.NotFound("Bot not found")
+	}
+
+	keys, err := s.store.UserPersonalTokens(ctx, bot.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.delete_bot", "ERROR: failed to load API keys"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	for _, key := range keys {
+		if err := s.deletePersonalToken(ctx, key); err != nil {
+			log.Print(ctx, log.KV{"auth.delete_bot", "ERROR: failed to delete API key"}, log.KV{"error", err.Error()})
+			return auth.InternalError("Internal server error")
+		}
+	}
+
+	if err := s.store.DeleteBot(ctx, bot.ID); err != nil {
+		log.Print(ctx, log.KV{"auth.delete_bot", "ERROR: failed to delete bot"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditTokenRevoked, UserID: bot.UserID, Reason: "bot_deleted"})
+	if token, ok := contextToken(ctx); ok {
+		log.Info(ctx, log.KV{"auth.delete_bot", fmt.Sprintf("user %s deleted bot %s", token.Entry.Login, bot.ID)})
+	}
+	return nil
+}
+
+// personalToken returns the entry of the personal access token or bot API key,
+// nil if token is none or expired.
+func (s *authsrvc) personalToken(ctx context.Context, token string) (*PersonalTokenEntry, error) {
+	entry, err := s.store.PersonalTokenByHash(ctx, hashPersonalToken(token))
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	if entry.expired(time.Now()) {
+		return nil, nil
+	}
+	return entry, nil
+}
+
+// revokePersonalToken removes the personal access token and returns its
+// user, empty if the token does not exist.
+func (s *authsrvc) revokePersonalToken(ctx context.Context, token string) (string, error) {
+	entry, err := s.personalToken(ctx, token)
+	if err != nil || entry == nil {
+		return "", err
+	}
+	return entry.UserID, s.deletePersonalToken(ctx, entry)
+}
+
+func personalAccessToken(entry *PersonalTokenEntry) *auth.PersonalAccessToken {
+	res := &auth.PersonalAccessToken{
+		ID:        entry.ID,
+		Name:      entry.Name,
+		Scopes:    entry.Scopes,
+		CreatedAt: entry.CreatedAt.Unix(),
+	}
+	if entry.ExpiresAt != nil {
+		expiresAt := entry.ExpiresAt.Unix()
+		res.ExpiresAt = &expiresAt
+	}
+	if entry.LastUsedAt != nil {
+		lastUsedAt := entry.LastUsedAt.Unix()
+		res.LastUsedAt = &lastUsedAt
+	}
+	if entry.WorkspaceID != "" {
+		res.WorkspaceID = &entry.WorkspaceID
+	}
+	return res
+}
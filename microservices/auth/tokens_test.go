@@ -0,0 +1,52 @@
+package authapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+func TestPersonalTokenActsInItsWorkspace(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	if err := s.store.AddWorkspaceMember(ctx, "ws", "user"); err != nil {
+		t.Fatal(err)
+	}
+	access, _ := login(t, s, "user", "ws")
+
+	pat, err := s.CreateToken(tokenContext(t, s, access), &auth.CreateTokenPayload{Name: "ci", Scopes: []string{"api:read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pat.WorkspaceID == nil || *pat.WorkspaceID != "ws" {
+		t.Errorf("workspace_id = %v, want ws", pat.WorkspaceID)
+	}
+
+	res, err := s.Introspect(ctx, &auth.IntrospectPayload{Token: pat.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(res.JWT, claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims["workspace_id"] != "ws" {
+		t.Errorf("JWT workspace_id = %v, want ws", claims["workspace_id"])
+	}
+
+	if err := s.store.SaveWorkspace(ctx, &WorkspaceEntry{ID: "ws", Name: "ws", CreatedBy: "owner"}); err != nil {
+		t.Fatal(err)
+	}
+	// The member leaves the workspace, which evicts the cached JWT.
+	if err := s.RemoveWorkspaceMember(tokenContext(t, s, access), &auth.RemoveWorkspaceMemberPayload{WorkspaceID: "ws", UserID: "user"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Introspect(ctx, &auth.IntrospectPayload{Token: pat.Token}); errorName(err) != "invalid_token" {
+		t.Errorf("token of a removed member: err = %v, want invalid_token", err)
+	}
+	if res := tokenIntrospect(t, s, pat.Token); res.Active {
+		t.Error("token of a removed member is active")
+	}
+}
@@ -0,0 +1,294 @@
+package authapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// Create a workspace with the current user as its first member
+func (s *authsrvc) CreateWorkspace(ctx context.Context, p *auth.CreateWorkspacePayload) (res *auth.Workspace, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	workspace := &WorkspaceEntry{
+		ID:        uuid.New().String(),
+		Name:      p.Name,
+		CreatedBy: token.Entry.UserID,
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.SaveWorkspace(ctx, workspace); err != nil {
+		log.Print(ctx, log.KV{"auth.create_workspace", "ERROR: failed to save workspace"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if err := s.store.AddWorkspaceMember(ctx, workspace.ID, workspace.CreatedBy); err != nil {
+		log.Print(ctx, log.KV{"auth.create_workspace", "ERROR: failed to add member"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	log.Info(ctx, log.KV{"auth.create_workspace", fmt.Sprintf("user %s created workspace %s", token.Entry.Login, workspace.ID)})
+	return workspaceResult(workspace), nil
+}
+
+// List the workspaces of the current user
+func (s *authsrvc) ListWorkspaces(ctx context.Context, p *auth.ListWorkspacesPayload) (res []*auth.Workspace, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	ids, err := s.store.UserWorkspaces(ctx, token.Entry.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.list_workspaces", "ERROR: failed to load workspaces"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	workspaces := make([]*WorkspaceEntry, 0, len(ids))
+	for _, id := range ids {
+		workspace, err := s.store.GetWorkspace(ctx, id)
+		if err != nil {
+			log.Print(ctx, log.KV{"auth.list_workspaces", "ERROR: failed to load workspace"}, log.KV{"error", err.Error()})
+			return nil, auth.InternalError("Internal server error")
+		}
+		if workspace != nil {
+			workspaces = append(workspaces, workspace)
+		}
+	}
+	sort.Slice(workspaces, func(i, j int) bool {
+		return workspaces[i].CreatedAt.Before(workspaces[j].CreatedAt)
+	})
+
+	res = make([]*auth.Workspace, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		res = append(res, workspaceResult(workspace))
+	}
+
+	return res, nil
+}
+
+// Add a user to a workspace created by the current user
+func (s *authsrvc) AddWorkspaceMember(ctx context.Context, p *auth.AddWorkspaceMemberPayload) (err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return auth.Unauthorized("Token is invalid or expired")
+	}
+
+	workspace, err := s.memberWorkspace(ctx, p.WorkspaceID, token.Entry.UserID)
+	if err != nil {
+		return err
+	}
+	if workspace.CreatedBy != token.Entry.UserID {
+		return auth.Forbidden("Only the creator of the workspace may add members")
+	}
+
+	exists, err := s.userExists(ctx, p.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.add_workspace_member", "ERROR: failed to load user"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	if !exists {
+		return auth.NotFound("User not found")
+	}
+
+	if err := s.store.AddWorkspaceMember(ctx, workspace.ID, p.UserID); err != nil {
+		log.Print(ctx, log.KV{"auth.add_workspace_member", "ERROR: failed to add member"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+
+	s.audit(ctx, &AuditEntry{Type: auditMemberAdded, UserID: p.UserID, Reason: workspace.ID})
+	log.Info(ctx, log.KV{"auth.add_workspace_member", fmt.Sprintf("user %s added %s to workspace %s", token.Entry.Login, p.UserID, workspace.ID)})
+	return nil
+}
+
+// Remove a user from a workspace, either by its creator or by the user
+// leaving it
+func (s *authsrvc) RemoveWorkspaceMember(ctx context.Context, p *auth.RemoveWorkspaceMemberPayload) (err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return auth.Unauthorized("Token is invalid or expired")
+	}
+
+	workspace, err := s.memberWorkspace(ctx, p.WorkspaceID, token.Entry.UserID)
+	if err != nil {
+		return err
+	}
+	if workspace.CreatedBy != token.Entry.UserID && p.UserID != token.Entry.UserID {
+		return auth.Forbidden("Only the creator of the workspace may remove other members")
+	}
+	if p.UserID == workspace.CreatedBy {
+		return auth.Forbidden("The creator of the workspace cannot leave it")
+	}
+
+	member, err := s.store.IsWorkspaceMember(ctx, workspace.ID, p.UserID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.remove_workspace_member", "ERROR: failed to load membership"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	if !member {
+		return auth.NotFound("Member not found")
+	}
+
+	if err := s.store.RemoveWorkspaceMember(ctx, workspace.ID, p.UserID); err != nil {
+		log.Print(ctx, log.KV{"auth.remove_workspace_member", "ERROR: failed to remove member"}, log.KV{"error", err.Error()})
+		return auth.InternalError("Internal server error")
+	}
+	// The tokens of the user stay valid in the other workspaces, only the
+	// cached JWTs are evicted so that the membership is checked again. Those
+	// of its personal access tokens are evicted by owner, as they belong to
+	// no family.
+	s.revoke(ctx, &Revocation{UserID: p.UserID, OwnerID: p.UserID})
+
+	s.audit(ctx, &AuditEntry{Type: auditMemberRemoved, UserID: p.UserID, Reason: workspace.ID})
+	log.Info(ctx, log.KV{"auth.remove_workspace_member", fmt.Sprintf("user %s removed %s from workspace %s", token.Entry.Login, p.UserID, workspace.ID)})
+	return nil
+}
+
+// Issue tokens of the current session acting in a workspace of the current
+// user
+func (s *authsrvc) SwitchWorkspace(ctx context.Context, p *auth.SwitchWorkspacePayload) (res *auth.TokenResult, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	workspace, err := s.memberWorkspace(ctx, p.WorkspaceID, token.Entry.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A profile service outage must not prevent the switch, the registration
+	// is retried on the next switch.
+	if err := s.registerWorkspaceProfile(ctx, token.Entry.UserID, workspace.ID); err != nil {
+		log.Print(ctx, log.KV{"auth.switch_workspace", "ERROR: failed to register profile"}, log.KV{"user_id", token.Entry.UserID}, log.KV{"error", err.Error()})
+	}
+
+	res, err = s.issueTokens(ctx, token.Entry.UserID, token.Entry.Login, token.Entry.FamilyID, workspace.ID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.switch_workspace", "ERROR: failed to store token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if err := s.retireTokens(ctx, token); err != nil {
+		log.Print(ctx, log.KV{"auth.switch_workspace", "ERROR: failed to revoke previous tokens"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	log.Info(ctx, log.KV{"auth.switch_workspace", fmt.Sprintf("user %s switched to workspace %s", token.Entry.Login, workspace.ID)})
+	return res, nil
+}
+
+// Issue tokens of the current session acting in the default workspace
+func (s *authsrvc) SwitchDefaultWorkspace(ctx context.Context, p *auth.SwitchDefaultWorkspacePayload) (res *auth.TokenResult, err error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		return nil, auth.Unauthorized("Token is invalid or expired")
+	}
+
+	res, err = s.issueTokens(ctx, token.Entry.UserID, token.Entry.Login, token.Entry.FamilyID, "")
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.switch_default_workspace", "ERROR: failed to store token"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if err := s.retireTokens(ctx, token); err != nil {
+		log.Print(ctx, log.KV{"auth.switch_default_workspace", "ERROR: failed to revoke previous tokens"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+
+	log.Info(ctx, log.KV{"auth.switch_default_workspace", fmt.Sprintf("user %s switched to the default workspace", token.Entry.Login)})
+	return res, nil
+}
+
+// retireTokens revokes the access token a switch was made with and rotates
+// its refresh token, so that neither acts in the previous workspace anymore.
+// Like any rotated refresh token, a replay revokes the whole family.
+func (s *authsrvc) retireTokens(ctx context.Context, token authenticatedToken) error {
+	if err := s.deleteToken(ctx, token.Token); err != nil {
+		return err
+	}
+	if token.Entry.RefreshToken == "" {
+		return nil
+	}
+	_, _, err := s.store.UseRefreshToken(ctx, token.Entry.RefreshToken)
+	return err
+}
+
+// memberWorkspace returns the workspace if the user is one of its members.
+// Other workspaces are not found so that their existence does not leak.
+func (s *authsrvc) memberWorkspace(ctx context.Context, workspaceID, userID string) (*WorkspaceEntry, error) {
+	workspace, err := s.store.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.workspace", "ERROR: failed to load workspace"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if workspace == nil {
+		return nil, auth.NotFound("Workspace not found")
+	}
+
+	member, err := s.store.IsWorkspaceMember(ctx, workspace.ID, userID)
+	if err != nil {
+		log.Print(ctx, log.KV{"auth.workspace", "ERROR: failed to load membership"}, log.KV{"error", err.Error()})
+		return nil, auth.InternalError("Internal server error")
+	}
+	if !member {
+		return nil, auth.NotFound("Workspace not found")
+	}
+
+	return workspace, nil
+}
+
+// userExists reports whether the user logged in with an identity, or is a bot
+// that can act in a workspace with its API keys.
+func (s *authsrvc) userExists(ctx context.Context, userID string) (bool, error) {
+	if botID, ok := strings.CutPrefix(userID, "bot:"); ok {
+		bot, err := s.store.GetBot(ctx, botID)
+		return bot != nil, err
+	}
+	identities, err := s.store.UserIdentities(ctx, userID)
+	return len(identities) > 0, err
+}
+
+// workspaceMember reports whether the user is a member of the workspace the
+// token acts in. Every user is a member of the default workspace.
+func (s *authsrvc) workspaceMember(ctx context.Context, workspaceID, userID string) (bool, error) {
+	if workspaceID == "" {
+		return true, nil
+	}
+	return s.store.IsWorkspaceMember(ctx, workspaceID, userID)
+}
+
+// registerWorkspaceProfile registers the profile of the user in the
+// workspace, seeded from its first linked identity.
+func (s *authsrvc) registerWorkspaceProfile(ctx context.Context, userID, workspaceID string) error {
+	identities, err := s.store.UserIdentities(ctx, userID)
+	if err != nil || len(identities) == 0 {
+		return err
+	}
+	sort.Slice(identities, func(i, j int) bool {
+		return identities[i].LinkedAt.Before(identities[j].LinkedAt)
+	})
+
+	first := identities[0]
+	return s.registerProfile(ctx, userID, workspaceID, &Identity{
+		ID:        first.Subject,
+		Login:     first.Login,
+		Name:      first.Name,
+		Email:     first.Email,
+		AvatarURL: first.AvatarURL,
+	})
+}
+
+func workspaceResult(workspace *WorkspaceEntry) *auth.Workspace {
+	return &auth.Workspace{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		CreatedBy: workspace.CreatedBy,
+		CreatedAt: workspace.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,112 @@
+package authapi
+
+import (
+	"context"
+	"testing"
+
+	auth "object-t.com/hackz-giganoto/microservices/auth/gen/auth"
+)
+
+// tokenWorkspace returns the workspace the access token acts in.
+func tokenWorkspace(t *testing.T, s *authsrvc, accessToken string) string {
+	t.Helper()
+	entry, err := s.store.GetToken(context.Background(), accessToken)
+	if err != nil || entry == nil {
+		t.Fatalf("token entry = %v, %v", entry, err)
+	}
+	return entry.WorkspaceID
+}
+
+// retired reports whether the access token was revoked and its refresh token
+// rotated.
+func retired(t *testing.T, s *authsrvc, accessToken, refreshToken string) bool {
+	t.Helper()
+	ctx := context.Background()
+	entry, err := s.store.GetToken(ctx, accessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	used, err := s.store.RefreshTokenUsed(ctx, refreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entry == nil && used
+}
+
+func TestSwitchBackToDefaultWorkspace(t *testing.T) {
+	s := newTestService(t)
+	access, refresh := login(t, s, "user", "")
+
+	workspace, err := s.CreateWorkspace(tokenContext(t, s, access), &auth.CreateWorkspacePayload{Name: "team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	switched, err := s.SwitchWorkspace(tokenContext(t, s, access), &auth.SwitchWorkspacePayload{WorkspaceID: workspace.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tokenWorkspace(t, s, switched.AccessToken); got != workspace.ID {
+		t.Fatalf("switched token acts in %q, want %s", got, workspace.ID)
+	}
+	if !retired(t, s, access, refresh) {
+		t.Error("tokens of the default workspace still valid after the switch")
+	}
+
+	back, err := s.SwitchDefaultWorkspace(tokenContext(t, s, switched.AccessToken), &auth.SwitchDefaultWorkspacePayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tokenWorkspace(t, s, back.AccessToken); got != "" {
+		t.Errorf("token acts in %q, want the default workspace", got)
+	}
+	if !retired(t, s, switched.AccessToken, switched.RefreshToken) {
+		t.Error("tokens of the workspace still valid after switching back")
+	}
+
+	// A replayed refresh token of a previous workspace revokes the session.
+	if _, err := s.Refresh(context.Background(), &auth.RefreshPayload{RefreshToken: refresh}); errorName(err) != "invalid_token" {
+		t.Errorf("refresh with a retired token: err = %v, want invalid_token", err)
+	}
+	if entry, err := s.store.GetToken(context.Background(), back.AccessToken); err != nil || entry != nil {
+		t.Errorf("token entry after the replay = %v, %v, want revoked", entry, err)
+	}
+}
+
+func TestAddWorkspaceMemberRequiresUser(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	if _, err := s.store.LinkIdentity(ctx, &IdentityEntry{Provider: "dev", Subject: "bob", UserID: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.store.SaveBot(ctx, &BotEntry{ID: "b-1", UserID: "bot:b-1", CreatedBy: "owner"}); err != nil {
+		t.Fatal(err)
+	}
+	access, _ := login(t, s, "owner", "")
+	workspace, err := s.CreateWorkspace(tokenContext(t, s, access), &auth.CreateWorkspacePayload{Name: "team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		userID string
+		err    string
+	}{
+		{"bob", ""},
+		{"bot:b-1", ""},
+		{"nobody", "not_found"},
+		{"bot:unknown", "not_found"},
+	}
+	for _, c := range cases {
+		err := s.AddWorkspaceMember(tokenContext(t, s, access), &auth.AddWorkspaceMemberPayload{WorkspaceID: workspace.ID, UserID: c.userID})
+		if errorName(err) != c.err {
+			t.Errorf("%s: err = %v, want %q", c.userID, err, c.err)
+		}
+		member, err := s.store.IsWorkspaceMember(ctx, workspace.ID, c.userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if member != (c.err == "") {
+			t.Errorf("%s: member = %v", c.userID, member)
+		}
+	}
+}
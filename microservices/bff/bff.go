@@ -8,11 +8,14 @@ import (
 	"goa.design/clue/log"
 	"goa.design/goa/v3/security"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	bff "object-t.com/hackz-giganoto/microservices/bff/gen/bff"
 	chatpb "object-t.com/hackz-giganoto/microservices/chat/gen/grpc/chat/pb"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
 	profilepb "object-t.com/hackz-giganoto/microservices/profile/gen/grpc/profile/pb"
 	security2 "object-t.com/hackz-giganoto/pkg/security"
 	"object-t.com/hackz-giganoto/pkg/telemetry"
@@ -21,11 +24,13 @@ import (
 type ServiceConfig struct {
 	ChatServiceAddr    string
 	ProfileServiceAddr string
+	FilesServiceAddr   string
 }
 
 type bffsrvc struct {
 	chatGRPCClient    chatpb.ChatClient
 	profileGRPCClient profilepb.ProfileClient
+	filesGRPCClient   filespb.FilesClient
 }
 
 // NewBff returns the bff service implementation.
@@ -33,6 +38,7 @@ func NewBff() bff.Service {
 	config := ServiceConfig{
 		ChatServiceAddr:    getEnvOrDefault("CHAT_SERVICE_ADDR", "localhost:50053"),
 		ProfileServiceAddr: getEnvOrDefault("PROFILE_SERVICE_ADDR", "localhost:50052"),
+		FilesServiceAddr:   getEnvOrDefault("FILES_SERVICE_ADDR", "localhost:50054"),
 	}
 
 	// Chat service client setup
@@ -56,9 +62,20 @@ func NewBff() bff.Service {
 	}
 	profileGRPCClient := profilepb.NewProfileClient(profileConn)
 
+	filesConn, err := grpc.NewClient(
+		config.FilesServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		telemetry.GRPCClientInterceptor(),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to connect to files service: %v", err))
+	}
+	filesGRPCClient := filespb.NewFilesClient(filesConn)
+
 	return &bffsrvc{
 		chatGRPCClient:    chatGRPCClient,
 		profileGRPCClient: profileGRPCClient,
+		filesGRPCClient:   filesGRPCClient,
 	}
 }
 
@@ -85,6 +102,8 @@ func (s *bffsrvc) JWTAuth(ctx context.Context, token string, scheme *security.JW
 		log.Printf(ctx, "invalid token: %v", err)
 		return ctx, bff.InvalidArgument("invalid token")
 	}
+	// The token is forwarded to the services called on behalf of the user.
+	ctx = context.WithValue(ctx, "jwt_token", token)
 	return security2.HasPermission(ctx, claims, scheme)
 }
 
@@ -120,21 +139,78 @@ func (s *bffsrvc) History(ctx context.Context, p *bff.HistoryPayload) (res []*bf
 		return nil, fmt.Errorf("received nil response from chat service")
 	}
 
+	var attachmentIDs []string
+	for _, h := range resp.Field {
+		attachmentIDs = append(attachmentIDs, h.AttachmentIds...)
+	}
+	attachments, err := s.attachments(grpcCtx, p.RoomID, attachmentIDs)
+	if err != nil {
+		log.Printf(ctx, "failed to resolve attachments: %v", err)
+		return nil, bff.InternalError("failed to resolve attachments")
+	}
+
+	names := map[string]string{}
 	res = []*bff.EnrichedMessage{}
 	for _, h := range resp.Field {
 		res = append(res, &bff.EnrichedMessage{
-			MessageID: &h.Id,
-			RoomID:    h.RoomId,
-			Message:   h.Message_,
-			UserID:    h.UserId,
-			UpdatedAt: &h.UpdatedAt,
-			CreatedAt: &h.CreatedAt,
+			MessageID:   &h.Id,
+			RoomID:      h.RoomId,
+			Message:     h.Message_,
+			UserID:      h.UserId,
+			UpdatedAt:   &h.UpdatedAt,
+			CreatedAt:   &h.CreatedAt,
+			Attachments: pickAttachments(attachments, h.AttachmentIds),
+			Poll:        s.poll(grpcCtx, h.Poll, names),
+			Kind:        h.Kind,
+			ClientID:    h.ClientId,
 		})
 	}
 
 	return
 }
 
+// attachments resolves attachment IDs to their metadata and download URLs.
+// Files only resolves the attachments shared in the room or uploaded by the
+// user.
+func (s *bffsrvc) attachments(ctx context.Context, roomID string, ids []string) (map[string]*bff.Attachment, error) {
+	res := make(map[string]*bff.Attachment, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	resp, err := s.filesGRPCClient.Attachments(ctx, &filespb.AttachmentsRequest{
+		Ids:    ids,
+		RoomId: &roomID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range resp.Field {
+		res[a.Id] = &bff.Attachment{
+			ID:          a.Id,
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			DownloadURL: a.GetDownloadUrl(),
+		}
+	}
+
+	return res, nil
+}
+
+// pickAttachments returns the resolved attachments in the order of ids,
+// skipping the ones that no longer exist.
+func pickAttachments(attachments map[string]*bff.Attachment, ids []string) []*bff.Attachment {
+	var res []*bff.Attachment
+	for _, id := range ids {
+		if a, ok := attachments[id]; ok {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
 // RoomList gets all chat rooms
 func (s *bffsrvc) RoomList(ctx context.Context, p *bff.RoomListPayload) (res []string, err error) {
 	log.Printf(ctx, "bff.room-list")
@@ -236,7 +312,9 @@ func (s *bffsrvc) StreamChat(ctx context.Context, p *bff.StreamChatPayload, stre
 			}
 
 			c := &chatpb.StreamRoomStreamingRequest{
-				Field: msg,
+				Message_:      msg.Message,
+				AttachmentIds: msg.AttachmentIds,
+				ClientId:      msg.ClientID,
 			}
 
 			if err := chatStream.Send(c); err != nil {
@@ -259,9 +337,22 @@ func (s *bffsrvc) StreamChat(ctx context.Context, p *bff.StreamChatPayload, stre
 			}
 
 			enrichedMsg := &bff.EnrichedMessage{
-				RoomID:  p.RoomID,
-				UserID:  chatMsg.UserId,
-				Message: chatMsg.Message_,
+				MessageID: &chatMsg.Id,
+				RoomID:    p.RoomID,
+				UserID:    chatMsg.UserId,
+				Message:   chatMsg.Message_,
+				Poll:      s.poll(grpcCtx, chatMsg.Poll, map[string]string{}),
+				Kind:      chatMsg.Kind,
+				ClientID:  chatMsg.ClientId,
+				CreatedAt: &chatMsg.CreatedAt,
+				UpdatedAt: &chatMsg.UpdatedAt,
+			}
+			if len(chatMsg.AttachmentIds) > 0 {
+				attachments, err := s.attachments(grpcCtx, p.RoomID, chatMsg.AttachmentIds)
+				if err != nil {
+					log.Print(ctx, log.KV{"bff.stream_chat", "ERROR: failed to resolve attachments"}, log.KV{"error", err.Error()})
+				}
+				enrichedMsg.Attachments = pickAttachments(attachments, chatMsg.AttachmentIds)
 			}
 
 			if err := stream.Send(enrichedMsg); err != nil {
@@ -329,3 +420,219 @@ func (s *bffsrvc) UpdateProfile(ctx context.Context, p *bff.UpdateProfilePayload
 		Name:   resp.Name,
 	}, nil
 }
+
+// ScheduleMessage schedules a message to be sent to a chat room later
+func (s *bffsrvc) ScheduleMessage(ctx context.Context, p *bff.ScheduleMessagePayload) (res *bff.ScheduledMessage, err error) {
+	log.Printf(ctx, "bff.schedule-message")
+	grpcCtx := s.addJWTToContext(ctx)
+	resp, err := s.chatGRPCClient.ScheduleMessage(grpcCtx, &chatpb.ScheduleMessageRequest{
+		RoomId:   p.RoomID,
+		Message_: p.Message,
+		SendAt:   p.SendAt,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, bff.InvalidArgument(status.Convert(err).Message())
+		}
+		return nil, bff.InternalError("InternalError")
+	}
+
+	if resp == nil {
+		return nil, fmt.Errorf("received nil response from chat service")
+	}
+
+	return &bff.ScheduledMessage{
+		ID:        resp.Id,
+		RoomID:    resp.RoomId,
+		UserID:    resp.UserId,
+		Message:   resp.Message_,
+		SendAt:    resp.SendAt,
+		CreatedAt: resp.CreatedAt,
+	}, nil
+}
+
+// ListScheduled lists the messages scheduled by the current user
+func (s *bffsrvc) ListScheduled(ctx context.Context, p *bff.ListScheduledPayload) (res []*bff.ScheduledMessage, err error) {
+	log.Printf(ctx, "bff.list-scheduled")
+	grpcCtx := s.addJWTToContext(ctx)
+	resp, err := s.chatGRPCClient.ListScheduled(grpcCtx, &chatpb.ListScheduledRequest{
+		RoomId: p.RoomID,
+	})
+	if err != nil {
+		return nil, bff.InternalError("InternalError")
+	}
+
+	if resp == nil {
+		return nil, fmt.Errorf("received nil response from chat service")
+	}
+
+	res = []*bff.ScheduledMessage{}
+	for _, scheduled := range resp.Field {
+		res = append(res, toScheduledMessage(scheduled))
+	}
+
+	return
+}
+
+// CancelScheduled cancels a scheduled message before it is sent
+func (s *bffsrvc) CancelScheduled(ctx context.Context, p *bff.CancelScheduledPayload) (res string, err error) {
+	log.Printf(ctx, "bff.cancel-scheduled")
+	grpcCtx := s.addJWTToContext(ctx)
+	resp, err := s.chatGRPCClient.CancelScheduled(grpcCtx, &chatpb.CancelScheduledRequest{
+		Id: p.ID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return "", bff.Notfound(status.Convert(err).Message())
+		case codes.PermissionDenied:
+			return "", bff.PermissionDenied(status.Convert(err).Message())
+		}
+		return "", bff.InternalError("InternalError")
+	}
+
+	if resp == nil {
+		return "", fmt.Errorf("received nil response from chat service")
+	}
+
+	return resp.Field, nil
+}
+
+func toScheduledMessage(m *chatpb.ScheduledMessage) *bff.ScheduledMessage {
+	return &bff.ScheduledMessage{
+		ID:        m.Id,
+		RoomID:    m.RoomId,
+		UserID:    m.UserId,
+		Message:   m.Message_,
+		SendAt:    m.SendAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// CreatePoll posts a poll in a chat room
+func (s *bffsrvc) CreatePoll(ctx context.Context, p *bff.CreatePollPayload) (res *bff.EnrichedMessage, err error) {
+	log.Printf(ctx, "bff.create-poll")
+	grpcCtx := s.addJWTToContext(ctx)
+	resp, err := s.chatGRPCClient.CreatePoll(grpcCtx, &chatpb.CreatePollRequest{
+		RoomId:         p.RoomID,
+		Question:       p.Question,
+		Options:        p.Options,
+		MultipleChoice: p.MultipleChoice,
+		Anonymous:      p.Anonymous,
+		ClosesAt:       p.ClosesAt,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, bff.InvalidArgument(status.Convert(err).Message())
+		}
+		return nil, bff.InternalError("InternalError")
+	}
+
+	if resp == nil {
+		return nil, fmt.Errorf("received nil response from chat service")
+	}
+
+	return &bff.EnrichedMessage{
+		MessageID: &resp.Id,
+		RoomID:    resp.RoomId,
+		UserID:    resp.UserId,
+		Message:   resp.Message_,
+		CreatedAt: &resp.CreatedAt,
+		UpdatedAt: &resp.UpdatedAt,
+		Poll:      s.poll(grpcCtx, resp.Poll, map[string]string{}),
+		Kind:      resp.Kind,
+	}, nil
+}
+
+// Vote votes on a poll
+func (s *bffsrvc) Vote(ctx context.Context, p *bff.VotePayload) (res *bff.Poll, err error) {
+	log.Printf(ctx, "bff.vote")
+	grpcCtx := s.addJWTToContext(ctx)
+
+	options := make([]int32, len(p.Options))
+	for i, option := range p.Options {
+		options[i] = int32(option)
+	}
+
+	resp, err := s.chatGRPCClient.Vote(grpcCtx, &chatpb.VoteRequest{
+		PollId:  p.PollID,
+		Options: options,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, bff.Notfound(status.Convert(err).Message())
+		case codes.InvalidArgument:
+			return nil, bff.InvalidArgument(status.Convert(err).Message())
+		}
+		return nil, bff.InternalError("InternalError")
+	}
+
+	if resp == nil {
+		return nil, fmt.Errorf("received nil response from chat service")
+	}
+
+	return s.poll(grpcCtx, &chatpb.Poll{
+		Id:             resp.Id,
+		Question:       resp.Question,
+		Options:        resp.Options,
+		MultipleChoice: resp.MultipleChoice,
+		Anonymous:      resp.Anonymous,
+		ClosesAt:       resp.ClosesAt,
+		Closed:         resp.Closed,
+	}, map[string]string{}), nil
+}
+
+// poll converts a chat poll and resolves the voter names through the profile
+// service. names caches the names already resolved by the caller.
+func (s *bffsrvc) poll(ctx context.Context, p *chatpb.Poll, names map[string]string) *bff.Poll {
+	if p == nil {
+		return nil
+	}
+
+	res := &bff.Poll{
+		ID:             p.Id,
+		Question:       p.Question,
+		MultipleChoice: p.MultipleChoice,
+		Anonymous:      p.Anonymous,
+		ClosesAt:       p.ClosesAt,
+		Closed:         p.Closed,
+	}
+	for _, o := range p.Options {
+		option := &bff.PollOption{
+			Index: int(o.Index),
+			Text:  o.Text,
+			Votes: int(o.Votes),
+		}
+		for _, userID := range o.VoterIds {
+			option.Voters = append(option.Voters, &bff.Voter{
+				UserID: userID,
+				Name:   s.userName(ctx, userID, names),
+			})
+		}
+		res.Options = append(res.Options, option)
+	}
+
+	return res
+}
+
+// userName returns the profile name of userID, falling back to the ID when
+// the profile cannot be loaded.
+func (s *bffsrvc) userName(ctx context.Context, userID string, names map[string]string) string {
+	if name, ok := names[userID]; ok {
+		return name
+	}
+
+	name := userID
+	resp, err := s.profileGRPCClient.GetProfile(ctx, &profilepb.GetProfileRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		log.Print(ctx, log.KV{"bff.user_name", "ERROR: failed to get profile"}, log.KV{"user_id", userID}, log.KV{"error", err.Error()})
+	} else if resp != nil && resp.Name != "" {
+		name = resp.Name
+	}
+	names[userID] = name
+
+	return name
+}
@@ -8,6 +8,56 @@ var JWTAuth = JWTSecurity("jwt", func() {
 	Scope("api:write", "Write access to API resources")
 })
 
+var Attachment = Type("Attachment", func() {
+	Description("File attached to a chat message")
+
+	Field(1, "id", String, "Attachment ID")
+	Field(2, "filename", String, "Original file name")
+	Field(3, "content_type", String, "MIME type of the file")
+	Field(4, "size", Int64, "File size in bytes")
+	Field(5, "download_url", String, "Presigned download URL")
+	Required("id", "filename", "content_type", "size", "download_url")
+})
+
+var MessageInput = Type("MessageInput", func() {
+	Description("Message sent on a chat stream")
+
+	Field(1, "message", String, "Message content")
+	Field(2, "attachment_ids", ArrayOf(String), "IDs of files uploaded to the files service")
+	Field(3, "client_id", String, "Client generated ID used to deduplicate retries")
+})
+
+var Voter = Type("Voter", func() {
+	Description("User who voted on a poll option")
+
+	Field(1, "user_id", String, "User ID")
+	Field(2, "name", String, "User name from profile")
+	Required("user_id", "name")
+})
+
+var PollOption = Type("PollOption", func() {
+	Description("Poll option with its current tally")
+
+	Field(1, "index", Int, "Option index")
+	Field(2, "text", String, "Option text")
+	Field(3, "votes", Int, "Number of votes")
+	Field(4, "voters", ArrayOf(Voter), "Users who voted for the option, empty for anonymous polls")
+	Required("index", "text", "votes")
+})
+
+var Poll = Type("Poll", func() {
+	Description("Poll posted in a chat room")
+
+	Field(1, "id", String, "Poll ID")
+	Field(2, "question", String, "Poll question")
+	Field(3, "options", ArrayOf(PollOption), "Poll options")
+	Field(4, "multiple_choice", Boolean, "Whether users may vote for several options")
+	Field(5, "anonymous", Boolean, "Whether voters are hidden")
+	Field(6, "closes_at", Int64, "Closing timestamp")
+	Field(7, "closed", Boolean, "Whether the poll is closed")
+	Required("id", "question", "options", "multiple_choice", "anonymous", "closed")
+})
+
 var EnrichedMessage = Type("EnrichedMessage", func() {
 	Description("Chat message enriched with user profile information")
 
@@ -17,6 +67,12 @@ var EnrichedMessage = Type("EnrichedMessage", func() {
 	Field(4, "message", String, "Message content")
 	Field(5, "created_at", Int64, "Sent timestamp")
 	Field(6, "updated_at", Int64, "Created timestamp")
+	Field(7, "attachments", ArrayOf(Attachment), "Attached files")
+	Field(8, "poll", Poll, "Poll posted with the message")
+	Field(9, "kind", String, "Event kind", func() {
+		Enum("message", "poll", "poll_update", "ack")
+	})
+	Field(10, "client_id", String, "Client generated ID of the message being sent or acknowledged")
 	Required("room_id", "user_id", "message")
 })
 
@@ -31,6 +87,18 @@ var RoomInfo = Type("RoomInfo", func() {
 	Required("room_id", "name", "created_by", "creator_name", "created_at")
 })
 
+var ScheduledMessage = Type("ScheduledMessage", func() {
+	Description("Chat message waiting for delivery")
+
+	Field(1, "id", String, "Scheduled message ID")
+	Field(2, "room_id", String, "Room ID")
+	Field(3, "user_id", String, "Sender user ID")
+	Field(4, "message", String, "Message content")
+	Field(5, "send_at", Int64, "Delivery timestamp")
+	Field(6, "created_at", Int64, "Created timestamp")
+	Required("id", "room_id", "user_id", "message", "send_at", "created_at")
+})
+
 var _ = Service("bff", func() {
 	Description("Backend for Frontend service for chat application")
 
@@ -176,7 +244,7 @@ var _ = Service("bff", func() {
 			Required("token", "room_id")
 		})
 
-		StreamingPayload(String)
+		StreamingPayload(MessageInput)
 		StreamingResult(EnrichedMessage)
 
 		Error("unauthorized", String, "Unauthorized access")
@@ -253,4 +321,138 @@ var _ = Service("bff", func() {
 			Response("internal_error", CodeInternal)
 		})
 	})
+	Method("schedule-message", func() {
+		Description("Schedule a message to be sent to a chat room later")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "room_id", String, "Room ID")
+			Field(2, "message", String, "Message content")
+			Field(3, "send_at", Int64, "Delivery timestamp")
+			Required("token", "room_id", "message", "send_at")
+		})
+
+		Result(ScheduledMessage)
+
+		Error("invalid_argument", String)
+		Error("internal_error", String, "Internal server error")
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_argument", CodeInvalidArgument)
+			Response("internal_error", CodeInternal)
+		})
+	})
+
+	Method("list-scheduled", func() {
+		Description("List the messages scheduled by the current user")
+
+		Security(JWTAuth, func() {
+			Scope("api:read")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "room_id", String, "Only list messages for this room")
+			Required("token")
+		})
+
+		Result(ArrayOf(ScheduledMessage))
+
+		Error("internal_error", String, "Internal server error")
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("internal_error", CodeInternal)
+		})
+	})
+
+	Method("cancel-scheduled", func() {
+		Description("Cancel a scheduled message before it is sent")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "id", String, "Scheduled message ID")
+			Required("token", "id")
+		})
+
+		Result(String)
+
+		Error("notfound", String)
+		Error("permission-denied", String, "Permission denied")
+		Error("internal_error", String, "Internal server error")
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("notfound", CodeNotFound)
+			Response("permission-denied", CodePermissionDenied)
+			Response("internal_error", CodeInternal)
+		})
+	})
+
+	Method("create-poll", func() {
+		Description("Post a poll in a chat room")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "room_id", String, "Room ID")
+			Field(2, "question", String, "Poll question")
+			Field(3, "options", ArrayOf(String), "Poll options")
+			Field(4, "multiple_choice", Boolean, "Whether users may vote for several options")
+			Field(5, "anonymous", Boolean, "Whether voters are hidden")
+			Field(6, "closes_at", Int64, "Closing timestamp")
+			Required("token", "room_id", "question", "options")
+		})
+
+		Result(EnrichedMessage)
+
+		Error("invalid_argument", String)
+		Error("internal_error", String, "Internal server error")
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_argument", CodeInvalidArgument)
+			Response("internal_error", CodeInternal)
+		})
+	})
+
+	Method("vote", func() {
+		Description("Vote on a poll")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "poll_id", String, "Poll ID")
+			Field(2, "options", ArrayOf(Int), "Indexes of the chosen options")
+			Required("token", "poll_id", "options")
+		})
+
+		Result(Poll)
+
+		Error("notfound", String)
+		Error("invalid_argument", String)
+		Error("internal_error", String, "Internal server error")
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("notfound", CodeNotFound)
+			Response("invalid_argument", CodeInvalidArgument)
+			Response("internal_error", CodeInternal)
+		})
+	})
 })
@@ -15,27 +15,37 @@ import (
 
 // Client is the "bff" service client.
 type Client struct {
-	CreateRoomEndpoint    goa.Endpoint
-	HistoryEndpoint       goa.Endpoint
-	RoomListEndpoint      goa.Endpoint
-	JoinRoomEndpoint      goa.Endpoint
-	InviteRoomEndpoint    goa.Endpoint
-	StreamChatEndpoint    goa.Endpoint
-	GetProfileEndpoint    goa.Endpoint
-	UpdateProfileEndpoint goa.Endpoint
+	CreateRoomEndpoint      goa.Endpoint
+	HistoryEndpoint         goa.Endpoint
+	RoomListEndpoint        goa.Endpoint
+	JoinRoomEndpoint        goa.Endpoint
+	InviteRoomEndpoint      goa.Endpoint
+	StreamChatEndpoint      goa.Endpoint
+	GetProfileEndpoint      goa.Endpoint
+	UpdateProfileEndpoint   goa.Endpoint
+	ScheduleMessageEndpoint goa.Endpoint
+	ListScheduledEndpoint   goa.Endpoint
+	CancelScheduledEndpoint goa.Endpoint
+	CreatePollEndpoint      goa.Endpoint
+	VoteEndpoint            goa.Endpoint
 }
 
 // NewClient initializes a "bff" service client given the endpoints.
-func NewClient(createRoom, history, roomList, joinRoom, inviteRoom, streamChat, getProfile, updateProfile goa.Endpoint) *Client {
+func NewClient(createRoom, history, roomList, joinRoom, inviteRoom, streamChat, getProfile, updateProfile, scheduleMessage, listScheduled, cancelScheduled, createPoll, vote goa.Endpoint) *Client {
 	return &Client{
-		CreateRoomEndpoint:    createRoom,
-		HistoryEndpoint:       history,
-		RoomListEndpoint:      roomList,
-		JoinRoomEndpoint:      joinRoom,
-		InviteRoomEndpoint:    inviteRoom,
-		StreamChatEndpoint:    streamChat,
-		GetProfileEndpoint:    getProfile,
-		UpdateProfileEndpoint: updateProfile,
+		CreateRoomEndpoint:      createRoom,
+		HistoryEndpoint:         history,
+		RoomListEndpoint:        roomList,
+		JoinRoomEndpoint:        joinRoom,
+		InviteRoomEndpoint:      inviteRoom,
+		StreamChatEndpoint:      streamChat,
+		GetProfileEndpoint:      getProfile,
+		UpdateProfileEndpoint:   updateProfile,
+		ScheduleMessageEndpoint: scheduleMessage,
+		ListScheduledEndpoint:   listScheduled,
+		CancelScheduledEndpoint: cancelScheduled,
+		CreatePollEndpoint:      createPoll,
+		VoteEndpoint:            vote,
 	}
 }
 
@@ -151,3 +161,74 @@ func (c *Client) UpdateProfile(ctx context.Context, p *UpdateProfilePayload) (re
 	}
 	return ires.(*UpdateProfileResult), nil
 }
+
+// ScheduleMessage calls the "schedule-message" endpoint of the "bff" service.
+// ScheduleMessage may return the following errors:
+//   - "invalid_argument" (type InvalidArgument)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ScheduleMessage(ctx context.Context, p *ScheduleMessagePayload) (res *ScheduledMessage, err error) {
+	var ires any
+	ires, err = c.ScheduleMessageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ScheduledMessage), nil
+}
+
+// ListScheduled calls the "list-scheduled" endpoint of the "bff" service.
+// ListScheduled may return the following errors:
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) ListScheduled(ctx context.Context, p *ListScheduledPayload) (res []*ScheduledMessage, err error) {
+	var ires any
+	ires, err = c.ListScheduledEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*ScheduledMessage), nil
+}
+
+// CancelScheduled calls the "cancel-scheduled" endpoint of the "bff" service.
+// CancelScheduled may return the following errors:
+//   - "notfound" (type Notfound)
+//   - "permission-denied" (type PermissionDenied)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) CancelScheduled(ctx context.Context, p *CancelScheduledPayload) (res string, err error) {
+	var ires any
+	ires, err = c.CancelScheduledEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(string), nil
+}
+
+// CreatePoll calls the "create-poll" endpoint of the "bff" service.
+// CreatePoll may return the following errors:
+//   - "invalid_argument" (type InvalidArgument)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) CreatePoll(ctx context.Context, p *CreatePollPayload) (res *EnrichedMessage, err error) {
+	var ires any
+	ires, err = c.CreatePollEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EnrichedMessage), nil
+}
+
+// Vote calls the "vote" endpoint of the "bff" service.
+// Vote may return the following errors:
+//   - "notfound" (type Notfound)
+//   - "invalid_argument" (type InvalidArgument)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Vote(ctx context.Context, p *VotePayload) (res *Poll, err error) {
+	var ires any
+	ires, err = c.VoteEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Poll), nil
+}
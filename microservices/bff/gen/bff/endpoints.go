@@ -16,14 +16,19 @@ import (
 
 // Endpoints wraps the "bff" service endpoints.
 type Endpoints struct {
-	CreateRoom    goa.Endpoint
-	History       goa.Endpoint
-	RoomList      goa.Endpoint
-	JoinRoom      goa.Endpoint
-	InviteRoom    goa.Endpoint
-	StreamChat    goa.Endpoint
-	GetProfile    goa.Endpoint
-	UpdateProfile goa.Endpoint
+	CreateRoom      goa.Endpoint
+	History         goa.Endpoint
+	RoomList        goa.Endpoint
+	JoinRoom        goa.Endpoint
+	InviteRoom      goa.Endpoint
+	StreamChat      goa.Endpoint
+	GetProfile      goa.Endpoint
+	UpdateProfile   goa.Endpoint
+	ScheduleMessage goa.Endpoint
+	ListScheduled   goa.Endpoint
+	CancelScheduled goa.Endpoint
+	CreatePoll      goa.Endpoint
+	Vote            goa.Endpoint
 }
 
 // StreamChatEndpointInput holds both the payload and the server stream of the
@@ -40,14 +45,19 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		CreateRoom:    NewCreateRoomEndpoint(s, a.JWTAuth),
-		History:       NewHistoryEndpoint(s, a.JWTAuth),
-		RoomList:      NewRoomListEndpoint(s, a.JWTAuth),
-		JoinRoom:      NewJoinRoomEndpoint(s, a.JWTAuth),
-		InviteRoom:    NewInviteRoomEndpoint(s, a.JWTAuth),
-		StreamChat:    NewStreamChatEndpoint(s, a.JWTAuth),
-		GetProfile:    NewGetProfileEndpoint(s, a.JWTAuth),
-		UpdateProfile: NewUpdateProfileEndpoint(s, a.JWTAuth),
+		CreateRoom:      NewCreateRoomEndpoint(s, a.JWTAuth),
+		History:         NewHistoryEndpoint(s, a.JWTAuth),
+		RoomList:        NewRoomListEndpoint(s, a.JWTAuth),
+		JoinRoom:        NewJoinRoomEndpoint(s, a.JWTAuth),
+		InviteRoom:      NewInviteRoomEndpoint(s, a.JWTAuth),
+		StreamChat:      NewStreamChatEndpoint(s, a.JWTAuth),
+		GetProfile:      NewGetProfileEndpoint(s, a.JWTAuth),
+		UpdateProfile:   NewUpdateProfileEndpoint(s, a.JWTAuth),
+		ScheduleMessage: NewScheduleMessageEndpoint(s, a.JWTAuth),
+		ListScheduled:   NewListScheduledEndpoint(s, a.JWTAuth),
+		CancelScheduled: NewCancelScheduledEndpoint(s, a.JWTAuth),
+		CreatePoll:      NewCreatePollEndpoint(s, a.JWTAuth),
+		Vote:            NewVoteEndpoint(s, a.JWTAuth),
 	}
 }
 
@@ -61,6 +71,11 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.StreamChat = m(e.StreamChat)
 	e.GetProfile = m(e.GetProfile)
 	e.UpdateProfile = m(e.UpdateProfile)
+	e.ScheduleMessage = m(e.ScheduleMessage)
+	e.ListScheduled = m(e.ListScheduled)
+	e.CancelScheduled = m(e.CancelScheduled)
+	e.CreatePoll = m(e.CreatePoll)
+	e.Vote = m(e.Vote)
 }
 
 // NewCreateRoomEndpoint returns an endpoint function that calls the method
@@ -218,3 +233,98 @@ func NewUpdateProfileEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		return s.UpdateProfile(ctx, p)
 	}
 }
+
+// NewScheduleMessageEndpoint returns an endpoint function that calls the
+// method "schedule-message" of service "bff".
+func NewScheduleMessageEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ScheduleMessagePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ScheduleMessage(ctx, p)
+	}
+}
+
+// NewListScheduledEndpoint returns an endpoint function that calls the method
+// "list-scheduled" of service "bff".
+func NewListScheduledEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListScheduledPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListScheduled(ctx, p)
+	}
+}
+
+// NewCancelScheduledEndpoint returns an endpoint function that calls the
+// method "cancel-scheduled" of service "bff".
+func NewCancelScheduledEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelScheduledPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CancelScheduled(ctx, p)
+	}
+}
+
+// NewCreatePollEndpoint returns an endpoint function that calls the method
+// "create-poll" of service "bff".
+func NewCreatePollEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreatePollPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreatePoll(ctx, p)
+	}
+}
+
+// NewVoteEndpoint returns an endpoint function that calls the method "vote" of
+// service "bff".
+func NewVoteEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*VotePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Vote(ctx, p)
+	}
+}
@@ -31,6 +31,16 @@ type Service interface {
 	GetProfile(context.Context, *GetProfilePayload) (res *GetProfileResult, err error)
 	// Update current user profile
 	UpdateProfile(context.Context, *UpdateProfilePayload) (res *UpdateProfileResult, err error)
+	// Schedule a message to be sent to a chat room later
+	ScheduleMessage(context.Context, *ScheduleMessagePayload) (res *ScheduledMessage, err error)
+	// List the messages scheduled by the current user
+	ListScheduled(context.Context, *ListScheduledPayload) (res []*ScheduledMessage, err error)
+	// Cancel a scheduled message before it is sent
+	CancelScheduled(context.Context, *CancelScheduledPayload) (res string, err error)
+	// Post a poll in a chat room
+	CreatePoll(context.Context, *CreatePollPayload) (res *EnrichedMessage, err error)
+	// Vote on a poll
+	Vote(context.Context, *VotePayload) (res *Poll, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -53,7 +63,7 @@ const ServiceName = "bff"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [8]string{"create_room", "history", "room-list", "join-room", "invite-room", "stream_chat", "get_profile", "update_profile"}
+var MethodNames = [13]string{"create_room", "history", "room-list", "join-room", "invite-room", "stream_chat", "get_profile", "update_profile", "schedule-message", "list-scheduled", "cancel-scheduled", "create-poll", "vote"}
 
 // StreamChatServerStream is the interface a "stream_chat" endpoint server
 // stream must satisfy.
@@ -62,10 +72,11 @@ type StreamChatServerStream interface {
 	Send(*EnrichedMessage) error
 	// SendWithContext streams instances of "EnrichedMessage" with context.
 	SendWithContext(context.Context, *EnrichedMessage) error
-	// Recv reads instances of "string" from the stream.
-	Recv() (string, error)
-	// RecvWithContext reads instances of "string" from the stream with context.
-	RecvWithContext(context.Context) (string, error)
+	// Recv reads instances of "MessageInput" from the stream.
+	Recv() (*MessageInput, error)
+	// RecvWithContext reads instances of "MessageInput" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*MessageInput, error)
 	// Close closes the stream.
 	Close() error
 }
@@ -73,10 +84,10 @@ type StreamChatServerStream interface {
 // StreamChatClientStream is the interface a "stream_chat" endpoint client
 // stream must satisfy.
 type StreamChatClientStream interface {
-	// Send streams instances of "string".
-	Send(string) error
-	// SendWithContext streams instances of "string" with context.
-	SendWithContext(context.Context, string) error
+	// Send streams instances of "MessageInput".
+	Send(*MessageInput) error
+	// SendWithContext streams instances of "MessageInput" with context.
+	SendWithContext(context.Context, *MessageInput) error
 	// Recv reads instances of "EnrichedMessage" from the stream.
 	Recv() (*EnrichedMessage, error)
 	// RecvWithContext reads instances of "EnrichedMessage" from the stream with
@@ -86,6 +97,47 @@ type StreamChatClientStream interface {
 	Close() error
 }
 
+// File attached to a chat message
+type Attachment struct {
+	// Attachment ID
+	ID string
+	// Original file name
+	Filename string
+	// MIME type of the file
+	ContentType string
+	// File size in bytes
+	Size int64
+	// Presigned download URL
+	DownloadURL string
+}
+
+// CancelScheduledPayload is the payload type of the bff service
+// cancel-scheduled method.
+type CancelScheduledPayload struct {
+	// JWT token
+	Token string
+	// Scheduled message ID
+	ID string
+}
+
+// CreatePollPayload is the payload type of the bff service create-poll method.
+type CreatePollPayload struct {
+	// JWT token
+	Token string
+	// Room ID
+	RoomID string
+	// Poll question
+	Question string
+	// Poll options
+	Options []string
+	// Whether users may vote for several options
+	MultipleChoice *bool
+	// Whether voters are hidden
+	Anonymous *bool
+	// Closing timestamp
+	ClosesAt *int64
+}
+
 // CreateRoomPayload is the payload type of the bff service create_room method.
 type CreateRoomPayload struct {
 	// JWT token
@@ -106,6 +158,14 @@ type EnrichedMessage struct {
 	CreatedAt *int64
 	// Created timestamp
 	UpdatedAt *int64
+	// Attached files
+	Attachments []*Attachment
+	// Poll posted with the message
+	Poll *Poll
+	// Event kind
+	Kind *string
+	// Client generated ID of the message being sent or acknowledged
+	ClientID *string
 }
 
 // GetProfilePayload is the payload type of the bff service get_profile method.
@@ -150,12 +210,92 @@ type JoinRoomPayload struct {
 	InviteKey string
 }
 
+// ListScheduledPayload is the payload type of the bff service list-scheduled
+// method.
+type ListScheduledPayload struct {
+	// JWT token
+	Token string
+	// Only list messages for this room
+	RoomID *string
+}
+
+// MessageInput is the streaming payload type of the bff service stream_chat
+// method.
+type MessageInput struct {
+	// Message content
+	Message *string
+	// IDs of files uploaded to the files service
+	AttachmentIds []string
+	// Client generated ID used to deduplicate retries
+	ClientID *string
+}
+
+// Poll is the result type of the bff service vote method.
+type Poll struct {
+	// Poll ID
+	ID string
+	// Poll question
+	Question string
+	// Poll options
+	Options []*PollOption
+	// Whether users may vote for several options
+	MultipleChoice bool
+	// Whether voters are hidden
+	Anonymous bool
+	// Closing timestamp
+	ClosesAt *int64
+	// Whether the poll is closed
+	Closed bool
+}
+
+// Poll option with its current tally
+type PollOption struct {
+	// Option index
+	Index int
+	// Option text
+	Text string
+	// Number of votes
+	Votes int
+	// Users who voted for the option, empty for anonymous polls
+	Voters []*Voter
+}
+
 // RoomListPayload is the payload type of the bff service room-list method.
 type RoomListPayload struct {
 	// The access token
 	Token string
 }
 
+// ScheduleMessagePayload is the payload type of the bff service
+// schedule-message method.
+type ScheduleMessagePayload struct {
+	// JWT token
+	Token string
+	// Room ID
+	RoomID string
+	// Message content
+	Message string
+	// Delivery timestamp
+	SendAt int64
+}
+
+// ScheduledMessage is the result type of the bff service schedule-message
+// method.
+type ScheduledMessage struct {
+	// Scheduled message ID
+	ID string
+	// Room ID
+	RoomID string
+	// Sender user ID
+	UserID string
+	// Message content
+	Message string
+	// Delivery timestamp
+	SendAt int64
+	// Created timestamp
+	CreatedAt int64
+}
+
 // StreamChatPayload is the payload type of the bff service stream_chat method.
 type StreamChatPayload struct {
 	// JWT token
@@ -182,6 +322,24 @@ type UpdateProfileResult struct {
 	Name string
 }
 
+// VotePayload is the payload type of the bff service vote method.
+type VotePayload struct {
+	// JWT token
+	Token string
+	// Poll ID
+	PollID string
+	// Indexes of the chosen options
+	Options []int
+}
+
+// User who voted on a poll option
+type Voter struct {
+	// User ID
+	UserID string
+	// User name from profile
+	Name string
+}
+
 // Invalid request
 type BadRequest string
 
@@ -37,7 +37,7 @@ func BuildHistoryPayload(bffHistoryMessage string, bffHistoryToken string) (*bff
 		if bffHistoryMessage != "" {
 			err = json.Unmarshal([]byte(bffHistoryMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Pariatur non commodi sunt quibusdam.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Molestiae nulla ea omnis qui quis sit.\"\n   }'")
 			}
 		}
 	}
@@ -75,7 +75,7 @@ func BuildJoinRoomPayload(bffJoinRoomMessage string, bffJoinRoomToken string) (*
 		if bffJoinRoomMessage != "" {
 			err = json.Unmarshal([]byte(bffJoinRoomMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"invite_key\": \"Et quae consequatur expedita.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"invite_key\": \"Aut itaque architecto et.\"\n   }'")
 			}
 		}
 	}
@@ -100,7 +100,7 @@ func BuildInviteRoomPayload(bffInviteRoomMessage string, bffInviteRoomToken stri
 		if bffInviteRoomMessage != "" {
 			err = json.Unmarshal([]byte(bffInviteRoomMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Veritatis error et nulla eius.\",\n      \"user_id\": \"Sed dolorem.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Error voluptas ipsa sit odio.\",\n      \"user_id\": \"Dolorem magnam magni illum aperiam error quia.\"\n   }'")
 			}
 		}
 	}
@@ -144,7 +144,7 @@ func BuildGetProfilePayload(bffGetProfileMessage string, bffGetProfileToken stri
 		if bffGetProfileMessage != "" {
 			err = json.Unmarshal([]byte(bffGetProfileMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": \"Alias vel.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": \"Error eum ut iusto.\"\n   }'")
 			}
 		}
 	}
@@ -169,7 +169,7 @@ func BuildUpdateProfilePayload(bffUpdateProfileMessage string, bffUpdateProfileT
 		if bffUpdateProfileMessage != "" {
 			err = json.Unmarshal([]byte(bffUpdateProfileMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"Rem non sequi rerum dicta autem.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"Quod sapiente.\"\n   }'")
 			}
 		}
 	}
@@ -186,3 +186,145 @@ func BuildUpdateProfilePayload(bffUpdateProfileMessage string, bffUpdateProfileT
 
 	return v, nil
 }
+
+// BuildScheduleMessagePayload builds the payload for the bff schedule-message
+// endpoint from CLI flags.
+func BuildScheduleMessagePayload(bffScheduleMessageMessage string, bffScheduleMessageToken string) (*bff.ScheduleMessagePayload, error) {
+	var err error
+	var message bffpb.ScheduleMessageRequest
+	{
+		if bffScheduleMessageMessage != "" {
+			err = json.Unmarshal([]byte(bffScheduleMessageMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"message\": \"Tempore fugit.\",\n      \"room_id\": \"Autem minus quam.\",\n      \"send_at\": 2654873182277710775\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = bffScheduleMessageToken
+	}
+	v := &bff.ScheduleMessagePayload{
+		RoomID:  message.RoomId,
+		Message: message.Message_,
+		SendAt:  message.SendAt,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListScheduledPayload builds the payload for the bff list-scheduled
+// endpoint from CLI flags.
+func BuildListScheduledPayload(bffListScheduledMessage string, bffListScheduledToken string) (*bff.ListScheduledPayload, error) {
+	var err error
+	var message bffpb.ListScheduledRequest
+	{
+		if bffListScheduledMessage != "" {
+			err = json.Unmarshal([]byte(bffListScheduledMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Sed dicta voluptas veritatis.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = bffListScheduledToken
+	}
+	v := &bff.ListScheduledPayload{
+		RoomID: message.RoomId,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCancelScheduledPayload builds the payload for the bff cancel-scheduled
+// endpoint from CLI flags.
+func BuildCancelScheduledPayload(bffCancelScheduledMessage string, bffCancelScheduledToken string) (*bff.CancelScheduledPayload, error) {
+	var err error
+	var message bffpb.CancelScheduledRequest
+	{
+		if bffCancelScheduledMessage != "" {
+			err = json.Unmarshal([]byte(bffCancelScheduledMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Perferendis suscipit itaque commodi odio.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = bffCancelScheduledToken
+	}
+	v := &bff.CancelScheduledPayload{
+		ID: message.Id,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreatePollPayload builds the payload for the bff create-poll endpoint
+// from CLI flags.
+func BuildCreatePollPayload(bffCreatePollMessage string, bffCreatePollToken string) (*bff.CreatePollPayload, error) {
+	var err error
+	var message bffpb.CreatePollRequest
+	{
+		if bffCreatePollMessage != "" {
+			err = json.Unmarshal([]byte(bffCreatePollMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"anonymous\": false,\n      \"closes_at\": 8303740379255892770,\n      \"multiple_choice\": true,\n      \"options\": [\n         \"Dolor non est.\",\n         \"Omnis cupiditate.\",\n         \"Laboriosam nobis dolores.\",\n         \"Atque voluptates et est aliquam quo.\"\n      ],\n      \"question\": \"Odio consectetur consectetur saepe et aut.\",\n      \"room_id\": \"Optio omnis necessitatibus odio tenetur.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = bffCreatePollToken
+	}
+	v := &bff.CreatePollPayload{
+		RoomID:         message.RoomId,
+		Question:       message.Question,
+		MultipleChoice: message.MultipleChoice,
+		Anonymous:      message.Anonymous,
+		ClosesAt:       message.ClosesAt,
+	}
+	if message.Options != nil {
+		v.Options = make([]string, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildVotePayload builds the payload for the bff vote endpoint from CLI flags.
+func BuildVotePayload(bffVoteMessage string, bffVoteToken string) (*bff.VotePayload, error) {
+	var err error
+	var message bffpb.VoteRequest
+	{
+		if bffVoteMessage != "" {
+			err = json.Unmarshal([]byte(bffVoteMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"options\": [\n         5406608474146301154,\n         9156838273409530697,\n         9157135848301077063\n      ],\n      \"poll_id\": \"Possimus commodi laborum.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = bffVoteToken
+	}
+	v := &bff.VotePayload{
+		PollID: message.PollId,
+	}
+	if message.Options != nil {
+		v.Options = make([]int, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = int(val)
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
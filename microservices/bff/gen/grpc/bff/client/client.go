@@ -206,6 +206,114 @@ func (c *Client) UpdateProfile() goa.Endpoint {
 	}
 }
 
+// ScheduleMessage calls the "ScheduleMessage" function in bffpb.BffClient
+// interface.
+func (c *Client) ScheduleMessage() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildScheduleMessageFunc(c.grpccli, c.opts...),
+			EncodeScheduleMessageRequest,
+			DecodeScheduleMessageResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListScheduled calls the "ListScheduled" function in bffpb.BffClient
+// interface.
+func (c *Client) ListScheduled() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListScheduledFunc(c.grpccli, c.opts...),
+			EncodeListScheduledRequest,
+			DecodeListScheduledResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CancelScheduled calls the "CancelScheduled" function in bffpb.BffClient
+// interface.
+func (c *Client) CancelScheduled() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCancelScheduledFunc(c.grpccli, c.opts...),
+			EncodeCancelScheduledRequest,
+			DecodeCancelScheduledResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CreatePoll calls the "CreatePoll" function in bffpb.BffClient interface.
+func (c *Client) CreatePoll() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCreatePollFunc(c.grpccli, c.opts...),
+			EncodeCreatePollRequest,
+			DecodeCreatePollResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Vote calls the "Vote" function in bffpb.BffClient interface.
+func (c *Client) Vote() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildVoteFunc(c.grpccli, c.opts...),
+			EncodeVoteRequest,
+			DecodeVoteResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
 // Recv reads instances of "bffpb.StreamChatResponse" from the "stream_chat"
 // endpoint gRPC stream.
 func (s *StreamChatClientStream) Recv() (*bff.EnrichedMessage, error) {
@@ -214,6 +322,9 @@ func (s *StreamChatClientStream) Recv() (*bff.EnrichedMessage, error) {
 	if err != nil {
 		return res, err
 	}
+	if err = ValidateStreamChatResponse(v); err != nil {
+		return res, err
+	}
 	return NewStreamChatResponseEnrichedMessage(v), nil
 }
 
@@ -225,14 +336,14 @@ func (s *StreamChatClientStream) RecvWithContext(ctx context.Context) (*bff.Enri
 
 // Send streams instances of "bffpb.StreamChatStreamingRequest" to the
 // "stream_chat" endpoint gRPC stream.
-func (s *StreamChatClientStream) Send(res string) error {
-	v := NewProtoStreamChatStreamingRequest(res)
+func (s *StreamChatClientStream) Send(res *bff.MessageInput) error {
+	v := NewProtoMessageInputStreamChatStreamingRequest(res)
 	return s.stream.Send(v)
 }
 
 // SendWithContext streams instances of "bffpb.StreamChatStreamingRequest" to
 // the "stream_chat" endpoint gRPC stream with context.
-func (s *StreamChatClientStream) SendWithContext(ctx context.Context, res string) error {
+func (s *StreamChatClientStream) SendWithContext(ctx context.Context, res *bff.MessageInput) error {
 	return s.Send(res)
 }
 
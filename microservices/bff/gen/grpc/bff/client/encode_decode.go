@@ -81,6 +81,9 @@ func DecodeHistoryResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (a
 	if !ok {
 		return nil, goagrpc.ErrInvalidType("bff", "history", "*bffpb.HistoryResponse", v)
 	}
+	if err := ValidateHistoryResponse(message); err != nil {
+		return nil, err
+	}
 	res := NewHistoryResult(message)
 	return res, nil
 }
@@ -290,3 +293,185 @@ func DecodeUpdateProfileResponse(ctx context.Context, v any, hdr, trlr metadata.
 	res := NewUpdateProfileResult(message)
 	return res, nil
 }
+
+// BuildScheduleMessageFunc builds the remote method to invoke for "bff"
+// service "schedule-message" endpoint.
+func BuildScheduleMessageFunc(grpccli bffpb.BffClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ScheduleMessage(ctx, reqpb.(*bffpb.ScheduleMessageRequest), opts...)
+		}
+		return grpccli.ScheduleMessage(ctx, &bffpb.ScheduleMessageRequest{}, opts...)
+	}
+}
+
+// EncodeScheduleMessageRequest encodes requests sent to bff schedule-message
+// endpoint.
+func EncodeScheduleMessageRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*bff.ScheduleMessagePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "schedule-message", "*bff.ScheduleMessagePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoScheduleMessageRequest(payload), nil
+}
+
+// DecodeScheduleMessageResponse decodes responses from the bff
+// schedule-message endpoint.
+func DecodeScheduleMessageResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*bffpb.ScheduleMessageResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "schedule-message", "*bffpb.ScheduleMessageResponse", v)
+	}
+	res := NewScheduleMessageResult(message)
+	return res, nil
+}
+
+// BuildListScheduledFunc builds the remote method to invoke for "bff" service
+// "list-scheduled" endpoint.
+func BuildListScheduledFunc(grpccli bffpb.BffClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListScheduled(ctx, reqpb.(*bffpb.ListScheduledRequest), opts...)
+		}
+		return grpccli.ListScheduled(ctx, &bffpb.ListScheduledRequest{}, opts...)
+	}
+}
+
+// EncodeListScheduledRequest encodes requests sent to bff list-scheduled
+// endpoint.
+func EncodeListScheduledRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*bff.ListScheduledPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "list-scheduled", "*bff.ListScheduledPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoListScheduledRequest(payload), nil
+}
+
+// DecodeListScheduledResponse decodes responses from the bff list-scheduled
+// endpoint.
+func DecodeListScheduledResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*bffpb.ListScheduledResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "list-scheduled", "*bffpb.ListScheduledResponse", v)
+	}
+	res := NewListScheduledResult(message)
+	return res, nil
+}
+
+// BuildCancelScheduledFunc builds the remote method to invoke for "bff"
+// service "cancel-scheduled" endpoint.
+func BuildCancelScheduledFunc(grpccli bffpb.BffClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CancelScheduled(ctx, reqpb.(*bffpb.CancelScheduledRequest), opts...)
+		}
+		return grpccli.CancelScheduled(ctx, &bffpb.CancelScheduledRequest{}, opts...)
+	}
+}
+
+// EncodeCancelScheduledRequest encodes requests sent to bff cancel-scheduled
+// endpoint.
+func EncodeCancelScheduledRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*bff.CancelScheduledPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "cancel-scheduled", "*bff.CancelScheduledPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCancelScheduledRequest(payload), nil
+}
+
+// DecodeCancelScheduledResponse decodes responses from the bff
+// cancel-scheduled endpoint.
+func DecodeCancelScheduledResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*bffpb.CancelScheduledResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "cancel-scheduled", "*bffpb.CancelScheduledResponse", v)
+	}
+	res := NewCancelScheduledResult(message)
+	return res, nil
+}
+
+// BuildCreatePollFunc builds the remote method to invoke for "bff" service
+// "create-poll" endpoint.
+func BuildCreatePollFunc(grpccli bffpb.BffClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CreatePoll(ctx, reqpb.(*bffpb.CreatePollRequest), opts...)
+		}
+		return grpccli.CreatePoll(ctx, &bffpb.CreatePollRequest{}, opts...)
+	}
+}
+
+// EncodeCreatePollRequest encodes requests sent to bff create-poll endpoint.
+func EncodeCreatePollRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*bff.CreatePollPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "create-poll", "*bff.CreatePollPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCreatePollRequest(payload), nil
+}
+
+// DecodeCreatePollResponse decodes responses from the bff create-poll endpoint.
+func DecodeCreatePollResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*bffpb.CreatePollResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "create-poll", "*bffpb.CreatePollResponse", v)
+	}
+	if err := ValidateCreatePollResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewCreatePollResult(message)
+	return res, nil
+}
+
+// BuildVoteFunc builds the remote method to invoke for "bff" service "vote"
+// endpoint.
+func BuildVoteFunc(grpccli bffpb.BffClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Vote(ctx, reqpb.(*bffpb.VoteRequest), opts...)
+		}
+		return grpccli.Vote(ctx, &bffpb.VoteRequest{}, opts...)
+	}
+}
+
+// EncodeVoteRequest encodes requests sent to bff vote endpoint.
+func EncodeVoteRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*bff.VotePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "vote", "*bff.VotePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoVoteRequest(payload), nil
+}
+
+// DecodeVoteResponse decodes responses from the bff vote endpoint.
+func DecodeVoteResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*bffpb.VoteResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "vote", "*bffpb.VoteResponse", v)
+	}
+	if err := ValidateVoteResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewVoteResult(message)
+	return res, nil
+}
@@ -8,6 +8,7 @@
 package client
 
 import (
+	goa "goa.design/goa/v3/pkg"
 	bff "object-t.com/hackz-giganoto/microservices/bff/gen/bff"
 	bffpb "object-t.com/hackz-giganoto/microservices/bff/gen/grpc/bff/pb"
 )
@@ -47,6 +48,23 @@ func NewHistoryResult(message *bffpb.HistoryResponse) []*bff.EnrichedMessage {
 			Message:   val.Message_,
 			CreatedAt: val.CreatedAt,
 			UpdatedAt: val.UpdatedAt,
+			Kind:      val.Kind,
+			ClientID:  val.ClientId,
+		}
+		if val.Attachments != nil {
+			result[i].Attachments = make([]*bff.Attachment, len(val.Attachments))
+			for j, val := range val.Attachments {
+				result[i].Attachments[j] = &bff.Attachment{
+					ID:          val.Id,
+					Filename:    val.Filename,
+					ContentType: val.ContentType,
+					Size:        val.Size,
+					DownloadURL: val.DownloadUrl,
+				}
+			}
+		}
+		if val.Poll != nil {
+			result[i].Poll = protobufBffpbPollToBffPoll(val.Poll)
 		}
 	}
 	return result
@@ -110,13 +128,38 @@ func NewStreamChatResponseEnrichedMessage(v *bffpb.StreamChatResponse) *bff.Enri
 		Message:   v.Message_,
 		CreatedAt: v.CreatedAt,
 		UpdatedAt: v.UpdatedAt,
+		Kind:      v.Kind,
+		ClientID:  v.ClientId,
+	}
+	if v.Attachments != nil {
+		result.Attachments = make([]*bff.Attachment, len(v.Attachments))
+		for i, val := range v.Attachments {
+			result.Attachments[i] = &bff.Attachment{
+				ID:          val.Id,
+				Filename:    val.Filename,
+				ContentType: val.ContentType,
+				Size:        val.Size,
+				DownloadURL: val.DownloadUrl,
+			}
+		}
+	}
+	if v.Poll != nil {
+		result.Poll = protobufBffpbPollToBffPoll(v.Poll)
 	}
 	return result
 }
 
-func NewProtoStreamChatStreamingRequest(spayload string) *bffpb.StreamChatStreamingRequest {
-	v := &bffpb.StreamChatStreamingRequest{}
-	v.Field = spayload
+func NewProtoMessageInputStreamChatStreamingRequest(spayload *bff.MessageInput) *bffpb.StreamChatStreamingRequest {
+	v := &bffpb.StreamChatStreamingRequest{
+		Message_: spayload.Message,
+		ClientId: spayload.ClientID,
+	}
+	if spayload.AttachmentIds != nil {
+		v.AttachmentIds = make([]string, len(spayload.AttachmentIds))
+		for i, val := range spayload.AttachmentIds {
+			v.AttachmentIds[i] = val
+		}
+	}
 	return v
 }
 
@@ -157,3 +200,317 @@ func NewUpdateProfileResult(message *bffpb.UpdateProfileResponse) *bff.UpdatePro
 	}
 	return result
 }
+
+// NewProtoScheduleMessageRequest builds the gRPC request type from the payload
+// of the "schedule-message" endpoint of the "bff" service.
+func NewProtoScheduleMessageRequest(payload *bff.ScheduleMessagePayload) *bffpb.ScheduleMessageRequest {
+	message := &bffpb.ScheduleMessageRequest{
+		RoomId:   payload.RoomID,
+		Message_: payload.Message,
+		SendAt:   payload.SendAt,
+	}
+	return message
+}
+
+// NewScheduleMessageResult builds the result type of the "schedule-message"
+// endpoint of the "bff" service from the gRPC response type.
+func NewScheduleMessageResult(message *bffpb.ScheduleMessageResponse) *bff.ScheduledMessage {
+	result := &bff.ScheduledMessage{
+		ID:        message.Id,
+		RoomID:    message.RoomId,
+		UserID:    message.UserId,
+		Message:   message.Message_,
+		SendAt:    message.SendAt,
+		CreatedAt: message.CreatedAt,
+	}
+	return result
+}
+
+// NewProtoListScheduledRequest builds the gRPC request type from the payload
+// of the "list-scheduled" endpoint of the "bff" service.
+func NewProtoListScheduledRequest(payload *bff.ListScheduledPayload) *bffpb.ListScheduledRequest {
+	message := &bffpb.ListScheduledRequest{
+		RoomId: payload.RoomID,
+	}
+	return message
+}
+
+// NewListScheduledResult builds the result type of the "list-scheduled"
+// endpoint of the "bff" service from the gRPC response type.
+func NewListScheduledResult(message *bffpb.ListScheduledResponse) []*bff.ScheduledMessage {
+	result := make([]*bff.ScheduledMessage, len(message.Field))
+	for i, val := range message.Field {
+		result[i] = &bff.ScheduledMessage{
+			ID:        val.Id,
+			RoomID:    val.RoomId,
+			UserID:    val.UserId,
+			Message:   val.Message_,
+			SendAt:    val.SendAt,
+			CreatedAt: val.CreatedAt,
+		}
+	}
+	return result
+}
+
+// NewProtoCancelScheduledRequest builds the gRPC request type from the payload
+// of the "cancel-scheduled" endpoint of the "bff" service.
+func NewProtoCancelScheduledRequest(payload *bff.CancelScheduledPayload) *bffpb.CancelScheduledRequest {
+	message := &bffpb.CancelScheduledRequest{
+		Id: payload.ID,
+	}
+	return message
+}
+
+// NewCancelScheduledResult builds the result type of the "cancel-scheduled"
+// endpoint of the "bff" service from the gRPC response type.
+func NewCancelScheduledResult(message *bffpb.CancelScheduledResponse) string {
+	result := message.Field
+	return result
+}
+
+// NewProtoCreatePollRequest builds the gRPC request type from the payload of
+// the "create-poll" endpoint of the "bff" service.
+func NewProtoCreatePollRequest(payload *bff.CreatePollPayload) *bffpb.CreatePollRequest {
+	message := &bffpb.CreatePollRequest{
+		RoomId:         payload.RoomID,
+		Question:       payload.Question,
+		MultipleChoice: payload.MultipleChoice,
+		Anonymous:      payload.Anonymous,
+		ClosesAt:       payload.ClosesAt,
+	}
+	if payload.Options != nil {
+		message.Options = make([]string, len(payload.Options))
+		for i, val := range payload.Options {
+			message.Options[i] = val
+		}
+	}
+	return message
+}
+
+// NewCreatePollResult builds the result type of the "create-poll" endpoint of
+// the "bff" service from the gRPC response type.
+func NewCreatePollResult(message *bffpb.CreatePollResponse) *bff.EnrichedMessage {
+	result := &bff.EnrichedMessage{
+		MessageID: message.MessageId,
+		RoomID:    message.RoomId,
+		UserID:    message.UserId,
+		Message:   message.Message_,
+		CreatedAt: message.CreatedAt,
+		UpdatedAt: message.UpdatedAt,
+		Kind:      message.Kind,
+		ClientID:  message.ClientId,
+	}
+	if message.Attachments != nil {
+		result.Attachments = make([]*bff.Attachment, len(message.Attachments))
+		for i, val := range message.Attachments {
+			result.Attachments[i] = &bff.Attachment{
+				ID:          val.Id,
+				Filename:    val.Filename,
+				ContentType: val.ContentType,
+				Size:        val.Size,
+				DownloadURL: val.DownloadUrl,
+			}
+		}
+	}
+	if message.Poll != nil {
+		result.Poll = protobufBffpbPollToBffPoll(message.Poll)
+	}
+	return result
+}
+
+// NewProtoVoteRequest builds the gRPC request type from the payload of the
+// "vote" endpoint of the "bff" service.
+func NewProtoVoteRequest(payload *bff.VotePayload) *bffpb.VoteRequest {
+	message := &bffpb.VoteRequest{
+		PollId: payload.PollID,
+	}
+	if payload.Options != nil {
+		message.Options = make([]int32, len(payload.Options))
+		for i, val := range payload.Options {
+			message.Options[i] = int32(val)
+		}
+	}
+	return message
+}
+
+// NewVoteResult builds the result type of the "vote" endpoint of the "bff"
+// service from the gRPC response type.
+func NewVoteResult(message *bffpb.VoteResponse) *bff.Poll {
+	result := &bff.Poll{
+		ID:             message.Id,
+		Question:       message.Question,
+		MultipleChoice: message.MultipleChoice,
+		Anonymous:      message.Anonymous,
+		ClosesAt:       message.ClosesAt,
+		Closed:         message.Closed,
+	}
+	if message.Options != nil {
+		result.Options = make([]*bff.PollOption, len(message.Options))
+		for i, val := range message.Options {
+			result.Options[i] = &bff.PollOption{
+				Index: int(val.Index),
+				Text:  val.Text,
+				Votes: int(val.Votes),
+			}
+			if val.Voters != nil {
+				result.Options[i].Voters = make([]*bff.Voter, len(val.Voters))
+				for j, val := range val.Voters {
+					result.Options[i].Voters[j] = &bff.Voter{
+						UserID: val.UserId,
+						Name:   val.Name,
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// ValidateHistoryResponse runs the validations defined on HistoryResponse.
+func ValidateHistoryResponse(message *bffpb.HistoryResponse) (err error) {
+	for _, e := range message.Field {
+		if e != nil {
+			if err2 := ValidateEnrichedMessage(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateEnrichedMessage runs the validations defined on EnrichedMessage.
+func ValidateEnrichedMessage(elem *bffpb.EnrichedMessage) (err error) {
+	if elem.Poll != nil {
+		if err2 := ValidatePoll(elem.Poll); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if elem.Kind != nil {
+		if !(*elem.Kind == "message" || *elem.Kind == "poll" || *elem.Kind == "poll_update" || *elem.Kind == "ack") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.kind", *elem.Kind, []any{"message", "poll", "poll_update", "ack"}))
+		}
+	}
+	return
+}
+
+// ValidatePoll runs the validations defined on Poll.
+func ValidatePoll(poll *bffpb.Poll) (err error) {
+	if poll.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "poll"))
+	}
+	return
+}
+
+// ValidateStreamChatResponse runs the validations defined on
+// StreamChatResponse.
+func ValidateStreamChatResponse(stream *bffpb.StreamChatResponse) (err error) {
+	if stream.Poll != nil {
+		if err2 := ValidatePoll(stream.Poll); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if stream.Kind != nil {
+		if !(*stream.Kind == "message" || *stream.Kind == "poll" || *stream.Kind == "poll_update" || *stream.Kind == "ack") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("stream.kind", *stream.Kind, []any{"message", "poll", "poll_update", "ack"}))
+		}
+	}
+	return
+}
+
+// ValidateCreatePollResponse runs the validations defined on
+// CreatePollResponse.
+func ValidateCreatePollResponse(message *bffpb.CreatePollResponse) (err error) {
+	if message.Poll != nil {
+		if err2 := ValidatePoll(message.Poll); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.Kind != nil {
+		if !(*message.Kind == "message" || *message.Kind == "poll" || *message.Kind == "poll_update" || *message.Kind == "ack") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.kind", *message.Kind, []any{"message", "poll", "poll_update", "ack"}))
+		}
+	}
+	return
+}
+
+// ValidateVoteResponse runs the validations defined on VoteResponse.
+func ValidateVoteResponse(message *bffpb.VoteResponse) (err error) {
+	if message.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "message"))
+	}
+	return
+}
+
+// svcBffPollToBffpbPoll builds a value of type *bffpb.Poll from a value of
+// type *bff.Poll.
+func svcBffPollToBffpbPoll(v *bff.Poll) *bffpb.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &bffpb.Poll{
+		Id:             v.ID,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*bffpb.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &bffpb.PollOption{
+				Index: int32(val.Index),
+				Text:  val.Text,
+				Votes: int32(val.Votes),
+			}
+			if val.Voters != nil {
+				res.Options[i].Voters = make([]*bffpb.Voter, len(val.Voters))
+				for j, val := range val.Voters {
+					res.Options[i].Voters[j] = &bffpb.Voter{
+						UserId: val.UserID,
+						Name:   val.Name,
+					}
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufBffpbPollToBffPoll builds a value of type *bff.Poll from a value of
+// type *bffpb.Poll.
+func protobufBffpbPollToBffPoll(v *bffpb.Poll) *bff.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &bff.Poll{
+		ID:             v.Id,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*bff.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &bff.PollOption{
+				Index: int(val.Index),
+				Text:  val.Text,
+				Votes: int(val.Votes),
+			}
+			if val.Voters != nil {
+				res.Options[i].Voters = make([]*bff.Voter, len(val.Voters))
+				for j, val := range val.Voters {
+					res.Options[i].Voters[j] = &bff.Voter{
+						UserID: val.UserId,
+						Name:   val.Name,
+					}
+				}
+			}
+		}
+	}
+
+	return res
+}
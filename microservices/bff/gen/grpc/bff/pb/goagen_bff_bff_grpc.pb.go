@@ -26,14 +26,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Bff_CreateRoom_FullMethodName    = "/bff.v1.Bff/CreateRoom"
-	Bff_History_FullMethodName       = "/bff.v1.Bff/History"
-	Bff_RoomList_FullMethodName      = "/bff.v1.Bff/RoomList"
-	Bff_JoinRoom_FullMethodName      = "/bff.v1.Bff/JoinRoom"
-	Bff_InviteRoom_FullMethodName    = "/bff.v1.Bff/InviteRoom"
-	Bff_StreamChat_FullMethodName    = "/bff.v1.Bff/StreamChat"
-	Bff_GetProfile_FullMethodName    = "/bff.v1.Bff/GetProfile"
-	Bff_UpdateProfile_FullMethodName = "/bff.v1.Bff/UpdateProfile"
+	Bff_CreateRoom_FullMethodName      = "/bff.v1.Bff/CreateRoom"
+	Bff_History_FullMethodName         = "/bff.v1.Bff/History"
+	Bff_RoomList_FullMethodName        = "/bff.v1.Bff/RoomList"
+	Bff_JoinRoom_FullMethodName        = "/bff.v1.Bff/JoinRoom"
+	Bff_InviteRoom_FullMethodName      = "/bff.v1.Bff/InviteRoom"
+	Bff_StreamChat_FullMethodName      = "/bff.v1.Bff/StreamChat"
+	Bff_GetProfile_FullMethodName      = "/bff.v1.Bff/GetProfile"
+	Bff_UpdateProfile_FullMethodName   = "/bff.v1.Bff/UpdateProfile"
+	Bff_ScheduleMessage_FullMethodName = "/bff.v1.Bff/ScheduleMessage"
+	Bff_ListScheduled_FullMethodName   = "/bff.v1.Bff/ListScheduled"
+	Bff_CancelScheduled_FullMethodName = "/bff.v1.Bff/CancelScheduled"
+	Bff_CreatePoll_FullMethodName      = "/bff.v1.Bff/CreatePoll"
+	Bff_Vote_FullMethodName            = "/bff.v1.Bff/Vote"
 )
 
 // BffClient is the client API for Bff service.
@@ -58,6 +63,16 @@ type BffClient interface {
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	// Update current user profile
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// Schedule a message to be sent to a chat room later
+	ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error)
+	// List the messages scheduled by the current user
+	ListScheduled(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ListScheduledResponse, error)
+	// Cancel a scheduled message before it is sent
+	CancelScheduled(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*CancelScheduledResponse, error)
+	// Post a poll in a chat room
+	CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*CreatePollResponse, error)
+	// Vote on a poll
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
 }
 
 type bffClient struct {
@@ -151,6 +166,56 @@ func (c *bffClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest,
 	return out, nil
 }
 
+func (c *bffClient) ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMessageResponse)
+	err := c.cc.Invoke(ctx, Bff_ScheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) ListScheduled(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ListScheduledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledResponse)
+	err := c.cc.Invoke(ctx, Bff_ListScheduled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) CancelScheduled(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*CancelScheduledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledResponse)
+	err := c.cc.Invoke(ctx, Bff_CancelScheduled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*CreatePollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePollResponse)
+	err := c.cc.Invoke(ctx, Bff_CreatePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bffClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, Bff_Vote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BffServer is the server API for Bff service.
 // All implementations must embed UnimplementedBffServer
 // for forward compatibility.
@@ -173,6 +238,16 @@ type BffServer interface {
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
 	// Update current user profile
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// Schedule a message to be sent to a chat room later
+	ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error)
+	// List the messages scheduled by the current user
+	ListScheduled(context.Context, *ListScheduledRequest) (*ListScheduledResponse, error)
+	// Cancel a scheduled message before it is sent
+	CancelScheduled(context.Context, *CancelScheduledRequest) (*CancelScheduledResponse, error)
+	// Post a poll in a chat room
+	CreatePoll(context.Context, *CreatePollRequest) (*CreatePollResponse, error)
+	// Vote on a poll
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
 	mustEmbedUnimplementedBffServer()
 }
 
@@ -207,6 +282,21 @@ func (UnimplementedBffServer) GetProfile(context.Context, *GetProfileRequest) (*
 func (UnimplementedBffServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedBffServer) ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMessage not implemented")
+}
+func (UnimplementedBffServer) ListScheduled(context.Context, *ListScheduledRequest) (*ListScheduledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduled not implemented")
+}
+func (UnimplementedBffServer) CancelScheduled(context.Context, *CancelScheduledRequest) (*CancelScheduledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduled not implemented")
+}
+func (UnimplementedBffServer) CreatePoll(context.Context, *CreatePollRequest) (*CreatePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePoll not implemented")
+}
+func (UnimplementedBffServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
 func (UnimplementedBffServer) mustEmbedUnimplementedBffServer() {}
 func (UnimplementedBffServer) testEmbeddedByValue()             {}
 
@@ -361,6 +451,96 @@ func _Bff_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bff_ScheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).ScheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bff_ScheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).ScheduleMessage(ctx, req.(*ScheduleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_ListScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).ListScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bff_ListScheduled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).ListScheduled(ctx, req.(*ListScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_CancelScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).CancelScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bff_CancelScheduled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).CancelScheduled(ctx, req.(*CancelScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_CreatePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).CreatePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bff_CreatePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).CreatePoll(ctx, req.(*CreatePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bff_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BffServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bff_Vote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BffServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bff_ServiceDesc is the grpc.ServiceDesc for Bff service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -396,6 +576,26 @@ var Bff_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _Bff_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "ScheduleMessage",
+			Handler:    _Bff_ScheduleMessage_Handler,
+		},
+		{
+			MethodName: "ListScheduled",
+			Handler:    _Bff_ListScheduled_Handler,
+		},
+		{
+			MethodName: "CancelScheduled",
+			Handler:    _Bff_CancelScheduled_Handler,
+		},
+		{
+			MethodName: "CreatePoll",
+			Handler:    _Bff_CreatePoll_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Bff_Vote_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -388,3 +388,252 @@ func DecodeUpdateProfileRequest(ctx context.Context, v any, md metadata.MD) (any
 	}
 	return payload, nil
 }
+
+// EncodeScheduleMessageResponse encodes responses from the "bff" service
+// "schedule-message" endpoint.
+func EncodeScheduleMessageResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*bff.ScheduledMessage)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "schedule-message", "*bff.ScheduledMessage", v)
+	}
+	resp := NewProtoScheduleMessageResponse(result)
+	return resp, nil
+}
+
+// DecodeScheduleMessageRequest decodes requests sent to "bff" service
+// "schedule-message" endpoint.
+func DecodeScheduleMessageRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *bffpb.ScheduleMessageRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*bffpb.ScheduleMessageRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("bff", "schedule-message", "*bffpb.ScheduleMessageRequest", v)
+		}
+	}
+	var payload *bff.ScheduleMessagePayload
+	{
+		payload = NewScheduleMessagePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeListScheduledResponse encodes responses from the "bff" service
+// "list-scheduled" endpoint.
+func EncodeListScheduledResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.([]*bff.ScheduledMessage)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "list-scheduled", "[]*bff.ScheduledMessage", v)
+	}
+	resp := NewProtoListScheduledResponse(result)
+	return resp, nil
+}
+
+// DecodeListScheduledRequest decodes requests sent to "bff" service
+// "list-scheduled" endpoint.
+func DecodeListScheduledRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *bffpb.ListScheduledRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*bffpb.ListScheduledRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("bff", "list-scheduled", "*bffpb.ListScheduledRequest", v)
+		}
+	}
+	var payload *bff.ListScheduledPayload
+	{
+		payload = NewListScheduledPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCancelScheduledResponse encodes responses from the "bff" service
+// "cancel-scheduled" endpoint.
+func EncodeCancelScheduledResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(string)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "cancel-scheduled", "string", v)
+	}
+	resp := NewProtoCancelScheduledResponse(result)
+	return resp, nil
+}
+
+// DecodeCancelScheduledRequest decodes requests sent to "bff" service
+// "cancel-scheduled" endpoint.
+func DecodeCancelScheduledRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *bffpb.CancelScheduledRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*bffpb.CancelScheduledRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("bff", "cancel-scheduled", "*bffpb.CancelScheduledRequest", v)
+		}
+	}
+	var payload *bff.CancelScheduledPayload
+	{
+		payload = NewCancelScheduledPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCreatePollResponse encodes responses from the "bff" service
+// "create-poll" endpoint.
+func EncodeCreatePollResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*bff.EnrichedMessage)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "create-poll", "*bff.EnrichedMessage", v)
+	}
+	resp := NewProtoCreatePollResponse(result)
+	return resp, nil
+}
+
+// DecodeCreatePollRequest decodes requests sent to "bff" service "create-poll"
+// endpoint.
+func DecodeCreatePollRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *bffpb.CreatePollRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*bffpb.CreatePollRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("bff", "create-poll", "*bffpb.CreatePollRequest", v)
+		}
+		if err = ValidateCreatePollRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *bff.CreatePollPayload
+	{
+		payload = NewCreatePollPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeVoteResponse encodes responses from the "bff" service "vote" endpoint.
+func EncodeVoteResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*bff.Poll)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("bff", "vote", "*bff.Poll", v)
+	}
+	resp := NewProtoVoteResponse(result)
+	return resp, nil
+}
+
+// DecodeVoteRequest decodes requests sent to "bff" service "vote" endpoint.
+func DecodeVoteRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *bffpb.VoteRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*bffpb.VoteRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("bff", "vote", "*bffpb.VoteRequest", v)
+		}
+		if err = ValidateVoteRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *bff.VotePayload
+	{
+		payload = NewVotePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
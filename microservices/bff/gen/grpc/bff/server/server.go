@@ -20,14 +20,19 @@ import (
 
 // Server implements the bffpb.BffServer interface.
 type Server struct {
-	CreateRoomH    goagrpc.UnaryHandler
-	HistoryH       goagrpc.UnaryHandler
-	RoomListH      goagrpc.UnaryHandler
-	JoinRoomH      goagrpc.UnaryHandler
-	InviteRoomH    goagrpc.UnaryHandler
-	StreamChatH    goagrpc.StreamHandler
-	GetProfileH    goagrpc.UnaryHandler
-	UpdateProfileH goagrpc.UnaryHandler
+	CreateRoomH      goagrpc.UnaryHandler
+	HistoryH         goagrpc.UnaryHandler
+	RoomListH        goagrpc.UnaryHandler
+	JoinRoomH        goagrpc.UnaryHandler
+	InviteRoomH      goagrpc.UnaryHandler
+	StreamChatH      goagrpc.StreamHandler
+	GetProfileH      goagrpc.UnaryHandler
+	UpdateProfileH   goagrpc.UnaryHandler
+	ScheduleMessageH goagrpc.UnaryHandler
+	ListScheduledH   goagrpc.UnaryHandler
+	CancelScheduledH goagrpc.UnaryHandler
+	CreatePollH      goagrpc.UnaryHandler
+	VoteH            goagrpc.UnaryHandler
 	bffpb.UnimplementedBffServer
 }
 
@@ -39,14 +44,19 @@ type StreamChatServerStream struct {
 // New instantiates the server struct with the bff service endpoints.
 func New(e *bff.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
 	return &Server{
-		CreateRoomH:    NewCreateRoomHandler(e.CreateRoom, uh),
-		HistoryH:       NewHistoryHandler(e.History, uh),
-		RoomListH:      NewRoomListHandler(e.RoomList, uh),
-		JoinRoomH:      NewJoinRoomHandler(e.JoinRoom, uh),
-		InviteRoomH:    NewInviteRoomHandler(e.InviteRoom, uh),
-		StreamChatH:    NewStreamChatHandler(e.StreamChat, sh),
-		GetProfileH:    NewGetProfileHandler(e.GetProfile, uh),
-		UpdateProfileH: NewUpdateProfileHandler(e.UpdateProfile, uh),
+		CreateRoomH:      NewCreateRoomHandler(e.CreateRoom, uh),
+		HistoryH:         NewHistoryHandler(e.History, uh),
+		RoomListH:        NewRoomListHandler(e.RoomList, uh),
+		JoinRoomH:        NewJoinRoomHandler(e.JoinRoom, uh),
+		InviteRoomH:      NewInviteRoomHandler(e.InviteRoom, uh),
+		StreamChatH:      NewStreamChatHandler(e.StreamChat, sh),
+		GetProfileH:      NewGetProfileHandler(e.GetProfile, uh),
+		UpdateProfileH:   NewUpdateProfileHandler(e.UpdateProfile, uh),
+		ScheduleMessageH: NewScheduleMessageHandler(e.ScheduleMessage, uh),
+		ListScheduledH:   NewListScheduledHandler(e.ListScheduled, uh),
+		CancelScheduledH: NewCancelScheduledHandler(e.CancelScheduled, uh),
+		CreatePollH:      NewCreatePollHandler(e.CreatePoll, uh),
+		VoteH:            NewVoteHandler(e.Vote, uh),
 	}
 }
 
@@ -305,6 +315,156 @@ func (s *Server) UpdateProfile(ctx context.Context, message *bffpb.UpdateProfile
 	return resp.(*bffpb.UpdateProfileResponse), nil
 }
 
+// NewScheduleMessageHandler creates a gRPC handler which serves the "bff"
+// service "schedule-message" endpoint.
+func NewScheduleMessageHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeScheduleMessageRequest, EncodeScheduleMessageResponse)
+	}
+	return h
+}
+
+// ScheduleMessage implements the "ScheduleMessage" method in bffpb.BffServer
+// interface.
+func (s *Server) ScheduleMessage(ctx context.Context, message *bffpb.ScheduleMessageRequest) (*bffpb.ScheduleMessageResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "schedule-message")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "bff")
+	resp, err := s.ScheduleMessageH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_argument":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*bffpb.ScheduleMessageResponse), nil
+}
+
+// NewListScheduledHandler creates a gRPC handler which serves the "bff"
+// service "list-scheduled" endpoint.
+func NewListScheduledHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListScheduledRequest, EncodeListScheduledResponse)
+	}
+	return h
+}
+
+// ListScheduled implements the "ListScheduled" method in bffpb.BffServer
+// interface.
+func (s *Server) ListScheduled(ctx context.Context, message *bffpb.ListScheduledRequest) (*bffpb.ListScheduledResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list-scheduled")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "bff")
+	resp, err := s.ListScheduledH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*bffpb.ListScheduledResponse), nil
+}
+
+// NewCancelScheduledHandler creates a gRPC handler which serves the "bff"
+// service "cancel-scheduled" endpoint.
+func NewCancelScheduledHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCancelScheduledRequest, EncodeCancelScheduledResponse)
+	}
+	return h
+}
+
+// CancelScheduled implements the "CancelScheduled" method in bffpb.BffServer
+// interface.
+func (s *Server) CancelScheduled(ctx context.Context, message *bffpb.CancelScheduledRequest) (*bffpb.CancelScheduledResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "cancel-scheduled")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "bff")
+	resp, err := s.CancelScheduledH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "notfound":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "permission-denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*bffpb.CancelScheduledResponse), nil
+}
+
+// NewCreatePollHandler creates a gRPC handler which serves the "bff" service
+// "create-poll" endpoint.
+func NewCreatePollHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCreatePollRequest, EncodeCreatePollResponse)
+	}
+	return h
+}
+
+// CreatePoll implements the "CreatePoll" method in bffpb.BffServer interface.
+func (s *Server) CreatePoll(ctx context.Context, message *bffpb.CreatePollRequest) (*bffpb.CreatePollResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "create-poll")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "bff")
+	resp, err := s.CreatePollH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_argument":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*bffpb.CreatePollResponse), nil
+}
+
+// NewVoteHandler creates a gRPC handler which serves the "bff" service "vote"
+// endpoint.
+func NewVoteHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeVoteRequest, EncodeVoteResponse)
+	}
+	return h
+}
+
+// Vote implements the "Vote" method in bffpb.BffServer interface.
+func (s *Server) Vote(ctx context.Context, message *bffpb.VoteRequest) (*bffpb.VoteResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "vote")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "bff")
+	resp, err := s.VoteH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "notfound":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "invalid_argument":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*bffpb.VoteResponse), nil
+}
+
 // Send streams instances of "bffpb.StreamChatResponse" to the "stream_chat"
 // endpoint gRPC stream.
 func (s *StreamChatServerStream) Send(res *bff.EnrichedMessage) error {
@@ -320,18 +480,18 @@ func (s *StreamChatServerStream) SendWithContext(ctx context.Context, res *bff.E
 
 // Recv reads instances of "bffpb.StreamChatStreamingRequest" from the
 // "stream_chat" endpoint gRPC stream.
-func (s *StreamChatServerStream) Recv() (string, error) {
-	var res string
+func (s *StreamChatServerStream) Recv() (*bff.MessageInput, error) {
+	var res *bff.MessageInput
 	v, err := s.stream.Recv()
 	if err != nil {
 		return res, err
 	}
-	return NewStreamChatStreamingRequestStreamChatStreamingRequest(v), nil
+	return NewStreamChatStreamingRequestMessageInput(v), nil
 }
 
 // RecvWithContext reads instances of "bffpb.StreamChatStreamingRequest" from
 // the "stream_chat" endpoint gRPC stream with context.
-func (s *StreamChatServerStream) RecvWithContext(ctx context.Context) (string, error) {
+func (s *StreamChatServerStream) RecvWithContext(ctx context.Context) (*bff.MessageInput, error) {
 	return s.Recv()
 }
 
@@ -8,6 +8,7 @@
 package server
 
 import (
+	goa "goa.design/goa/v3/pkg"
 	bff "object-t.com/hackz-giganoto/microservices/bff/gen/bff"
 	bffpb "object-t.com/hackz-giganoto/microservices/bff/gen/grpc/bff/pb"
 )
@@ -51,6 +52,23 @@ func NewProtoHistoryResponse(result []*bff.EnrichedMessage) *bffpb.HistoryRespon
 			Message_:  val.Message,
 			CreatedAt: val.CreatedAt,
 			UpdatedAt: val.UpdatedAt,
+			Kind:      val.Kind,
+			ClientId:  val.ClientID,
+		}
+		if val.Attachments != nil {
+			message.Field[i].Attachments = make([]*bffpb.Attachment, len(val.Attachments))
+			for j, val := range val.Attachments {
+				message.Field[i].Attachments[j] = &bffpb.Attachment{
+					Id:          val.ID,
+					Filename:    val.Filename,
+					ContentType: val.ContentType,
+					Size:        val.Size,
+					DownloadUrl: val.DownloadURL,
+				}
+			}
+		}
+		if val.Poll != nil {
+			message.Field[i].Poll = svcBffPollToBffpbPoll(val.Poll)
 		}
 	}
 	return message
@@ -131,6 +149,23 @@ func NewProtoStreamChatResponse(result *bff.EnrichedMessage) *bffpb.StreamChatRe
 		Message_:  result.Message,
 		CreatedAt: result.CreatedAt,
 		UpdatedAt: result.UpdatedAt,
+		Kind:      result.Kind,
+		ClientId:  result.ClientID,
+	}
+	if result.Attachments != nil {
+		message.Attachments = make([]*bffpb.Attachment, len(result.Attachments))
+		for i, val := range result.Attachments {
+			message.Attachments[i] = &bffpb.Attachment{
+				Id:          val.ID,
+				Filename:    val.Filename,
+				ContentType: val.ContentType,
+				Size:        val.Size,
+				DownloadUrl: val.DownloadURL,
+			}
+		}
+	}
+	if result.Poll != nil {
+		message.Poll = svcBffPollToBffpbPoll(result.Poll)
 	}
 	return message
 }
@@ -143,12 +178,38 @@ func NewProtoEnrichedMessageStreamChatResponse(result *bff.EnrichedMessage) *bff
 		Message_:  result.Message,
 		CreatedAt: result.CreatedAt,
 		UpdatedAt: result.UpdatedAt,
+		Kind:      result.Kind,
+		ClientId:  result.ClientID,
+	}
+	if result.Attachments != nil {
+		v.Attachments = make([]*bffpb.Attachment, len(result.Attachments))
+		for i, val := range result.Attachments {
+			v.Attachments[i] = &bffpb.Attachment{
+				Id:          val.ID,
+				Filename:    val.Filename,
+				ContentType: val.ContentType,
+				Size:        val.Size,
+				DownloadUrl: val.DownloadURL,
+			}
+		}
+	}
+	if result.Poll != nil {
+		v.Poll = svcBffPollToBffpbPoll(result.Poll)
 	}
 	return v
 }
 
-func NewStreamChatStreamingRequestStreamChatStreamingRequest(v *bffpb.StreamChatStreamingRequest) string {
-	spayload := v.Field
+func NewStreamChatStreamingRequestMessageInput(v *bffpb.StreamChatStreamingRequest) *bff.MessageInput {
+	spayload := &bff.MessageInput{
+		Message:  v.Message_,
+		ClientID: v.ClientId,
+	}
+	if v.AttachmentIds != nil {
+		spayload.AttachmentIds = make([]string, len(v.AttachmentIds))
+		for i, val := range v.AttachmentIds {
+			spayload.AttachmentIds[i] = val
+		}
+	}
 	return spayload
 }
 
@@ -191,3 +252,265 @@ func NewProtoUpdateProfileResponse(result *bff.UpdateProfileResult) *bffpb.Updat
 	}
 	return message
 }
+
+// NewScheduleMessagePayload builds the payload of the "schedule-message"
+// endpoint of the "bff" service from the gRPC request type.
+func NewScheduleMessagePayload(message *bffpb.ScheduleMessageRequest, token string) *bff.ScheduleMessagePayload {
+	v := &bff.ScheduleMessagePayload{
+		RoomID:  message.RoomId,
+		Message: message.Message_,
+		SendAt:  message.SendAt,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoScheduleMessageResponse builds the gRPC response type from the
+// result of the "schedule-message" endpoint of the "bff" service.
+func NewProtoScheduleMessageResponse(result *bff.ScheduledMessage) *bffpb.ScheduleMessageResponse {
+	message := &bffpb.ScheduleMessageResponse{
+		Id:        result.ID,
+		RoomId:    result.RoomID,
+		UserId:    result.UserID,
+		Message_:  result.Message,
+		SendAt:    result.SendAt,
+		CreatedAt: result.CreatedAt,
+	}
+	return message
+}
+
+// NewListScheduledPayload builds the payload of the "list-scheduled" endpoint
+// of the "bff" service from the gRPC request type.
+func NewListScheduledPayload(message *bffpb.ListScheduledRequest, token string) *bff.ListScheduledPayload {
+	v := &bff.ListScheduledPayload{
+		RoomID: message.RoomId,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoListScheduledResponse builds the gRPC response type from the result
+// of the "list-scheduled" endpoint of the "bff" service.
+func NewProtoListScheduledResponse(result []*bff.ScheduledMessage) *bffpb.ListScheduledResponse {
+	message := &bffpb.ListScheduledResponse{}
+	message.Field = make([]*bffpb.ScheduledMessage, len(result))
+	for i, val := range result {
+		message.Field[i] = &bffpb.ScheduledMessage{
+			Id:        val.ID,
+			RoomId:    val.RoomID,
+			UserId:    val.UserID,
+			Message_:  val.Message,
+			SendAt:    val.SendAt,
+			CreatedAt: val.CreatedAt,
+		}
+	}
+	return message
+}
+
+// NewCancelScheduledPayload builds the payload of the "cancel-scheduled"
+// endpoint of the "bff" service from the gRPC request type.
+func NewCancelScheduledPayload(message *bffpb.CancelScheduledRequest, token string) *bff.CancelScheduledPayload {
+	v := &bff.CancelScheduledPayload{
+		ID: message.Id,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCancelScheduledResponse builds the gRPC response type from the
+// result of the "cancel-scheduled" endpoint of the "bff" service.
+func NewProtoCancelScheduledResponse(result string) *bffpb.CancelScheduledResponse {
+	message := &bffpb.CancelScheduledResponse{}
+	message.Field = result
+	return message
+}
+
+// NewCreatePollPayload builds the payload of the "create-poll" endpoint of the
+// "bff" service from the gRPC request type.
+func NewCreatePollPayload(message *bffpb.CreatePollRequest, token string) *bff.CreatePollPayload {
+	v := &bff.CreatePollPayload{
+		RoomID:         message.RoomId,
+		Question:       message.Question,
+		MultipleChoice: message.MultipleChoice,
+		Anonymous:      message.Anonymous,
+		ClosesAt:       message.ClosesAt,
+	}
+	if message.Options != nil {
+		v.Options = make([]string, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = val
+		}
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCreatePollResponse builds the gRPC response type from the result of
+// the "create-poll" endpoint of the "bff" service.
+func NewProtoCreatePollResponse(result *bff.EnrichedMessage) *bffpb.CreatePollResponse {
+	message := &bffpb.CreatePollResponse{
+		MessageId: result.MessageID,
+		RoomId:    result.RoomID,
+		UserId:    result.UserID,
+		Message_:  result.Message,
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+		Kind:      result.Kind,
+		ClientId:  result.ClientID,
+	}
+	if result.Attachments != nil {
+		message.Attachments = make([]*bffpb.Attachment, len(result.Attachments))
+		for i, val := range result.Attachments {
+			message.Attachments[i] = &bffpb.Attachment{
+				Id:          val.ID,
+				Filename:    val.Filename,
+				ContentType: val.ContentType,
+				Size:        val.Size,
+				DownloadUrl: val.DownloadURL,
+			}
+		}
+	}
+	if result.Poll != nil {
+		message.Poll = svcBffPollToBffpbPoll(result.Poll)
+	}
+	return message
+}
+
+// NewVotePayload builds the payload of the "vote" endpoint of the "bff"
+// service from the gRPC request type.
+func NewVotePayload(message *bffpb.VoteRequest, token string) *bff.VotePayload {
+	v := &bff.VotePayload{
+		PollID: message.PollId,
+	}
+	if message.Options != nil {
+		v.Options = make([]int, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = int(val)
+		}
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoVoteResponse builds the gRPC response type from the result of the
+// "vote" endpoint of the "bff" service.
+func NewProtoVoteResponse(result *bff.Poll) *bffpb.VoteResponse {
+	message := &bffpb.VoteResponse{
+		Id:             result.ID,
+		Question:       result.Question,
+		MultipleChoice: result.MultipleChoice,
+		Anonymous:      result.Anonymous,
+		ClosesAt:       result.ClosesAt,
+		Closed:         result.Closed,
+	}
+	if result.Options != nil {
+		message.Options = make([]*bffpb.PollOption, len(result.Options))
+		for i, val := range result.Options {
+			message.Options[i] = &bffpb.PollOption{
+				Index: int32(val.Index),
+				Text:  val.Text,
+				Votes: int32(val.Votes),
+			}
+			if val.Voters != nil {
+				message.Options[i].Voters = make([]*bffpb.Voter, len(val.Voters))
+				for j, val := range val.Voters {
+					message.Options[i].Voters[j] = &bffpb.Voter{
+						UserId: val.UserID,
+						Name:   val.Name,
+					}
+				}
+			}
+		}
+	}
+	return message
+}
+
+// ValidateCreatePollRequest runs the validations defined on CreatePollRequest.
+func ValidateCreatePollRequest(message *bffpb.CreatePollRequest) (err error) {
+	if message.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "message"))
+	}
+	return
+}
+
+// ValidateVoteRequest runs the validations defined on VoteRequest.
+func ValidateVoteRequest(message *bffpb.VoteRequest) (err error) {
+	if message.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "message"))
+	}
+	return
+}
+
+// svcBffPollToBffpbPoll builds a value of type *bffpb.Poll from a value of
+// type *bff.Poll.
+func svcBffPollToBffpbPoll(v *bff.Poll) *bffpb.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &bffpb.Poll{
+		Id:             v.ID,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*bffpb.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &bffpb.PollOption{
+				Index: int32(val.Index),
+				Text:  val.Text,
+				Votes: int32(val.Votes),
+			}
+			if val.Voters != nil {
+				res.Options[i].Voters = make([]*bffpb.Voter, len(val.Voters))
+				for j, val := range val.Voters {
+					res.Options[i].Voters[j] = &bffpb.Voter{
+						UserId: val.UserID,
+						Name:   val.Name,
+					}
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufBffpbPollToBffPoll builds a value of type *bff.Poll from a value of
+// type *bffpb.Poll.
+func protobufBffpbPollToBffPoll(v *bffpb.Poll) *bff.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &bff.Poll{
+		ID:             v.Id,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*bff.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &bff.PollOption{
+				Index: int(val.Index),
+				Text:  val.Text,
+				Votes: int(val.Votes),
+			}
+			if val.Voters != nil {
+				res.Options[i].Voters = make([]*bff.Voter, len(val.Voters))
+				for j, val := range val.Voters {
+					res.Options[i].Voters[j] = &bff.Voter{
+						UserID: val.UserId,
+						Name:   val.Name,
+					}
+				}
+			}
+		}
+	}
+
+	return res
+}
@@ -21,13 +21,13 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `bff (create-room|history|room-list|join-room|invite-room|stream-chat|get-profile|update-profile)
+	return `bff (create-room|history|room-list|join-room|invite-room|stream-chat|get-profile|update-profile|schedule-message|list-scheduled|cancel-scheduled|create-poll|vote)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` bff create-room --token "Aspernatur tenetur libero accusantium laborum."` + "\n" +
+	return os.Args[0] + ` bff create-room --token "Quibusdam harum ea nostrum."` + "\n" +
 		""
 }
 
@@ -69,6 +69,26 @@ func ParseEndpoint(
 		bffUpdateProfileFlags       = flag.NewFlagSet("update-profile", flag.ExitOnError)
 		bffUpdateProfileMessageFlag = bffUpdateProfileFlags.String("message", "", "")
 		bffUpdateProfileTokenFlag   = bffUpdateProfileFlags.String("token", "", "")
+
+		bffScheduleMessageFlags       = flag.NewFlagSet("schedule-message", flag.ExitOnError)
+		bffScheduleMessageMessageFlag = bffScheduleMessageFlags.String("message", "", "")
+		bffScheduleMessageTokenFlag   = bffScheduleMessageFlags.String("token", "REQUIRED", "")
+
+		bffListScheduledFlags       = flag.NewFlagSet("list-scheduled", flag.ExitOnError)
+		bffListScheduledMessageFlag = bffListScheduledFlags.String("message", "", "")
+		bffListScheduledTokenFlag   = bffListScheduledFlags.String("token", "REQUIRED", "")
+
+		bffCancelScheduledFlags       = flag.NewFlagSet("cancel-scheduled", flag.ExitOnError)
+		bffCancelScheduledMessageFlag = bffCancelScheduledFlags.String("message", "", "")
+		bffCancelScheduledTokenFlag   = bffCancelScheduledFlags.String("token", "REQUIRED", "")
+
+		bffCreatePollFlags       = flag.NewFlagSet("create-poll", flag.ExitOnError)
+		bffCreatePollMessageFlag = bffCreatePollFlags.String("message", "", "")
+		bffCreatePollTokenFlag   = bffCreatePollFlags.String("token", "REQUIRED", "")
+
+		bffVoteFlags       = flag.NewFlagSet("vote", flag.ExitOnError)
+		bffVoteMessageFlag = bffVoteFlags.String("message", "", "")
+		bffVoteTokenFlag   = bffVoteFlags.String("token", "REQUIRED", "")
 	)
 	bffFlags.Usage = bffUsage
 	bffCreateRoomFlags.Usage = bffCreateRoomUsage
@@ -79,6 +99,11 @@ func ParseEndpoint(
 	bffStreamChatFlags.Usage = bffStreamChatUsage
 	bffGetProfileFlags.Usage = bffGetProfileUsage
 	bffUpdateProfileFlags.Usage = bffUpdateProfileUsage
+	bffScheduleMessageFlags.Usage = bffScheduleMessageUsage
+	bffListScheduledFlags.Usage = bffListScheduledUsage
+	bffCancelScheduledFlags.Usage = bffCancelScheduledUsage
+	bffCreatePollFlags.Usage = bffCreatePollUsage
+	bffVoteFlags.Usage = bffVoteUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -138,6 +163,21 @@ func ParseEndpoint(
 			case "update-profile":
 				epf = bffUpdateProfileFlags
 
+			case "schedule-message":
+				epf = bffScheduleMessageFlags
+
+			case "list-scheduled":
+				epf = bffListScheduledFlags
+
+			case "cancel-scheduled":
+				epf = bffCancelScheduledFlags
+
+			case "create-poll":
+				epf = bffCreatePollFlags
+
+			case "vote":
+				epf = bffVoteFlags
+
 			}
 
 		}
@@ -187,6 +227,21 @@ func ParseEndpoint(
 			case "update-profile":
 				endpoint = c.UpdateProfile()
 				data, err = bffc.BuildUpdateProfilePayload(*bffUpdateProfileMessageFlag, *bffUpdateProfileTokenFlag)
+			case "schedule-message":
+				endpoint = c.ScheduleMessage()
+				data, err = bffc.BuildScheduleMessagePayload(*bffScheduleMessageMessageFlag, *bffScheduleMessageTokenFlag)
+			case "list-scheduled":
+				endpoint = c.ListScheduled()
+				data, err = bffc.BuildListScheduledPayload(*bffListScheduledMessageFlag, *bffListScheduledTokenFlag)
+			case "cancel-scheduled":
+				endpoint = c.CancelScheduled()
+				data, err = bffc.BuildCancelScheduledPayload(*bffCancelScheduledMessageFlag, *bffCancelScheduledTokenFlag)
+			case "create-poll":
+				endpoint = c.CreatePoll()
+				data, err = bffc.BuildCreatePollPayload(*bffCreatePollMessageFlag, *bffCreatePollTokenFlag)
+			case "vote":
+				endpoint = c.Vote()
+				data, err = bffc.BuildVotePayload(*bffVoteMessageFlag, *bffVoteTokenFlag)
 			}
 		}
 	}
@@ -212,6 +267,11 @@ COMMAND:
     stream-chat: Stream chat messages with bidirectional communication
     get-profile: Get current user profile
     update-profile: Update current user profile
+    schedule-message: Schedule a message to be sent to a chat room later
+    list-scheduled: List the messages scheduled by the current user
+    cancel-scheduled: Cancel a scheduled message before it is sent
+    create-poll: Post a poll in a chat room
+    vote: Vote on a poll
 
 Additional help:
     %[1]s bff COMMAND --help
@@ -224,7 +284,7 @@ Create a new chat room
     -token STRING: 
 
 Example:
-    %[1]s bff create-room --token "Aspernatur tenetur libero accusantium laborum."
+    %[1]s bff create-room --token "Quibusdam harum ea nostrum."
 `, os.Args[0])
 }
 
@@ -237,8 +297,8 @@ Get chat room history with enriched user names
 
 Example:
     %[1]s bff history --message '{
-      "room_id": "Pariatur non commodi sunt quibusdam."
-   }' --token "Totam voluptatum in error suscipit eius impedit."
+      "room_id": "Molestiae nulla ea omnis qui quis sit."
+   }' --token "A quam perspiciatis."
 `, os.Args[0])
 }
 
@@ -249,7 +309,7 @@ Get all chat rooms history
     -token STRING: 
 
 Example:
-    %[1]s bff room-list --token "Quia illum officiis et."
+    %[1]s bff room-list --token "Sint nemo blanditiis nobis et distinctio."
 `, os.Args[0])
 }
 
@@ -262,8 +322,8 @@ Creates a new chat room
 
 Example:
     %[1]s bff join-room --message '{
-      "invite_key": "Et quae consequatur expedita."
-   }' --token "Sunt doloribus quibusdam nihil sed."
+      "invite_key": "Aut itaque architecto et."
+   }' --token "Dolore doloremque non quam quia provident."
 `, os.Args[0])
 }
 
@@ -276,9 +336,9 @@ Creates a new chat room
 
 Example:
     %[1]s bff invite-room --message '{
-      "room_id": "Veritatis error et nulla eius.",
-      "user_id": "Sed dolorem."
-   }' --token "Mollitia corrupti placeat enim aut."
+      "room_id": "Error voluptas ipsa sit odio.",
+      "user_id": "Dolorem magnam magni illum aperiam error quia."
+   }' --token "Debitis quibusdam sint."
 `, os.Args[0])
 }
 
@@ -290,7 +350,7 @@ Stream chat messages with bidirectional communication
     -room-id STRING: 
 
 Example:
-    %[1]s bff stream-chat --token "Autem ipsam officiis rem autem." --room-id "Quo facilis quas voluptatum."
+    %[1]s bff stream-chat --token "Impedit facere suscipit." --room-id "Asperiores quia corporis numquam repudiandae eum."
 `, os.Args[0])
 }
 
@@ -303,8 +363,8 @@ Get current user profile
 
 Example:
     %[1]s bff get-profile --message '{
-      "user_id": "Alias vel."
-   }' --token "Nostrum ipsa consequatur vel et inventore."
+      "user_id": "Error eum ut iusto."
+   }' --token "Omnis accusamus aperiam eos molestiae quos."
 `, os.Args[0])
 }
 
@@ -317,7 +377,94 @@ Update current user profile
 
 Example:
     %[1]s bff update-profile --message '{
-      "name": "Rem non sequi rerum dicta autem."
-   }' --token "Nostrum quo et quia."
+      "name": "Quod sapiente."
+   }' --token "Harum nihil quo sunt fugiat possimus labore."
+`, os.Args[0])
+}
+
+func bffScheduleMessageUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] bff schedule-message -message JSON -token STRING
+
+Schedule a message to be sent to a chat room later
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s bff schedule-message --message '{
+      "message": "Tempore fugit.",
+      "room_id": "Autem minus quam.",
+      "send_at": 2654873182277710775
+   }' --token "Quis eaque nesciunt numquam vel ducimus enim."
+`, os.Args[0])
+}
+
+func bffListScheduledUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] bff list-scheduled -message JSON -token STRING
+
+List the messages scheduled by the current user
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s bff list-scheduled --message '{
+      "room_id": "Sed dicta voluptas veritatis."
+   }' --token "Accusantium esse impedit magni esse facere id."
+`, os.Args[0])
+}
+
+func bffCancelScheduledUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] bff cancel-scheduled -message JSON -token STRING
+
+Cancel a scheduled message before it is sent
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s bff cancel-scheduled --message '{
+      "id": "Perferendis suscipit itaque commodi odio."
+   }' --token "Repellendus aut voluptatem."
+`, os.Args[0])
+}
+
+func bffCreatePollUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] bff create-poll -message JSON -token STRING
+
+Post a poll in a chat room
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s bff create-poll --message '{
+      "anonymous": false,
+      "closes_at": 8303740379255892770,
+      "multiple_choice": true,
+      "options": [
+         "Dolor non est.",
+         "Omnis cupiditate.",
+         "Laboriosam nobis dolores.",
+         "Atque voluptates et est aliquam quo."
+      ],
+      "question": "Odio consectetur consectetur saepe et aut.",
+      "room_id": "Optio omnis necessitatibus odio tenetur."
+   }' --token "Sint fuga."
+`, os.Args[0])
+}
+
+func bffVoteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] bff vote -message JSON -token STRING
+
+Vote on a poll
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s bff vote --message '{
+      "options": [
+         5406608474146301154,
+         9156838273409530697,
+         9157135848301077063
+      ],
+      "poll_id": "Possimus commodi laborum."
+   }' --token "Sit eius non deleniti."
 `, os.Args[0])
 }
@@ -2,83 +2,84 @@ package chatapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
-	"os"
+	"sync"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
-	"github.com/redis/go-redis/v9"
 	"goa.design/clue/log"
 	"goa.design/goa/v3/security"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+	security2 "object-t.com/hackz-giganoto/pkg/security"
 )
 
 const (
-	roomsKey       = "rooms"
-	roomPublishKey = "room_publish"
-	historyKey     = "history"
+	// dedupWindow is how long a client message ID is remembered to drop
+	// retried sends.
+	dedupWindow = 10 * time.Minute
+)
+
+// Kinds of the events sent on a room stream.
+const (
+	kindMessage    = "message"
+	kindPoll       = "poll"
+	kindPollUpdate = "poll_update"
+	kindAck        = "ack"
 )
 
 type chatsrvc struct {
-	redis     *redis.Client
-	jwtSecret []byte
+	store ChatStore
+	files filespb.FilesClient
 }
 
-func NewChat() chat.Service {
-	// Setup Redis client
-	redisAddr := os.Getenv("REDIS_ADDR")
-	if redisAddr == "" {
-		redisAddr = "localhost:6379"
+// NewChat returns the chat service implementation persisting its state in
+// store. The attachments of the messages are shared in their room through
+// files. The scheduler delivering scheduled messages runs until ctx is
+// canceled.
+func NewChat(ctx context.Context, store ChatStore, files filespb.FilesClient) chat.Service {
+	s := &chatsrvc{
+		store: store,
+		files: files,
 	}
+	go s.runScheduler(ctx)
 
-	redisClient := redis.NewClient(&redis.Options{
-		Addr: redisAddr,
-	})
-
-	jwtSecret := []byte(os.Getenv("JWT_SECRET"))
-	if len(jwtSecret) == 0 {
-		jwtSecret = []byte("secret")
-	}
-
-	return &chatsrvc{
-		redis:     redisClient,
-		jwtSecret: jwtSecret,
-	}
+	return s
 }
 
-func (s *chatsrvc) extractUserID(ctx context.Context, tokenString string) (string, error) {
-	claims := make(jwt.MapClaims)
-
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return s.jwtSecret, nil
-	})
-
-	if err != nil || !token.Valid {
-		return "", fmt.Errorf("invalid token: %w", err)
+// extractUserID returns the user of the token and the workspace it acts in.
+func (s *chatsrvc) extractUserID(ctx context.Context, tokenString string) (string, string, error) {
+	claims, err := security2.ValidToken(tokenString)
+	if err != nil {
+		return "", "", err
 	}
 
-	sub, ok := claims["sub"].(string)
-	if !ok {
-		return "", fmt.Errorf("invalid sub claim")
+	// Chat is only used on behalf of users, service principals are rejected.
+	authInfo := security2.AuthInfo{Claims: claims}
+	userID := authInfo.UserID()
+	if userID == "" {
+		return "", "", fmt.Errorf("token has no user subject")
 	}
 
-	return sub, nil
+	return userID, authInfo.WorkspaceID(), nil
 }
 
 func (s *chatsrvc) JWTAuth(ctx context.Context, tokenString string, scheme *security.JWTScheme) (context.Context, error) {
-	userID, err := s.extractUserID(ctx, tokenString)
+	userID, workspaceID, err := s.extractUserID(ctx, tokenString)
 	if err != nil {
 		log.Print(ctx, log.KV{"chat.jwt_auth", "ERROR: failed to extract user ID"}, log.KV{"error", err.Error()})
 		return ctx, chat.Unauthorized("invalid token")
 	}
 
 	ctx = context.WithValue(ctx, "user_id", userID)
+	// The token is forwarded to files to share the attachments of the user.
+	ctx = context.WithValue(ctx, "jwt_token", tokenString)
+	// Every key of the store is scoped to the workspace of the token.
+	ctx = context.WithValue(ctx, "workspace_id", workspaceID)
 
 	log.Info(ctx, log.KV{"chat.jwt_auth", fmt.Sprintf("authenticated user %s", userID)})
 	return ctx, nil
@@ -87,9 +88,20 @@ func (s *chatsrvc) JWTAuth(ctx context.Context, tokenString string, scheme *secu
 func (s *chatsrvc) CreateRoom(ctx context.Context, p *chat.CreateRoomPayload) (res string, err error) {
 	log.Info(ctx, log.KV{"chat.create_room", "creating new room"})
 
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return "", chat.Unauthorized("user not authenticated")
+	}
+
 	newRoomId := uuid.New().String()
-	if err := s.redis.SAdd(ctx, roomsKey, newRoomId).Err(); err != nil {
-		log.Print(ctx, log.KV{"chat.create_room", "ERROR: redis SAdd failed"}, log.KV{"error", err.Error()})
+	if err := s.store.CreateRoom(ctx, newRoomId); err != nil {
+		log.Print(ctx, log.KV{"chat.create_room", "ERROR: failed to create room"}, log.KV{"error", err.Error()})
+		return "", chat.Internal("Internal server error")
+	}
+	// The creator is a member of the room, which files checks before serving
+	// the attachments shared in it.
+	if err := s.store.AddMember(ctx, newRoomId, userID); err != nil {
+		log.Print(ctx, log.KV{"chat.create_room", "ERROR: failed to add member"}, log.KV{"error", err.Error()})
 		return "", chat.Internal("Internal server error")
 	}
 
@@ -98,26 +110,32 @@ func (s *chatsrvc) CreateRoom(ctx context.Context, p *chat.CreateRoomPayload) (r
 
 func (s *chatsrvc) History(ctx context.Context, p *chat.HistoryPayload) (res []*chat.Chat, err error) {
 	log.Info(ctx, log.KV{"chat.history", "retrieving chat history"})
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, chat.Unauthorized("user not authenticated")
+	}
+	if err := s.checkMember(ctx, "chat.history", p.RoomID, userID); err != nil {
+		return nil, err
+	}
 
-	histories, err := s.redis.LRange(ctx, fmt.Sprintf("%s:%s", historyKey, p.RoomID), 0, -1).Result()
+	res, err = s.store.History(ctx, p.RoomID)
 	if err != nil {
-		log.Print(ctx, log.KV{"chat.history", "ERROR: redis LRange failed"}, log.KV{"error", err.Error()})
+		log.Print(ctx, log.KV{"chat.history", "ERROR: failed to load history"}, log.KV{"error", err.Error()})
 		return nil, chat.Internal("Internal server error")
 	}
 
-	if len(histories) == 0 {
-		return make([]*chat.Chat, 0), nil
-	}
-
-	for _, historyJSON := range histories {
-		var chatMessage chat.Chat
-
-		if err := json.Unmarshal([]byte(historyJSON), &chatMessage); err != nil {
-			log.Print(ctx, log.KV{"chat.history", "ERROR: redis Unmarshal failed"}, log.KV{"error", err.Error()})
-			return nil, chat.Internal("Internal server error")
+	for _, chatMessage := range res {
+		// The stored poll is a snapshot taken when it was posted.
+		if chatMessage.Poll != nil {
+			poll, err := s.currentPoll(ctx, chatMessage.Poll.ID)
+			if err != nil {
+				log.Print(ctx, log.KV{"chat.history", "ERROR: failed to load poll"}, log.KV{"error", err.Error()})
+				return nil, chat.Internal("Internal server error")
+			}
+			if poll != nil {
+				chatMessage.Poll = poll
+			}
 		}
-
-		res = append(res, &chatMessage)
 	}
 
 	return res, nil
@@ -131,20 +149,34 @@ func (s *chatsrvc) StreamRoom(ctx context.Context, p *chat.StreamRoomPayload, st
 	if !ok {
 		return chat.Unauthorized("user not authenticated")
 	}
+	// Only members read and post in the room.
+	if err := s.checkMember(ctx, "chat.stream_room", p.RoomID, userID); err != nil {
+		return err
+	}
 
-	pubsubChannel := roomPublishKey + ":" + p.RoomID
-	pubsub := s.redis.Subscribe(ctx, pubsubChannel)
-	defer pubsub.Close()
+	sub, err := s.store.Subscribe(ctx, p.RoomID)
+	if err != nil {
+		log.Print(ctx, log.KV{"chat.stream_room", "ERROR: failed to subscribe to room"}, log.KV{"error", err.Error()})
+		return chat.Internal("Internal server error")
+	}
+	defer sub.Close()
 
-	msgCh := make(chan string)
-	errCh := make(chan error)
+	msgCh := make(chan *chat.MessageInput)
+	errCh := make(chan error, 2)
+
+	// Room messages and acks are sent from different goroutines.
+	var sendMu sync.Mutex
+	send := func(c *chat.Chat) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(c)
+	}
 
 	go func() {
 		defer close(msgCh)
-		defer close(errCh)
 
 		for {
-			str, err := stream.Recv()
+			input, err := stream.Recv()
 			if err == io.EOF {
 				log.Info(ctx, log.KV{"chat.stream_room", "client closed connection"})
 				return
@@ -155,20 +187,13 @@ func (s *chatsrvc) StreamRoom(ctx context.Context, p *chat.StreamRoomPayload, st
 				return
 			}
 
-			msgCh <- str
+			msgCh <- input
 		}
 	}()
 
 	go func() {
-		ch := pubsub.Channel()
-		for msg := range ch {
-			var newChat chat.Chat
-			if err := json.Unmarshal([]byte(msg.Payload), &newChat); err != nil {
-				log.Print(ctx, log.KV{"chat.stream_room", "ERROR: json.Unmarshal from pubsub failed"}, log.KV{"error", err.Error()})
-				continue
-			}
-
-			if err := stream.Send(&newChat); err != nil {
+		for newChat := range sub.Channel() {
+			if err := send(newChat); err != nil {
 				log.Print(ctx, log.KV{"chat.stream_room", "ERROR: stream.Send failed"}, log.KV{"error", err.Error()})
 				errCh <- err
 				return
@@ -178,34 +203,76 @@ func (s *chatsrvc) StreamRoom(ctx context.Context, p *chat.StreamRoomPayload, st
 
 	for done := false; !done; {
 		select {
-		case msg := <-msgCh:
-			if msg == "" {
+		case input, ok := <-msgCh:
+			if !ok {
+				// The client stopped sending, keep delivering room messages.
+				msgCh = nil
 				continue
 			}
+			var msg string
+			if input.Message != nil {
+				msg = *input.Message
+			}
+			if msg == "" && len(input.AttachmentIds) == 0 {
+				continue
+			}
+
+			clientID := input.ClientID
+			if clientID != nil && *clientID == "" {
+				clientID = nil
+			}
+
+			if len(input.AttachmentIds) > 0 {
+				if err := s.shareAttachments(ctx, p.RoomID, input.AttachmentIds); err != nil {
+					// Drop only this message, the stream stays open.
+					log.Print(ctx, log.KV{"chat.stream_room", "ERROR: message rejected"}, log.KV{"error", err.Error()})
+					continue
+				}
+			}
 
 			newChat := chat.Chat{
-				ID:        uuid.NewString(),
-				RoomID:    p.RoomID,
-				UserID:    userID,
-				Message:   msg,
-				CreatedAt: time.Now().Unix(),
-				UpdatedAt: time.Now().Unix(),
+				ID:            uuid.NewString(),
+				RoomID:        p.RoomID,
+				UserID:        userID,
+				Message:       msg,
+				AttachmentIds: input.AttachmentIds,
+				Kind:          kind(kindMessage),
+				ClientID:      clientID,
+				CreatedAt:     time.Now().Unix(),
+				UpdatedAt:     time.Now().Unix(),
 			}
 
-			chatJSON, err := json.Marshal(newChat)
-			if err != nil {
-				log.Print(ctx, log.KV{"chat.stream_room", "ERROR: json.Marshal failed"}, log.KV{"error", err.Error()})
-				return chat.Internal("Internal server error")
+			if newChat.ClientID != nil {
+				delivered, err := s.store.ClaimClientID(ctx, &newChat, dedupWindow)
+				if err != nil {
+					log.Print(ctx, log.KV{"chat.stream_room", "ERROR: failed to claim client id"}, log.KV{"error", err.Error()})
+					return chat.Internal("Internal server error")
+				}
+				if delivered != nil {
+					// A retry of a message that was already sent, only
+					// acknowledge it again.
+					if err := send(ack(delivered)); err != nil {
+						log.Print(ctx, log.KV{"chat.stream_room", "ERROR: stream.Send failed"}, log.KV{"error", err.Error()})
+						return chat.Internal("Internal server error")
+					}
+					continue
+				}
 			}
 
-			if err := s.redis.LPush(ctx, fmt.Sprintf("%s:%s", historyKey, p.RoomID), chatJSON).Err(); err != nil {
-				log.Print(ctx, log.KV{"chat.stream_room", "ERROR: redis LPush failed"}, log.KV{"error", err.Error()})
+			if err := s.store.Publish(ctx, &newChat); err != nil {
+				log.Print(ctx, log.KV{"chat.stream_room", "ERROR: publish failed"}, log.KV{"error", err.Error()})
+				if newChat.ClientID != nil {
+					// Let the client retry the failed message.
+					s.store.ReleaseClientID(ctx, &newChat)
+				}
 				return chat.Internal("Internal server error")
 			}
 
-			if err := s.redis.Publish(ctx, pubsubChannel, chatJSON).Err(); err != nil {
-				log.Print(ctx, log.KV{"chat.stream_room", "ERROR: redis Publish failed"}, log.KV{"error", err.Error()})
-				return chat.Internal("Internal server error")
+			if newChat.ClientID != nil {
+				if err := send(ack(&newChat)); err != nil {
+					log.Print(ctx, log.KV{"chat.stream_room", "ERROR: stream.Send failed"}, log.KV{"error", err.Error()})
+					return chat.Internal("Internal server error")
+				}
 			}
 
 		case err := <-errCh:
@@ -222,14 +289,63 @@ func (s *chatsrvc) StreamRoom(ctx context.Context, p *chat.StreamRoomPayload, st
 	return stream.Close()
 }
 
+// checkMember returns a permission-denied error unless the user is a member
+// of the room.
+func (s *chatsrvc) checkMember(ctx context.Context, op, roomID, userID string) error {
+	member, err := s.store.IsMember(ctx, roomID, userID)
+	if err != nil {
+		log.Print(ctx, log.KV{op, "ERROR: failed to check room membership"}, log.KV{"error", err.Error()})
+		return chat.Internal("Internal server error")
+	}
+	if !member {
+		return chat.PermissionDenied("user is not a member of the room")
+	}
+	return nil
+}
+
+// shareAttachments shares the attachments of a message in its room, which
+// files only allows for attachments uploaded by the sender.
+func (s *chatsrvc) shareAttachments(ctx context.Context, roomID string, ids []string) error {
+	if token, ok := ctx.Value("jwt_token").(string); ok {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	_, err := s.files.Share(ctx, &filespb.ShareRequest{RoomId: roomID, Ids: ids})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return chat.PermissionDenied("attachment not found")
+	default:
+		log.Print(ctx, log.KV{"chat.stream_room", "ERROR: failed to share attachments"}, log.KV{"error", err.Error()})
+		return chat.Internal("Internal server error")
+	}
+}
+
+// ack returns the acknowledgement of msg sent back to its sender.
+func ack(msg *chat.Chat) *chat.Chat {
+	return &chat.Chat{
+		ID:        msg.ID,
+		RoomID:    msg.RoomID,
+		UserID:    msg.UserID,
+		Kind:      kind(kindAck),
+		ClientID:  msg.ClientID,
+		CreatedAt: msg.CreatedAt,
+		UpdatedAt: msg.UpdatedAt,
+	}
+}
+
+func kind(k string) *string {
+	return &k
+}
+
 func (s *chatsrvc) RoomList(ctx context.Context, p *chat.RoomListPayload) (res []string, err error) {
 	log.Printf(ctx, "chat.room-list")
 	userID, ok := ctx.Value("user_id").(string)
 	if !ok {
 		return nil, chat.Unauthorized("user not authenticated")
 	}
-	roomListKey := "rooms:" + userID
-	res, err = s.redis.LRange(ctx, roomListKey, 0, -1).Result()
+	res, err = s.store.UserRooms(ctx, userID)
 	if err != nil {
 		return nil, chat.Internal("Internal server error")
 	}
@@ -243,14 +359,15 @@ func (s *chatsrvc) JoinRoom(ctx context.Context, p *chat.JoinRoomPayload) (res s
 	if !ok {
 		return "", chat.Unauthorized("user not authenticated")
 	}
-	inviteKey := "invite:" + p.InviteKey + ":" + userID
-	roomListKey := "rooms:" + userID
-	inviteRoom := s.redis.Get(ctx, inviteKey).Val()
+	inviteRoom, err := s.store.InvitedRoom(ctx, p.InviteKey, userID)
+	if err != nil {
+		return "", chat.Internal("Internal server error")
+	}
 	if inviteRoom == "" {
 		return "", chat.Notfound("user not invited")
 	}
 
-	if s.redis.LPush(ctx, roomListKey, inviteRoom).Err() != nil {
+	if s.store.AddMember(ctx, inviteRoom, userID) != nil {
 		return "", chat.Internal("Internal server error")
 	}
 
@@ -259,14 +376,17 @@ func (s *chatsrvc) JoinRoom(ctx context.Context, p *chat.JoinRoomPayload) (res s
 
 func (s *chatsrvc) InviteRoom(ctx context.Context, p *chat.InviteRoomPayload) (res string, err error) {
 	log.Printf(ctx, "chat.invite-room")
-	_, ok := ctx.Value("user_id").(string)
+	userID, ok := ctx.Value("user_id").(string)
 	if !ok {
 		return "", chat.Unauthorized("user not authenticated")
 	}
+	// Members invite, or anyone could invite themselves.
+	if err := s.checkMember(ctx, "chat.invite_room", p.RoomID, userID); err != nil {
+		return "", err
+	}
 
 	newInviteId := uuid.NewString()
-	inviteKey := "invite:" + newInviteId + ":" + p.UserID
-	if s.redis.Set(ctx, inviteKey, p.RoomID, 0).Err() != nil {
+	if s.store.SaveInvite(ctx, newInviteId, p.UserID, p.RoomID) != nil {
 		return "", chat.Internal("Internal server error")
 	}
 
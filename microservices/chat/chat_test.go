@@ -0,0 +1,324 @@
+package chatapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+// newTestService returns the chat service backed by the memory store, without
+// its scheduler loop.
+func newTestService(t *testing.T) *chatsrvc {
+	t.Helper()
+	return &chatsrvc{store: NewMemoryStore(), files: &testFiles{}}
+}
+
+// userContext returns the context of a request of the user in the workspace.
+func userContext(userID, workspaceID string) context.Context {
+	ctx := context.WithValue(context.Background(), "user_id", userID)
+	return context.WithValue(ctx, "workspace_id", workspaceID)
+}
+
+// errorName returns the name of the goa error, empty for other errors.
+func errorName(err error) string {
+	var namer goa.GoaErrorNamer
+	if errors.As(err, &namer) {
+		return namer.GoaErrorName()
+	}
+	return ""
+}
+
+// testFiles is a files client sharing the attachments it knows the uploader
+// of.
+type testFiles struct {
+	filespb.FilesClient
+	uploaders map[string]string
+	shared    []*filespb.ShareRequest
+}
+
+func (f *testFiles) Share(ctx context.Context, in *filespb.ShareRequest, opts ...grpc.CallOption) (*filespb.ShareResponse, error) {
+	for _, id := range in.Ids {
+		if f.uploaders[id] == "" {
+			return nil, status.Error(codes.NotFound, "attachment not found")
+		}
+	}
+	f.shared = append(f.shared, in)
+	return &filespb.ShareResponse{}, nil
+}
+
+// testStream is a stream-room stream fed by the test.
+type testStream struct {
+	in  chan *chat.MessageInput
+	out chan *chat.Chat
+}
+
+func newTestStream() *testStream {
+	return &testStream{in: make(chan *chat.MessageInput), out: make(chan *chat.Chat, 10)}
+}
+
+func (s *testStream) Send(c *chat.Chat) error {
+	s.out <- c
+	return nil
+}
+
+func (s *testStream) SendWithContext(ctx context.Context, c *chat.Chat) error {
+	return s.Send(c)
+}
+
+func (s *testStream) Recv() (*chat.MessageInput, error) {
+	input, ok := <-s.in
+	if !ok {
+		return nil, io.EOF
+	}
+	return input, nil
+}
+
+func (s *testStream) RecvWithContext(ctx context.Context) (*chat.MessageInput, error) {
+	return s.Recv()
+}
+
+func (s *testStream) Close() error {
+	return nil
+}
+
+// receive returns the next event sent on the stream.
+func (s *testStream) receive(t *testing.T) *chat.Chat {
+	t.Helper()
+	select {
+	case c := <-s.out:
+		return c
+	case <-time.After(time.Second):
+		t.Fatal("no event sent on the stream")
+		return nil
+	}
+}
+
+// streamRoom runs StreamRoom on the stream until the test ends and returns
+// the channel of its result.
+func streamRoom(t *testing.T, s *chatsrvc, ctx context.Context, roomID string, stream *testStream) <-chan error {
+	t.Helper()
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	finished := make(chan struct{})
+	go func() {
+		done <- s.StreamRoom(ctx, &chat.StreamRoomPayload{RoomID: roomID}, stream)
+		close(finished)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-finished
+	})
+	return done
+}
+
+// joinRoom makes the user of ctx a member of the room.
+func joinRoom(t *testing.T, s *chatsrvc, ctx context.Context, roomID string) {
+	t.Helper()
+	if err := s.store.AddMember(ctx, roomID, ctx.Value("user_id").(string)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestStreamPublishesToHistory(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	roomID, err := s.CreateRoom(ctx, &chat.CreateRoomPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream := newTestStream()
+	streamRoom(t, s, ctx, roomID, stream)
+
+	stream.in <- &chat.MessageInput{Message: ptr("hello"), ClientID: ptr("c-1")}
+	kinds := map[string]*chat.Chat{}
+	for range 2 {
+		event := stream.receive(t)
+		kinds[*event.Kind] = event
+	}
+	msg, ack := kinds[kindMessage], kinds[kindAck]
+	if msg == nil || ack == nil {
+		t.Fatalf("events = %v, want the message and its ack", kinds)
+	}
+	if msg.Message != "hello" || msg.UserID != "alice" || msg.RoomID != roomID {
+		t.Errorf("message = %+v", msg)
+	}
+	if ack.ID != msg.ID || *ack.ClientID != "c-1" {
+		t.Errorf("ack = %+v, want the ack of message %s", ack, msg.ID)
+	}
+
+	// A retry of the message is only acknowledged again.
+	stream.in <- &chat.MessageInput{Message: ptr("hello"), ClientID: ptr("c-1")}
+	if retried := stream.receive(t); *retried.Kind != kindAck || retried.ID != msg.ID {
+		t.Errorf("retry = %+v, want the ack of message %s", retried, msg.ID)
+	}
+
+	stream.in <- &chat.MessageInput{Message: ptr("world")}
+	stream.receive(t)
+
+	history, err := s.History(ctx, &chat.HistoryPayload{RoomID: roomID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("history has %d messages, want 2", len(history))
+	}
+	// The newest message comes first.
+	if history[0].Message != "world" || history[1].Message != "hello" {
+		t.Errorf("history = %q, %q, want world, hello", history[0].Message, history[1].Message)
+	}
+}
+
+func TestStreamSharesAttachments(t *testing.T) {
+	s := newTestService(t)
+	files := &testFiles{uploaders: map[string]string{"a-1": "alice"}}
+	s.files = files
+	ctx := userContext("alice", "")
+	joinRoom(t, s, ctx, "room-1")
+
+	stream := newTestStream()
+	streamRoom(t, s, ctx, "room-1", stream)
+
+	stream.in <- &chat.MessageInput{AttachmentIds: []string{"a-1"}}
+	if msg := stream.receive(t); len(msg.AttachmentIds) != 1 || msg.AttachmentIds[0] != "a-1" {
+		t.Errorf("message attachments = %v, want a-1", msg.AttachmentIds)
+	}
+	if len(files.shared) != 1 || files.shared[0].RoomId != "room-1" {
+		t.Errorf("shared = %v, want a-1 in room-1", files.shared)
+	}
+}
+
+func TestStreamRejectsUnknownAttachments(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	joinRoom(t, s, ctx, "room-1")
+
+	stream := newTestStream()
+	done := streamRoom(t, s, ctx, "room-1", stream)
+
+	stream.in <- &chat.MessageInput{Message: ptr("look"), AttachmentIds: []string{"someone-elses"}}
+	// The stream stays open for the next message.
+	stream.in <- &chat.MessageInput{Message: ptr("hello")}
+	if got := stream.receive(t); got.Message != "hello" {
+		t.Errorf("received %q, want hello", got.Message)
+	}
+	select {
+	case err := <-done:
+		t.Fatalf("stream closed: %v", err)
+	default:
+	}
+
+	history, err := s.History(ctx, &chat.HistoryPayload{RoomID: "room-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Message != "hello" {
+		t.Errorf("history = %v, want only hello", history)
+	}
+}
+
+func TestHistoryScopedToWorkspace(t *testing.T) {
+	s := newTestService(t)
+	msg := &chat.Chat{ID: "m-1", RoomID: "room-1", UserID: "alice", Message: "hello", Kind: ptr(kindMessage)}
+	if err := s.store.Publish(userContext("alice", "w-1"), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	for workspaceID, want := range map[string]int{"w-1": 1, "w-2": 0, "": 0} {
+		ctx := userContext("alice", workspaceID)
+		joinRoom(t, s, ctx, "room-1")
+		history, err := s.History(ctx, &chat.HistoryPayload{RoomID: "room-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != want {
+			t.Errorf("workspace %q: history has %d messages, want %d", workspaceID, len(history), want)
+		}
+	}
+}
+
+func TestCreateRoomAddsCreator(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	roomID, err := s.CreateRoom(ctx, &chat.CreateRoomPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rooms, err := s.RoomList(ctx, &chat.RoomListPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rooms) != 1 || rooms[0] != roomID {
+		t.Errorf("rooms = %v, want %s", rooms, roomID)
+	}
+}
+
+func TestRoomRequiresMembership(t *testing.T) {
+	s := newTestService(t)
+	alice, bob := userContext("alice", ""), userContext("bob", "")
+	roomID, err := s.CreateRoom(alice, &chat.CreateRoomPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Membership is scoped to the workspace of the room.
+	if _, err := s.History(userContext("alice", "w-1"), &chat.HistoryPayload{RoomID: roomID}); errorName(err) != "permission-denied" {
+		t.Errorf("history from another workspace: err = %v, want permission-denied", err)
+	}
+
+	calls := map[string]func() error{
+		"history": func() error {
+			_, err := s.History(bob, &chat.HistoryPayload{RoomID: roomID})
+			return err
+		},
+		"stream-room": func() error {
+			return s.StreamRoom(bob, &chat.StreamRoomPayload{RoomID: roomID}, newTestStream())
+		},
+		"schedule-message": func() error {
+			_, err := s.ScheduleMessage(bob, &chat.ScheduleMessagePayload{RoomID: roomID, Message: "hi", SendAt: time.Now().Add(time.Hour).Unix()})
+			return err
+		},
+		"create-poll": func() error {
+			_, err := s.CreatePoll(bob, &chat.CreatePollPayload{RoomID: roomID, Question: "q", Options: []string{"a", "b"}})
+			return err
+		},
+		"invite-room": func() error {
+			_, err := s.InviteRoom(bob, &chat.InviteRoomPayload{RoomID: roomID, UserID: "bob"})
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); errorName(err) != "permission-denied" {
+			t.Errorf("%s by a non-member: err = %v, want permission-denied", name, err)
+		}
+	}
+
+	invite, err := s.InviteRoom(alice, &chat.InviteRoomPayload{RoomID: roomID, UserID: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.JoinRoom(bob, &chat.JoinRoomPayload{InviteKey: invite}); err != nil {
+		t.Fatal(err)
+	}
+	for name, call := range calls {
+		if name == "stream-room" {
+			continue
+		}
+		if err := call(); err != nil {
+			t.Errorf("%s by a member: %v", name, err)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	goa "goa.design/goa/v3/pkg"
+	"object-t.com/hackz-giganoto/pkg/cliauth"
 )
 
 func main() {
@@ -24,6 +25,15 @@ func main() {
 	flag.Usage = usage
 	flag.Parse()
 
+	handled, err := cliauth.Handle()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	if handled {
+		return
+	}
+
 	var (
 		addr    string
 		timeout int
@@ -61,7 +71,6 @@ func main() {
 	var (
 		endpoint goa.Endpoint
 		payload  any
-		err      error
 	)
 	{
 		switch scheme {
@@ -105,6 +114,8 @@ Usage:
     -verbose|-v: print request and response details (false)
 
 Commands:
+    login [-provider PROVIDER]: log in with the device flow (github)
+    logout: remove the stored credentials
 %s
 Additional help:
     %s SERVICE [ENDPOINT] --help
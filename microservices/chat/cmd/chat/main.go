@@ -13,10 +13,14 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/redis/go-redis/v9"
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	chatapi "object-t.com/hackz-giganoto/microservices/chat"
 	chat "object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
 )
 
 func main() {
@@ -68,7 +72,7 @@ func main() {
 		chatSvc chat.Service
 	)
 	{
-		chatSvc = chatapi.NewChat()
+		chatSvc = chatapi.NewChat(ctx, newStore(), newFilesClient(ctx))
 	}
 
 	// Wrap the services in endpoints that can be invoked from other services
@@ -137,3 +141,41 @@ func main() {
 	wg.Wait()
 	log.Printf(ctx, "exited")
 }
+
+// newStore returns the chat store selected by CHAT_STORE, Redis unless it is
+// set to "memory".
+func newStore() chatapi.ChatStore {
+	if os.Getenv("CHAT_STORE") == "memory" {
+		return chatapi.NewMemoryStore()
+	}
+
+	redisAddr := os.Getenv("REDIS_ADDR")
+	if redisAddr == "" {
+		redisAddr = "localhost:6379"
+	}
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: redisAddr,
+	})
+
+	return chatapi.NewRedisStore(redisClient)
+}
+
+// newFilesClient returns the client of the files service at FILES_SERVICE_ADDR.
+func newFilesClient(ctx context.Context) filespb.FilesClient {
+	filesAddr := os.Getenv("FILES_SERVICE_ADDR")
+	if filesAddr == "" {
+		filesAddr = "localhost:50054"
+	}
+
+	conn, err := grpc.NewClient(
+		filesAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		telemetry.GRPCClientInterceptor(),
+	)
+	if err != nil {
+		log.Fatalf(ctx, err, "failed to connect to files service")
+	}
+
+	return filespb.NewFilesClient(conn)
+}
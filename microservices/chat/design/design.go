@@ -17,9 +17,58 @@ var Chat = Type("Chat", func() {
 	Field(4, "created_at", Int64, "Created timestamp")
 	Field(5, "updated_at", Int64, "Updated timestamp")
 	Field(6, "room_id", String, "room")
+	Field(7, "attachment_ids", ArrayOf(String), "IDs of the attached files")
+	Field(8, "poll", Poll, "Poll posted with the message")
+	Field(9, "kind", String, "Event kind", func() {
+		Enum("message", "poll", "poll_update", "ack")
+	})
+	Field(10, "client_id", String, "Client generated ID of the message being sent or acknowledged")
 	Required("user_id", "message", "id", "created_at", "updated_at", "room_id")
 })
 
+var PollOption = Type("PollOption", func() {
+	Description("Poll option with its current tally")
+
+	Field(1, "index", Int, "Option index")
+	Field(2, "text", String, "Option text")
+	Field(3, "votes", Int, "Number of votes")
+	Field(4, "voter_ids", ArrayOf(String), "Users who voted for the option, empty for anonymous polls")
+	Required("index", "text", "votes")
+})
+
+var Poll = Type("Poll", func() {
+	Description("Poll posted in a chat room")
+
+	Field(1, "id", String, "Poll ID")
+	Field(2, "question", String, "Poll question")
+	Field(3, "options", ArrayOf(PollOption), "Poll options")
+	Field(4, "multiple_choice", Boolean, "Whether users may vote for several options")
+	Field(5, "anonymous", Boolean, "Whether voters are hidden")
+	Field(6, "closes_at", Int64, "Closing timestamp")
+	Field(7, "closed", Boolean, "Whether the poll is closed")
+	Required("id", "question", "options", "multiple_choice", "anonymous", "closed")
+})
+
+var MessageInput = Type("MessageInput", func() {
+	Description("Message sent on a chat room stream")
+
+	Field(1, "message", String, "Message content")
+	Field(2, "attachment_ids", ArrayOf(String), "IDs of files uploaded to the files service")
+	Field(3, "client_id", String, "Client generated ID used to deduplicate retries")
+})
+
+var ScheduledMessage = Type("ScheduledMessage", func() {
+	Description("Chat message waiting for delivery")
+
+	Field(1, "id", String, "ID")
+	Field(2, "room_id", String, "room")
+	Field(3, "user_id", String, "user_id")
+	Field(4, "message", String, "Message content")
+	Field(5, "send_at", Int64, "Delivery timestamp")
+	Field(6, "created_at", Int64, "Created timestamp")
+	Required("id", "room_id", "user_id", "message", "send_at", "created_at")
+})
+
 var _ = API("chat", func() {
 	Title("Chat Service")
 	Description("Real-time chat service")
@@ -151,6 +200,7 @@ var _ = Service("chat", func() {
 		GRPC(func() {
 			Response(CodeOK)
 			Response("invalid_argument", CodeInvalidArgument)
+			Response("permission-denied", CodePermissionDenied)
 		})
 	})
 
@@ -167,7 +217,7 @@ var _ = Service("chat", func() {
 			Required("token", "room_id")
 		})
 
-		StreamingPayload(String)
+		StreamingPayload(MessageInput)
 		StreamingResult(Chat)
 
 		GRPC(func() {
@@ -176,4 +226,133 @@ var _ = Service("chat", func() {
 			Response("permission-denied", CodePermissionDenied)
 		})
 	})
+	Method("schedule-message", func() {
+		Description("Schedules a message to be sent to a chat room later")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "The access token")
+			Field(1, "room_id", String, "The id of the room")
+			Field(2, "message", String, "Message content")
+			Field(3, "send_at", Int64, "Delivery timestamp")
+			Required("token", "room_id", "message", "send_at")
+		})
+
+		Result(ScheduledMessage)
+
+		Error("invalid_argument", String)
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_argument", CodeInvalidArgument)
+			Response("permission-denied", CodePermissionDenied)
+			Response("internal", CodeInternal)
+		})
+	})
+
+	Method("list-scheduled", func() {
+		Description("Lists the messages scheduled by the user")
+
+		Security(JWTAuth, func() {
+			Scope("api:read")
+		})
+
+		Payload(func() {
+			Token("token", String, "The access token")
+			Field(1, "room_id", String, "Only list messages for this room")
+			Required("token")
+		})
+
+		Result(ArrayOf(ScheduledMessage))
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("internal", CodeInternal)
+		})
+	})
+
+	Method("cancel-scheduled", func() {
+		Description("Cancels a scheduled message before it is sent")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "The access token")
+			Field(1, "id", String, "The id of the scheduled message")
+			Required("token", "id")
+		})
+
+		Result(String)
+
+		Error("notfound", String)
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("notfound", CodeNotFound)
+			Response("permission-denied", CodePermissionDenied)
+			Response("internal", CodeInternal)
+		})
+	})
+	Method("create-poll", func() {
+		Description("Posts a poll in a chat room")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "The access token")
+			Field(1, "room_id", String, "The id of the room")
+			Field(2, "question", String, "Poll question")
+			Field(3, "options", ArrayOf(String), "Poll options")
+			Field(4, "multiple_choice", Boolean, "Whether users may vote for several options")
+			Field(5, "anonymous", Boolean, "Whether voters are hidden")
+			Field(6, "closes_at", Int64, "Closing timestamp")
+			Required("token", "room_id", "question", "options")
+		})
+
+		Result(Chat)
+
+		Error("invalid_argument", String)
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_argument", CodeInvalidArgument)
+			Response("permission-denied", CodePermissionDenied)
+			Response("internal", CodeInternal)
+		})
+	})
+
+	Method("vote", func() {
+		Description("Votes on a poll")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "The access token")
+			Field(1, "poll_id", String, "The id of the poll")
+			Field(2, "options", ArrayOf(Int), "Indexes of the chosen options")
+			Required("token", "poll_id", "options")
+		})
+
+		Result(Poll)
+
+		Error("notfound", String)
+		Error("invalid_argument", String)
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("notfound", CodeNotFound)
+			Response("invalid_argument", CodeInvalidArgument)
+			Response("permission-denied", CodePermissionDenied)
+			Response("internal", CodeInternal)
+		})
+	})
 })
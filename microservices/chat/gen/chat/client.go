@@ -15,23 +15,33 @@ import (
 
 // Client is the "chat" service client.
 type Client struct {
-	CreateRoomEndpoint goa.Endpoint
-	HistoryEndpoint    goa.Endpoint
-	RoomListEndpoint   goa.Endpoint
-	JoinRoomEndpoint   goa.Endpoint
-	InviteRoomEndpoint goa.Endpoint
-	StreamRoomEndpoint goa.Endpoint
+	CreateRoomEndpoint      goa.Endpoint
+	HistoryEndpoint         goa.Endpoint
+	RoomListEndpoint        goa.Endpoint
+	JoinRoomEndpoint        goa.Endpoint
+	InviteRoomEndpoint      goa.Endpoint
+	StreamRoomEndpoint      goa.Endpoint
+	ScheduleMessageEndpoint goa.Endpoint
+	ListScheduledEndpoint   goa.Endpoint
+	CancelScheduledEndpoint goa.Endpoint
+	CreatePollEndpoint      goa.Endpoint
+	VoteEndpoint            goa.Endpoint
 }
 
 // NewClient initializes a "chat" service client given the endpoints.
-func NewClient(createRoom, history, roomList, joinRoom, inviteRoom, streamRoom goa.Endpoint) *Client {
+func NewClient(createRoom, history, roomList, joinRoom, inviteRoom, streamRoom, scheduleMessage, listScheduled, cancelScheduled, createPoll, vote goa.Endpoint) *Client {
 	return &Client{
-		CreateRoomEndpoint: createRoom,
-		HistoryEndpoint:    history,
-		RoomListEndpoint:   roomList,
-		JoinRoomEndpoint:   joinRoom,
-		InviteRoomEndpoint: inviteRoom,
-		StreamRoomEndpoint: streamRoom,
+		CreateRoomEndpoint:      createRoom,
+		HistoryEndpoint:         history,
+		RoomListEndpoint:        roomList,
+		JoinRoomEndpoint:        joinRoom,
+		InviteRoomEndpoint:      inviteRoom,
+		StreamRoomEndpoint:      streamRoom,
+		ScheduleMessageEndpoint: scheduleMessage,
+		ListScheduledEndpoint:   listScheduled,
+		CancelScheduledEndpoint: cancelScheduled,
+		CreatePollEndpoint:      createPoll,
+		VoteEndpoint:            vote,
 	}
 }
 
@@ -126,3 +136,83 @@ func (c *Client) StreamRoom(ctx context.Context, p *StreamRoomPayload) (res Stre
 	}
 	return ires.(StreamRoomClientStream), nil
 }
+
+// ScheduleMessage calls the "schedule-message" endpoint of the "chat" service.
+// ScheduleMessage may return the following errors:
+//   - "invalid_argument" (type InvalidArgument)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission-denied" (type PermissionDenied)
+//   - "internal" (type Internal)
+//   - error: internal error
+func (c *Client) ScheduleMessage(ctx context.Context, p *ScheduleMessagePayload) (res *ScheduledMessage, err error) {
+	var ires any
+	ires, err = c.ScheduleMessageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ScheduledMessage), nil
+}
+
+// ListScheduled calls the "list-scheduled" endpoint of the "chat" service.
+// ListScheduled may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "permission-denied" (type PermissionDenied)
+//   - "internal" (type Internal)
+//   - error: internal error
+func (c *Client) ListScheduled(ctx context.Context, p *ListScheduledPayload) (res []*ScheduledMessage, err error) {
+	var ires any
+	ires, err = c.ListScheduledEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*ScheduledMessage), nil
+}
+
+// CancelScheduled calls the "cancel-scheduled" endpoint of the "chat" service.
+// CancelScheduled may return the following errors:
+//   - "notfound" (type Notfound)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission-denied" (type PermissionDenied)
+//   - "internal" (type Internal)
+//   - error: internal error
+func (c *Client) CancelScheduled(ctx context.Context, p *CancelScheduledPayload) (res string, err error) {
+	var ires any
+	ires, err = c.CancelScheduledEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(string), nil
+}
+
+// CreatePoll calls the "create-poll" endpoint of the "chat" service.
+// CreatePoll may return the following errors:
+//   - "invalid_argument" (type InvalidArgument)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission-denied" (type PermissionDenied)
+//   - "internal" (type Internal)
+//   - error: internal error
+func (c *Client) CreatePoll(ctx context.Context, p *CreatePollPayload) (res *Chat, err error) {
+	var ires any
+	ires, err = c.CreatePollEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Chat), nil
+}
+
+// Vote calls the "vote" endpoint of the "chat" service.
+// Vote may return the following errors:
+//   - "notfound" (type Notfound)
+//   - "invalid_argument" (type InvalidArgument)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission-denied" (type PermissionDenied)
+//   - "internal" (type Internal)
+//   - error: internal error
+func (c *Client) Vote(ctx context.Context, p *VotePayload) (res *Poll, err error) {
+	var ires any
+	ires, err = c.VoteEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Poll), nil
+}
@@ -27,6 +27,16 @@ type Service interface {
 	InviteRoom(context.Context, *InviteRoomPayload) (res string, err error)
 	// Streams chat room events on a chat room
 	StreamRoom(context.Context, *StreamRoomPayload, StreamRoomServerStream) (err error)
+	// Schedules a message to be sent to a chat room later
+	ScheduleMessage(context.Context, *ScheduleMessagePayload) (res *ScheduledMessage, err error)
+	// Lists the messages scheduled by the user
+	ListScheduled(context.Context, *ListScheduledPayload) (res []*ScheduledMessage, err error)
+	// Cancels a scheduled message before it is sent
+	CancelScheduled(context.Context, *CancelScheduledPayload) (res string, err error)
+	// Posts a poll in a chat room
+	CreatePoll(context.Context, *CreatePollPayload) (res *Chat, err error)
+	// Votes on a poll
+	Vote(context.Context, *VotePayload) (res *Poll, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -49,7 +59,7 @@ const ServiceName = "chat"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [6]string{"create-room", "history", "room-list", "join-room", "invite-room", "stream-room"}
+var MethodNames = [11]string{"create-room", "history", "room-list", "join-room", "invite-room", "stream-room", "schedule-message", "list-scheduled", "cancel-scheduled", "create-poll", "vote"}
 
 // StreamRoomServerStream is the interface a "stream-room" endpoint server
 // stream must satisfy.
@@ -58,10 +68,11 @@ type StreamRoomServerStream interface {
 	Send(*Chat) error
 	// SendWithContext streams instances of "Chat" with context.
 	SendWithContext(context.Context, *Chat) error
-	// Recv reads instances of "string" from the stream.
-	Recv() (string, error)
-	// RecvWithContext reads instances of "string" from the stream with context.
-	RecvWithContext(context.Context) (string, error)
+	// Recv reads instances of "MessageInput" from the stream.
+	Recv() (*MessageInput, error)
+	// RecvWithContext reads instances of "MessageInput" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*MessageInput, error)
 	// Close closes the stream.
 	Close() error
 }
@@ -69,10 +80,10 @@ type StreamRoomServerStream interface {
 // StreamRoomClientStream is the interface a "stream-room" endpoint client
 // stream must satisfy.
 type StreamRoomClientStream interface {
-	// Send streams instances of "string".
-	Send(string) error
-	// SendWithContext streams instances of "string" with context.
-	SendWithContext(context.Context, string) error
+	// Send streams instances of "MessageInput".
+	Send(*MessageInput) error
+	// SendWithContext streams instances of "MessageInput" with context.
+	SendWithContext(context.Context, *MessageInput) error
 	// Recv reads instances of "Chat" from the stream.
 	Recv() (*Chat, error)
 	// RecvWithContext reads instances of "Chat" from the stream with context.
@@ -81,6 +92,15 @@ type StreamRoomClientStream interface {
 	Close() error
 }
 
+// CancelScheduledPayload is the payload type of the chat service
+// cancel-scheduled method.
+type CancelScheduledPayload struct {
+	// The access token
+	Token string
+	// The id of the scheduled message
+	ID string
+}
+
 // Chat is the result type of the chat service stream-room method.
 type Chat struct {
 	// user_id
@@ -95,6 +115,32 @@ type Chat struct {
 	UpdatedAt int64
 	// room
 	RoomID string
+	// IDs of the attached files
+	AttachmentIds []string
+	// Poll posted with the message
+	Poll *Poll
+	// Event kind
+	Kind *string
+	// Client generated ID of the message being sent or acknowledged
+	ClientID *string
+}
+
+// CreatePollPayload is the payload type of the chat service create-poll method.
+type CreatePollPayload struct {
+	// The access token
+	Token string
+	// The id of the room
+	RoomID string
+	// Poll question
+	Question string
+	// Poll options
+	Options []string
+	// Whether users may vote for several options
+	MultipleChoice *bool
+	// Whether voters are hidden
+	Anonymous *bool
+	// Closing timestamp
+	ClosesAt *int64
 }
 
 // CreateRoomPayload is the payload type of the chat service create-room method.
@@ -129,12 +175,92 @@ type JoinRoomPayload struct {
 	InviteKey string
 }
 
+// ListScheduledPayload is the payload type of the chat service list-scheduled
+// method.
+type ListScheduledPayload struct {
+	// The access token
+	Token string
+	// Only list messages for this room
+	RoomID *string
+}
+
+// MessageInput is the streaming payload type of the chat service stream-room
+// method.
+type MessageInput struct {
+	// Message content
+	Message *string
+	// IDs of files uploaded to the files service
+	AttachmentIds []string
+	// Client generated ID used to deduplicate retries
+	ClientID *string
+}
+
+// Poll is the result type of the chat service vote method.
+type Poll struct {
+	// Poll ID
+	ID string
+	// Poll question
+	Question string
+	// Poll options
+	Options []*PollOption
+	// Whether users may vote for several options
+	MultipleChoice bool
+	// Whether voters are hidden
+	Anonymous bool
+	// Closing timestamp
+	ClosesAt *int64
+	// Whether the poll is closed
+	Closed bool
+}
+
+// Poll option with its current tally
+type PollOption struct {
+	// Option index
+	Index int
+	// Option text
+	Text string
+	// Number of votes
+	Votes int
+	// Users who voted for the option, empty for anonymous polls
+	VoterIds []string
+}
+
 // RoomListPayload is the payload type of the chat service room-list method.
 type RoomListPayload struct {
 	// The access token
 	Token string
 }
 
+// ScheduleMessagePayload is the payload type of the chat service
+// schedule-message method.
+type ScheduleMessagePayload struct {
+	// The access token
+	Token string
+	// The id of the room
+	RoomID string
+	// Message content
+	Message string
+	// Delivery timestamp
+	SendAt int64
+}
+
+// ScheduledMessage is the result type of the chat service schedule-message
+// method.
+type ScheduledMessage struct {
+	// ID
+	ID string
+	// room
+	RoomID string
+	// user_id
+	UserID string
+	// Message content
+	Message string
+	// Delivery timestamp
+	SendAt int64
+	// Created timestamp
+	CreatedAt int64
+}
+
 // StreamRoomPayload is the payload type of the chat service stream-room method.
 type StreamRoomPayload struct {
 	// The access token
@@ -143,6 +269,16 @@ type StreamRoomPayload struct {
 	RoomID string
 }
 
+// VotePayload is the payload type of the chat service vote method.
+type VotePayload struct {
+	// The access token
+	Token string
+	// The id of the poll
+	PollID string
+	// Indexes of the chosen options
+	Options []int
+}
+
 type Internal string
 
 type InvalidArgument string
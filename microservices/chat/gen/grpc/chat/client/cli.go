@@ -37,7 +37,7 @@ func BuildHistoryPayload(chatHistoryMessage string, chatHistoryToken string) (*c
 		if chatHistoryMessage != "" {
 			err = json.Unmarshal([]byte(chatHistoryMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Rerum sapiente reiciendis nobis et voluptatem.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Natus dolores accusamus non dolorem veritatis.\"\n   }'")
 			}
 		}
 	}
@@ -75,7 +75,7 @@ func BuildJoinRoomPayload(chatJoinRoomMessage string, chatJoinRoomToken string)
 		if chatJoinRoomMessage != "" {
 			err = json.Unmarshal([]byte(chatJoinRoomMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"invite_key\": \"Enim voluptas.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"invite_key\": \"Omnis voluptatem esse commodi aperiam ea ipsum.\"\n   }'")
 			}
 		}
 	}
@@ -100,7 +100,7 @@ func BuildInviteRoomPayload(chatInviteRoomMessage string, chatInviteRoomToken st
 		if chatInviteRoomMessage != "" {
 			err = json.Unmarshal([]byte(chatInviteRoomMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Sed ipsam.\",\n      \"user_id\": \"Culpa laudantium qui esse non dolorem et.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Ut laboriosam.\",\n      \"user_id\": \"Optio ut.\"\n   }'")
 			}
 		}
 	}
@@ -134,3 +134,146 @@ func BuildStreamRoomPayload(chatStreamRoomToken string, chatStreamRoomRoomID str
 
 	return v, nil
 }
+
+// BuildScheduleMessagePayload builds the payload for the chat schedule-message
+// endpoint from CLI flags.
+func BuildScheduleMessagePayload(chatScheduleMessageMessage string, chatScheduleMessageToken string) (*chat.ScheduleMessagePayload, error) {
+	var err error
+	var message chatpb.ScheduleMessageRequest
+	{
+		if chatScheduleMessageMessage != "" {
+			err = json.Unmarshal([]byte(chatScheduleMessageMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"message\": \"Sequi dolores placeat ad.\",\n      \"room_id\": \"Omnis corrupti non ratione molestias.\",\n      \"send_at\": 7347101748433722016\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = chatScheduleMessageToken
+	}
+	v := &chat.ScheduleMessagePayload{
+		RoomID:  message.RoomId,
+		Message: message.Message_,
+		SendAt:  message.SendAt,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListScheduledPayload builds the payload for the chat list-scheduled
+// endpoint from CLI flags.
+func BuildListScheduledPayload(chatListScheduledMessage string, chatListScheduledToken string) (*chat.ListScheduledPayload, error) {
+	var err error
+	var message chatpb.ListScheduledRequest
+	{
+		if chatListScheduledMessage != "" {
+			err = json.Unmarshal([]byte(chatListScheduledMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"room_id\": \"Nihil molestias unde voluptas.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = chatListScheduledToken
+	}
+	v := &chat.ListScheduledPayload{
+		RoomID: message.RoomId,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCancelScheduledPayload builds the payload for the chat cancel-scheduled
+// endpoint from CLI flags.
+func BuildCancelScheduledPayload(chatCancelScheduledMessage string, chatCancelScheduledToken string) (*chat.CancelScheduledPayload, error) {
+	var err error
+	var message chatpb.CancelScheduledRequest
+	{
+		if chatCancelScheduledMessage != "" {
+			err = json.Unmarshal([]byte(chatCancelScheduledMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Perspiciatis optio beatae qui quae unde vel.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = chatCancelScheduledToken
+	}
+	v := &chat.CancelScheduledPayload{
+		ID: message.Id,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreatePollPayload builds the payload for the chat create-poll endpoint
+// from CLI flags.
+func BuildCreatePollPayload(chatCreatePollMessage string, chatCreatePollToken string) (*chat.CreatePollPayload, error) {
+	var err error
+	var message chatpb.CreatePollRequest
+	{
+		if chatCreatePollMessage != "" {
+			err = json.Unmarshal([]byte(chatCreatePollMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"anonymous\": false,\n      \"closes_at\": 2886035326489961491,\n      \"multiple_choice\": false,\n      \"options\": [\n         \"Iusto occaecati.\",\n         \"Porro voluptatem repudiandae recusandae repellendus.\"\n      ],\n      \"question\": \"A recusandae est in.\",\n      \"room_id\": \"Rerum dignissimos iusto quam doloribus ullam qui.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = chatCreatePollToken
+	}
+	v := &chat.CreatePollPayload{
+		RoomID:         message.RoomId,
+		Question:       message.Question,
+		MultipleChoice: message.MultipleChoice,
+		Anonymous:      message.Anonymous,
+		ClosesAt:       message.ClosesAt,
+	}
+	if message.Options != nil {
+		v.Options = make([]string, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildVotePayload builds the payload for the chat vote endpoint from CLI
+// flags.
+func BuildVotePayload(chatVoteMessage string, chatVoteToken string) (*chat.VotePayload, error) {
+	var err error
+	var message chatpb.VoteRequest
+	{
+		if chatVoteMessage != "" {
+			err = json.Unmarshal([]byte(chatVoteMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"options\": [\n         2746982251438003805,\n         3973790490981430682\n      ],\n      \"poll_id\": \"Voluptas qui nesciunt.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = chatVoteToken
+	}
+	v := &chat.VotePayload{
+		PollID: message.PollId,
+	}
+	if message.Options != nil {
+		v.Options = make([]int, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = int(val)
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
@@ -163,6 +163,114 @@ func (c *Client) StreamRoom() goa.Endpoint {
 	}
 }
 
+// ScheduleMessage calls the "ScheduleMessage" function in chatpb.ChatClient
+// interface.
+func (c *Client) ScheduleMessage() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildScheduleMessageFunc(c.grpccli, c.opts...),
+			EncodeScheduleMessageRequest,
+			DecodeScheduleMessageResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListScheduled calls the "ListScheduled" function in chatpb.ChatClient
+// interface.
+func (c *Client) ListScheduled() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListScheduledFunc(c.grpccli, c.opts...),
+			EncodeListScheduledRequest,
+			DecodeListScheduledResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CancelScheduled calls the "CancelScheduled" function in chatpb.ChatClient
+// interface.
+func (c *Client) CancelScheduled() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCancelScheduledFunc(c.grpccli, c.opts...),
+			EncodeCancelScheduledRequest,
+			DecodeCancelScheduledResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CreatePoll calls the "CreatePoll" function in chatpb.ChatClient interface.
+func (c *Client) CreatePoll() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCreatePollFunc(c.grpccli, c.opts...),
+			EncodeCreatePollRequest,
+			DecodeCreatePollResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Vote calls the "Vote" function in chatpb.ChatClient interface.
+func (c *Client) Vote() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildVoteFunc(c.grpccli, c.opts...),
+			EncodeVoteRequest,
+			DecodeVoteResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
 // Recv reads instances of "chatpb.StreamRoomResponse" from the "stream-room"
 // endpoint gRPC stream.
 func (s *StreamRoomClientStream) Recv() (*chat.Chat, error) {
@@ -171,6 +279,9 @@ func (s *StreamRoomClientStream) Recv() (*chat.Chat, error) {
 	if err != nil {
 		return res, err
 	}
+	if err = ValidateStreamRoomResponse(v); err != nil {
+		return res, err
+	}
 	return NewStreamRoomResponseChat2(v), nil
 }
 
@@ -182,14 +293,14 @@ func (s *StreamRoomClientStream) RecvWithContext(ctx context.Context) (*chat.Cha
 
 // Send streams instances of "chatpb.StreamRoomStreamingRequest" to the
 // "stream-room" endpoint gRPC stream.
-func (s *StreamRoomClientStream) Send(res string) error {
-	v := NewProtoStreamRoomStreamingRequest(res)
+func (s *StreamRoomClientStream) Send(res *chat.MessageInput) error {
+	v := NewProtoMessageInputStreamRoomStreamingRequest(res)
 	return s.stream.Send(v)
 }
 
 // SendWithContext streams instances of "chatpb.StreamRoomStreamingRequest" to
 // the "stream-room" endpoint gRPC stream with context.
-func (s *StreamRoomClientStream) SendWithContext(ctx context.Context, res string) error {
+func (s *StreamRoomClientStream) SendWithContext(ctx context.Context, res *chat.MessageInput) error {
 	return s.Send(res)
 }
 
@@ -82,6 +82,9 @@ func DecodeHistoryResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (a
 	if !ok {
 		return nil, goagrpc.ErrInvalidType("chat", "history", "*chatpb.HistoryResponse", v)
 	}
+	if err := ValidateHistoryResponse(message); err != nil {
+		return nil, err
+	}
 	res := NewHistoryResult(message)
 	return res, nil
 }
@@ -221,3 +224,186 @@ func DecodeStreamRoomResponse(ctx context.Context, v any, hdr, trlr metadata.MD)
 		stream: v.(chatpb.Chat_StreamRoomClient),
 	}, nil
 }
+
+// BuildScheduleMessageFunc builds the remote method to invoke for "chat"
+// service "schedule-message" endpoint.
+func BuildScheduleMessageFunc(grpccli chatpb.ChatClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ScheduleMessage(ctx, reqpb.(*chatpb.ScheduleMessageRequest), opts...)
+		}
+		return grpccli.ScheduleMessage(ctx, &chatpb.ScheduleMessageRequest{}, opts...)
+	}
+}
+
+// EncodeScheduleMessageRequest encodes requests sent to chat schedule-message
+// endpoint.
+func EncodeScheduleMessageRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*chat.ScheduleMessagePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "schedule-message", "*chat.ScheduleMessagePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoScheduleMessageRequest(payload), nil
+}
+
+// DecodeScheduleMessageResponse decodes responses from the chat
+// schedule-message endpoint.
+func DecodeScheduleMessageResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*chatpb.ScheduleMessageResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "schedule-message", "*chatpb.ScheduleMessageResponse", v)
+	}
+	res := NewScheduleMessageResult(message)
+	return res, nil
+}
+
+// BuildListScheduledFunc builds the remote method to invoke for "chat" service
+// "list-scheduled" endpoint.
+func BuildListScheduledFunc(grpccli chatpb.ChatClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListScheduled(ctx, reqpb.(*chatpb.ListScheduledRequest), opts...)
+		}
+		return grpccli.ListScheduled(ctx, &chatpb.ListScheduledRequest{}, opts...)
+	}
+}
+
+// EncodeListScheduledRequest encodes requests sent to chat list-scheduled
+// endpoint.
+func EncodeListScheduledRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*chat.ListScheduledPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "list-scheduled", "*chat.ListScheduledPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoListScheduledRequest(payload), nil
+}
+
+// DecodeListScheduledResponse decodes responses from the chat list-scheduled
+// endpoint.
+func DecodeListScheduledResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*chatpb.ListScheduledResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "list-scheduled", "*chatpb.ListScheduledResponse", v)
+	}
+	res := NewListScheduledResult(message)
+	return res, nil
+}
+
+// BuildCancelScheduledFunc builds the remote method to invoke for "chat"
+// service "cancel-scheduled" endpoint.
+func BuildCancelScheduledFunc(grpccli chatpb.ChatClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CancelScheduled(ctx, reqpb.(*chatpb.CancelScheduledRequest), opts...)
+		}
+		return grpccli.CancelScheduled(ctx, &chatpb.CancelScheduledRequest{}, opts...)
+	}
+}
+
+// EncodeCancelScheduledRequest encodes requests sent to chat cancel-scheduled
+// endpoint.
+func EncodeCancelScheduledRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*chat.CancelScheduledPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "cancel-scheduled", "*chat.CancelScheduledPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCancelScheduledRequest(payload), nil
+}
+
+// DecodeCancelScheduledResponse decodes responses from the chat
+// cancel-scheduled endpoint.
+func DecodeCancelScheduledResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*chatpb.CancelScheduledResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "cancel-scheduled", "*chatpb.CancelScheduledResponse", v)
+	}
+	res := NewCancelScheduledResult(message)
+	return res, nil
+}
+
+// BuildCreatePollFunc builds the remote method to invoke for "chat" service
+// "create-poll" endpoint.
+func BuildCreatePollFunc(grpccli chatpb.ChatClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CreatePoll(ctx, reqpb.(*chatpb.CreatePollRequest), opts...)
+		}
+		return grpccli.CreatePoll(ctx, &chatpb.CreatePollRequest{}, opts...)
+	}
+}
+
+// EncodeCreatePollRequest encodes requests sent to chat create-poll endpoint.
+func EncodeCreatePollRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*chat.CreatePollPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "create-poll", "*chat.CreatePollPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoCreatePollRequest(payload), nil
+}
+
+// DecodeCreatePollResponse decodes responses from the chat create-poll
+// endpoint.
+func DecodeCreatePollResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*chatpb.CreatePollResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "create-poll", "*chatpb.CreatePollResponse", v)
+	}
+	if err := ValidateCreatePollResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewCreatePollResult(message)
+	return res, nil
+}
+
+// BuildVoteFunc builds the remote method to invoke for "chat" service "vote"
+// endpoint.
+func BuildVoteFunc(grpccli chatpb.ChatClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Vote(ctx, reqpb.(*chatpb.VoteRequest), opts...)
+		}
+		return grpccli.Vote(ctx, &chatpb.VoteRequest{}, opts...)
+	}
+}
+
+// EncodeVoteRequest encodes requests sent to chat vote endpoint.
+func EncodeVoteRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*chat.VotePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "vote", "*chat.VotePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoVoteRequest(payload), nil
+}
+
+// DecodeVoteResponse decodes responses from the chat vote endpoint.
+func DecodeVoteResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*chatpb.VoteResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "vote", "*chatpb.VoteResponse", v)
+	}
+	if err := ValidateVoteResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewVoteResult(message)
+	return res, nil
+}
@@ -8,6 +8,7 @@
 package client
 
 import (
+	goa "goa.design/goa/v3/pkg"
 	chat "object-t.com/hackz-giganoto/microservices/chat/gen/chat"
 	chatpb "object-t.com/hackz-giganoto/microservices/chat/gen/grpc/chat/pb"
 )
@@ -47,6 +48,17 @@ func NewHistoryResult(message *chatpb.HistoryResponse) []*chat.Chat {
 			CreatedAt: val.CreatedAt,
 			UpdatedAt: val.UpdatedAt,
 			RoomID:    val.RoomId,
+			Kind:      val.Kind,
+			ClientID:  val.ClientId,
+		}
+		if val.AttachmentIds != nil {
+			result[i].AttachmentIds = make([]string, len(val.AttachmentIds))
+			for j, val := range val.AttachmentIds {
+				result[i].AttachmentIds[j] = val
+			}
+		}
+		if val.Poll != nil {
+			result[i].Poll = protobufChatpbPollToChatPoll(val.Poll)
 		}
 	}
 	return result
@@ -110,12 +122,330 @@ func NewStreamRoomResponseChat2(v *chatpb.StreamRoomResponse) *chat.Chat {
 		CreatedAt: v.CreatedAt,
 		UpdatedAt: v.UpdatedAt,
 		RoomID:    v.RoomId,
+		Kind:      v.Kind,
+		ClientID:  v.ClientId,
+	}
+	if v.AttachmentIds != nil {
+		result.AttachmentIds = make([]string, len(v.AttachmentIds))
+		for i, val := range v.AttachmentIds {
+			result.AttachmentIds[i] = val
+		}
+	}
+	if v.Poll != nil {
+		result.Poll = protobufChatpbPollToChatPoll(v.Poll)
 	}
 	return result
 }
 
-func NewProtoStreamRoomStreamingRequest(spayload string) *chatpb.StreamRoomStreamingRequest {
-	v := &chatpb.StreamRoomStreamingRequest{}
-	v.Field = spayload
+func NewProtoMessageInputStreamRoomStreamingRequest(spayload *chat.MessageInput) *chatpb.StreamRoomStreamingRequest {
+	v := &chatpb.StreamRoomStreamingRequest{
+		Message_: spayload.Message,
+		ClientId: spayload.ClientID,
+	}
+	if spayload.AttachmentIds != nil {
+		v.AttachmentIds = make([]string, len(spayload.AttachmentIds))
+		for i, val := range spayload.AttachmentIds {
+			v.AttachmentIds[i] = val
+		}
+	}
 	return v
 }
+
+// NewProtoScheduleMessageRequest builds the gRPC request type from the payload
+// of the "schedule-message" endpoint of the "chat" service.
+func NewProtoScheduleMessageRequest(payload *chat.ScheduleMessagePayload) *chatpb.ScheduleMessageRequest {
+	message := &chatpb.ScheduleMessageRequest{
+		RoomId:   payload.RoomID,
+		Message_: payload.Message,
+		SendAt:   payload.SendAt,
+	}
+	return message
+}
+
+// NewScheduleMessageResult builds the result type of the "schedule-message"
+// endpoint of the "chat" service from the gRPC response type.
+func NewScheduleMessageResult(message *chatpb.ScheduleMessageResponse) *chat.ScheduledMessage {
+	result := &chat.ScheduledMessage{
+		ID:        message.Id,
+		RoomID:    message.RoomId,
+		UserID:    message.UserId,
+		Message:   message.Message_,
+		SendAt:    message.SendAt,
+		CreatedAt: message.CreatedAt,
+	}
+	return result
+}
+
+// NewProtoListScheduledRequest builds the gRPC request type from the payload
+// of the "list-scheduled" endpoint of the "chat" service.
+func NewProtoListScheduledRequest(payload *chat.ListScheduledPayload) *chatpb.ListScheduledRequest {
+	message := &chatpb.ListScheduledRequest{
+		RoomId: payload.RoomID,
+	}
+	return message
+}
+
+// NewListScheduledResult builds the result type of the "list-scheduled"
+// endpoint of the "chat" service from the gRPC response type.
+func NewListScheduledResult(message *chatpb.ListScheduledResponse) []*chat.ScheduledMessage {
+	result := make([]*chat.ScheduledMessage, len(message.Field))
+	for i, val := range message.Field {
+		result[i] = &chat.ScheduledMessage{
+			ID:        val.Id,
+			RoomID:    val.RoomId,
+			UserID:    val.UserId,
+			Message:   val.Message_,
+			SendAt:    val.SendAt,
+			CreatedAt: val.CreatedAt,
+		}
+	}
+	return result
+}
+
+// NewProtoCancelScheduledRequest builds the gRPC request type from the payload
+// of the "cancel-scheduled" endpoint of the "chat" service.
+func NewProtoCancelScheduledRequest(payload *chat.CancelScheduledPayload) *chatpb.CancelScheduledRequest {
+	message := &chatpb.CancelScheduledRequest{
+		Id: payload.ID,
+	}
+	return message
+}
+
+// NewCancelScheduledResult builds the result type of the "cancel-scheduled"
+// endpoint of the "chat" service from the gRPC response type.
+func NewCancelScheduledResult(message *chatpb.CancelScheduledResponse) string {
+	result := message.Field
+	return result
+}
+
+// NewProtoCreatePollRequest builds the gRPC request type from the payload of
+// the "create-poll" endpoint of the "chat" service.
+func NewProtoCreatePollRequest(payload *chat.CreatePollPayload) *chatpb.CreatePollRequest {
+	message := &chatpb.CreatePollRequest{
+		RoomId:         payload.RoomID,
+		Question:       payload.Question,
+		MultipleChoice: payload.MultipleChoice,
+		Anonymous:      payload.Anonymous,
+		ClosesAt:       payload.ClosesAt,
+	}
+	if payload.Options != nil {
+		message.Options = make([]string, len(payload.Options))
+		for i, val := range payload.Options {
+			message.Options[i] = val
+		}
+	}
+	return message
+}
+
+// NewCreatePollResult builds the result type of the "create-poll" endpoint of
+// the "chat" service from the gRPC response type.
+func NewCreatePollResult(message *chatpb.CreatePollResponse) *chat.Chat {
+	result := &chat.Chat{
+		UserID:    message.UserId,
+		Message:   message.Message_,
+		ID:        message.Id,
+		CreatedAt: message.CreatedAt,
+		UpdatedAt: message.UpdatedAt,
+		RoomID:    message.RoomId,
+		Kind:      message.Kind,
+		ClientID:  message.ClientId,
+	}
+	if message.AttachmentIds != nil {
+		result.AttachmentIds = make([]string, len(message.AttachmentIds))
+		for i, val := range message.AttachmentIds {
+			result.AttachmentIds[i] = val
+		}
+	}
+	if message.Poll != nil {
+		result.Poll = protobufChatpbPollToChatPoll(message.Poll)
+	}
+	return result
+}
+
+// NewProtoVoteRequest builds the gRPC request type from the payload of the
+// "vote" endpoint of the "chat" service.
+func NewProtoVoteRequest(payload *chat.VotePayload) *chatpb.VoteRequest {
+	message := &chatpb.VoteRequest{
+		PollId: payload.PollID,
+	}
+	if payload.Options != nil {
+		message.Options = make([]int32, len(payload.Options))
+		for i, val := range payload.Options {
+			message.Options[i] = int32(val)
+		}
+	}
+	return message
+}
+
+// NewVoteResult builds the result type of the "vote" endpoint of the "chat"
+// service from the gRPC response type.
+func NewVoteResult(message *chatpb.VoteResponse) *chat.Poll {
+	result := &chat.Poll{
+		ID:             message.Id,
+		Question:       message.Question,
+		MultipleChoice: message.MultipleChoice,
+		Anonymous:      message.Anonymous,
+		ClosesAt:       message.ClosesAt,
+		Closed:         message.Closed,
+	}
+	if message.Options != nil {
+		result.Options = make([]*chat.PollOption, len(message.Options))
+		for i, val := range message.Options {
+			result.Options[i] = &chat.PollOption{
+				Index: int(val.Index),
+				Text:  val.Text,
+				Votes: int(val.Votes),
+			}
+			if val.VoterIds != nil {
+				result.Options[i].VoterIds = make([]string, len(val.VoterIds))
+				for j, val := range val.VoterIds {
+					result.Options[i].VoterIds[j] = val
+				}
+			}
+		}
+	}
+	return result
+}
+
+// ValidateHistoryResponse runs the validations defined on HistoryResponse.
+func ValidateHistoryResponse(message *chatpb.HistoryResponse) (err error) {
+	for _, e := range message.Field {
+		if e != nil {
+			if err2 := ValidateChat2(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateChat2 runs the validations defined on Chat2.
+func ValidateChat2(elem *chatpb.Chat2) (err error) {
+	if elem.Poll != nil {
+		if err2 := ValidatePoll(elem.Poll); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if elem.Kind != nil {
+		if !(*elem.Kind == "message" || *elem.Kind == "poll" || *elem.Kind == "poll_update" || *elem.Kind == "ack") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.kind", *elem.Kind, []any{"message", "poll", "poll_update", "ack"}))
+		}
+	}
+	return
+}
+
+// ValidatePoll runs the validations defined on Poll.
+func ValidatePoll(poll *chatpb.Poll) (err error) {
+	if poll.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "poll"))
+	}
+	return
+}
+
+// ValidateStreamRoomResponse runs the validations defined on
+// StreamRoomResponse.
+func ValidateStreamRoomResponse(stream *chatpb.StreamRoomResponse) (err error) {
+	if stream.Poll != nil {
+		if err2 := ValidatePoll(stream.Poll); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if stream.Kind != nil {
+		if !(*stream.Kind == "message" || *stream.Kind == "poll" || *stream.Kind == "poll_update" || *stream.Kind == "ack") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("stream.kind", *stream.Kind, []any{"message", "poll", "poll_update", "ack"}))
+		}
+	}
+	return
+}
+
+// ValidateCreatePollResponse runs the validations defined on
+// CreatePollResponse.
+func ValidateCreatePollResponse(message *chatpb.CreatePollResponse) (err error) {
+	if message.Poll != nil {
+		if err2 := ValidatePoll(message.Poll); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if message.Kind != nil {
+		if !(*message.Kind == "message" || *message.Kind == "poll" || *message.Kind == "poll_update" || *message.Kind == "ack") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.kind", *message.Kind, []any{"message", "poll", "poll_update", "ack"}))
+		}
+	}
+	return
+}
+
+// ValidateVoteResponse runs the validations defined on VoteResponse.
+func ValidateVoteResponse(message *chatpb.VoteResponse) (err error) {
+	if message.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "message"))
+	}
+	return
+}
+
+// svcChatPollToChatpbPoll builds a value of type *chatpb.Poll from a value of
+// type *chat.Poll.
+func svcChatPollToChatpbPoll(v *chat.Poll) *chatpb.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &chatpb.Poll{
+		Id:             v.ID,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*chatpb.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &chatpb.PollOption{
+				Index: int32(val.Index),
+				Text:  val.Text,
+				Votes: int32(val.Votes),
+			}
+			if val.VoterIds != nil {
+				res.Options[i].VoterIds = make([]string, len(val.VoterIds))
+				for j, val := range val.VoterIds {
+					res.Options[i].VoterIds[j] = val
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufChatpbPollToChatPoll builds a value of type *chat.Poll from a value
+// of type *chatpb.Poll.
+func protobufChatpbPollToChatPoll(v *chatpb.Poll) *chat.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &chat.Poll{
+		ID:             v.Id,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*chat.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &chat.PollOption{
+				Index: int(val.Index),
+				Text:  val.Text,
+				Votes: int(val.Votes),
+			}
+			if val.VoterIds != nil {
+				res.Options[i].VoterIds = make([]string, len(val.VoterIds))
+				for j, val := range val.VoterIds {
+					res.Options[i].VoterIds[j] = val
+				}
+			}
+		}
+	}
+
+	return res
+}
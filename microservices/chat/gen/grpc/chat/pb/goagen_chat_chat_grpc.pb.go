@@ -26,12 +26,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Chat_CreateRoom_FullMethodName = "/chat.v1.Chat/CreateRoom"
-	Chat_History_FullMethodName    = "/chat.v1.Chat/History"
-	Chat_RoomList_FullMethodName   = "/chat.v1.Chat/RoomList"
-	Chat_JoinRoom_FullMethodName   = "/chat.v1.Chat/JoinRoom"
-	Chat_InviteRoom_FullMethodName = "/chat.v1.Chat/InviteRoom"
-	Chat_StreamRoom_FullMethodName = "/chat.v1.Chat/StreamRoom"
+	Chat_CreateRoom_FullMethodName      = "/chat.v1.Chat/CreateRoom"
+	Chat_History_FullMethodName         = "/chat.v1.Chat/History"
+	Chat_RoomList_FullMethodName        = "/chat.v1.Chat/RoomList"
+	Chat_JoinRoom_FullMethodName        = "/chat.v1.Chat/JoinRoom"
+	Chat_InviteRoom_FullMethodName      = "/chat.v1.Chat/InviteRoom"
+	Chat_StreamRoom_FullMethodName      = "/chat.v1.Chat/StreamRoom"
+	Chat_ScheduleMessage_FullMethodName = "/chat.v1.Chat/ScheduleMessage"
+	Chat_ListScheduled_FullMethodName   = "/chat.v1.Chat/ListScheduled"
+	Chat_CancelScheduled_FullMethodName = "/chat.v1.Chat/CancelScheduled"
+	Chat_CreatePoll_FullMethodName      = "/chat.v1.Chat/CreatePoll"
+	Chat_Vote_FullMethodName            = "/chat.v1.Chat/Vote"
 )
 
 // ChatClient is the client API for Chat service.
@@ -52,6 +57,16 @@ type ChatClient interface {
 	InviteRoom(ctx context.Context, in *InviteRoomRequest, opts ...grpc.CallOption) (*InviteRoomResponse, error)
 	// Streams chat room events on a chat room
 	StreamRoom(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamRoomStreamingRequest, StreamRoomResponse], error)
+	// Schedules a message to be sent to a chat room later
+	ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error)
+	// Lists the messages scheduled by the user
+	ListScheduled(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ListScheduledResponse, error)
+	// Cancels a scheduled message before it is sent
+	CancelScheduled(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*CancelScheduledResponse, error)
+	// Posts a poll in a chat room
+	CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*CreatePollResponse, error)
+	// Votes on a poll
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
 }
 
 type chatClient struct {
@@ -125,6 +140,56 @@ func (c *chatClient) StreamRoom(ctx context.Context, opts ...grpc.CallOption) (g
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Chat_StreamRoomClient = grpc.BidiStreamingClient[StreamRoomStreamingRequest, StreamRoomResponse]
 
+func (c *chatClient) ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMessageResponse)
+	err := c.cc.Invoke(ctx, Chat_ScheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ListScheduled(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ListScheduledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledResponse)
+	err := c.cc.Invoke(ctx, Chat_ListScheduled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CancelScheduled(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*CancelScheduledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledResponse)
+	err := c.cc.Invoke(ctx, Chat_CancelScheduled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*CreatePollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePollResponse)
+	err := c.cc.Invoke(ctx, Chat_CreatePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, Chat_Vote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServer is the server API for Chat service.
 // All implementations must embed UnimplementedChatServer
 // for forward compatibility.
@@ -143,6 +208,16 @@ type ChatServer interface {
 	InviteRoom(context.Context, *InviteRoomRequest) (*InviteRoomResponse, error)
 	// Streams chat room events on a chat room
 	StreamRoom(grpc.BidiStreamingServer[StreamRoomStreamingRequest, StreamRoomResponse]) error
+	// Schedules a message to be sent to a chat room later
+	ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error)
+	// Lists the messages scheduled by the user
+	ListScheduled(context.Context, *ListScheduledRequest) (*ListScheduledResponse, error)
+	// Cancels a scheduled message before it is sent
+	CancelScheduled(context.Context, *CancelScheduledRequest) (*CancelScheduledResponse, error)
+	// Posts a poll in a chat room
+	CreatePoll(context.Context, *CreatePollRequest) (*CreatePollResponse, error)
+	// Votes on a poll
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
 	mustEmbedUnimplementedChatServer()
 }
 
@@ -171,6 +246,21 @@ func (UnimplementedChatServer) InviteRoom(context.Context, *InviteRoomRequest) (
 func (UnimplementedChatServer) StreamRoom(grpc.BidiStreamingServer[StreamRoomStreamingRequest, StreamRoomResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamRoom not implemented")
 }
+func (UnimplementedChatServer) ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMessage not implemented")
+}
+func (UnimplementedChatServer) ListScheduled(context.Context, *ListScheduledRequest) (*ListScheduledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduled not implemented")
+}
+func (UnimplementedChatServer) CancelScheduled(context.Context, *CancelScheduledRequest) (*CancelScheduledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduled not implemented")
+}
+func (UnimplementedChatServer) CreatePoll(context.Context, *CreatePollRequest) (*CreatePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePoll not implemented")
+}
+func (UnimplementedChatServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
 func (UnimplementedChatServer) mustEmbedUnimplementedChatServer() {}
 func (UnimplementedChatServer) testEmbeddedByValue()              {}
 
@@ -289,6 +379,96 @@ func _Chat_StreamRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Chat_StreamRoomServer = grpc.BidiStreamingServer[StreamRoomStreamingRequest, StreamRoomResponse]
 
+func _Chat_ScheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ScheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_ScheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ScheduleMessage(ctx, req.(*ScheduleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ListScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ListScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_ListScheduled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ListScheduled(ctx, req.(*ListScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_CancelScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).CancelScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_CancelScheduled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).CancelScheduled(ctx, req.(*CancelScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_CreatePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).CreatePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_CreatePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).CreatePoll(ctx, req.(*CreatePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Chat_Vote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Chat_ServiceDesc is the grpc.ServiceDesc for Chat service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -316,6 +496,26 @@ var Chat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InviteRoom",
 			Handler:    _Chat_InviteRoom_Handler,
 		},
+		{
+			MethodName: "ScheduleMessage",
+			Handler:    _Chat_ScheduleMessage_Handler,
+		},
+		{
+			MethodName: "ListScheduled",
+			Handler:    _Chat_ListScheduled_Handler,
+		},
+		{
+			MethodName: "CancelScheduled",
+			Handler:    _Chat_CancelScheduled_Handler,
+		},
+		{
+			MethodName: "CreatePoll",
+			Handler:    _Chat_CreatePoll_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Chat_Vote_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
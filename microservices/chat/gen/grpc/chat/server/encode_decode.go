@@ -290,3 +290,252 @@ func DecodeStreamRoomRequest(ctx context.Context, v any, md metadata.MD) (any, e
 	}
 	return payload, nil
 }
+
+// EncodeScheduleMessageResponse encodes responses from the "chat" service
+// "schedule-message" endpoint.
+func EncodeScheduleMessageResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*chat.ScheduledMessage)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "schedule-message", "*chat.ScheduledMessage", v)
+	}
+	resp := NewProtoScheduleMessageResponse(result)
+	return resp, nil
+}
+
+// DecodeScheduleMessageRequest decodes requests sent to "chat" service
+// "schedule-message" endpoint.
+func DecodeScheduleMessageRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *chatpb.ScheduleMessageRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*chatpb.ScheduleMessageRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("chat", "schedule-message", "*chatpb.ScheduleMessageRequest", v)
+		}
+	}
+	var payload *chat.ScheduleMessagePayload
+	{
+		payload = NewScheduleMessagePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeListScheduledResponse encodes responses from the "chat" service
+// "list-scheduled" endpoint.
+func EncodeListScheduledResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.([]*chat.ScheduledMessage)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "list-scheduled", "[]*chat.ScheduledMessage", v)
+	}
+	resp := NewProtoListScheduledResponse(result)
+	return resp, nil
+}
+
+// DecodeListScheduledRequest decodes requests sent to "chat" service
+// "list-scheduled" endpoint.
+func DecodeListScheduledRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *chatpb.ListScheduledRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*chatpb.ListScheduledRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("chat", "list-scheduled", "*chatpb.ListScheduledRequest", v)
+		}
+	}
+	var payload *chat.ListScheduledPayload
+	{
+		payload = NewListScheduledPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCancelScheduledResponse encodes responses from the "chat" service
+// "cancel-scheduled" endpoint.
+func EncodeCancelScheduledResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(string)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "cancel-scheduled", "string", v)
+	}
+	resp := NewProtoCancelScheduledResponse(result)
+	return resp, nil
+}
+
+// DecodeCancelScheduledRequest decodes requests sent to "chat" service
+// "cancel-scheduled" endpoint.
+func DecodeCancelScheduledRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *chatpb.CancelScheduledRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*chatpb.CancelScheduledRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("chat", "cancel-scheduled", "*chatpb.CancelScheduledRequest", v)
+		}
+	}
+	var payload *chat.CancelScheduledPayload
+	{
+		payload = NewCancelScheduledPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeCreatePollResponse encodes responses from the "chat" service
+// "create-poll" endpoint.
+func EncodeCreatePollResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*chat.Chat)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "create-poll", "*chat.Chat", v)
+	}
+	resp := NewProtoCreatePollResponse(result)
+	return resp, nil
+}
+
+// DecodeCreatePollRequest decodes requests sent to "chat" service
+// "create-poll" endpoint.
+func DecodeCreatePollRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *chatpb.CreatePollRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*chatpb.CreatePollRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("chat", "create-poll", "*chatpb.CreatePollRequest", v)
+		}
+		if err = ValidateCreatePollRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *chat.CreatePollPayload
+	{
+		payload = NewCreatePollPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeVoteResponse encodes responses from the "chat" service "vote" endpoint.
+func EncodeVoteResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*chat.Poll)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("chat", "vote", "*chat.Poll", v)
+	}
+	resp := NewProtoVoteResponse(result)
+	return resp, nil
+}
+
+// DecodeVoteRequest decodes requests sent to "chat" service "vote" endpoint.
+func DecodeVoteRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *chatpb.VoteRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*chatpb.VoteRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("chat", "vote", "*chatpb.VoteRequest", v)
+		}
+		if err = ValidateVoteRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *chat.VotePayload
+	{
+		payload = NewVotePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
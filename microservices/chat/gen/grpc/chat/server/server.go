@@ -20,12 +20,17 @@ import (
 
 // Server implements the chatpb.ChatServer interface.
 type Server struct {
-	CreateRoomH goagrpc.UnaryHandler
-	HistoryH    goagrpc.UnaryHandler
-	RoomListH   goagrpc.UnaryHandler
-	JoinRoomH   goagrpc.UnaryHandler
-	InviteRoomH goagrpc.UnaryHandler
-	StreamRoomH goagrpc.StreamHandler
+	CreateRoomH      goagrpc.UnaryHandler
+	HistoryH         goagrpc.UnaryHandler
+	RoomListH        goagrpc.UnaryHandler
+	JoinRoomH        goagrpc.UnaryHandler
+	InviteRoomH      goagrpc.UnaryHandler
+	StreamRoomH      goagrpc.StreamHandler
+	ScheduleMessageH goagrpc.UnaryHandler
+	ListScheduledH   goagrpc.UnaryHandler
+	CancelScheduledH goagrpc.UnaryHandler
+	CreatePollH      goagrpc.UnaryHandler
+	VoteH            goagrpc.UnaryHandler
 	chatpb.UnimplementedChatServer
 }
 
@@ -37,12 +42,17 @@ type StreamRoomServerStream struct {
 // New instantiates the server struct with the chat service endpoints.
 func New(e *chat.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
 	return &Server{
-		CreateRoomH: NewCreateRoomHandler(e.CreateRoom, uh),
-		HistoryH:    NewHistoryHandler(e.History, uh),
-		RoomListH:   NewRoomListHandler(e.RoomList, uh),
-		JoinRoomH:   NewJoinRoomHandler(e.JoinRoom, uh),
-		InviteRoomH: NewInviteRoomHandler(e.InviteRoom, uh),
-		StreamRoomH: NewStreamRoomHandler(e.StreamRoom, sh),
+		CreateRoomH:      NewCreateRoomHandler(e.CreateRoom, uh),
+		HistoryH:         NewHistoryHandler(e.History, uh),
+		RoomListH:        NewRoomListHandler(e.RoomList, uh),
+		JoinRoomH:        NewJoinRoomHandler(e.JoinRoom, uh),
+		InviteRoomH:      NewInviteRoomHandler(e.InviteRoom, uh),
+		StreamRoomH:      NewStreamRoomHandler(e.StreamRoom, sh),
+		ScheduleMessageH: NewScheduleMessageHandler(e.ScheduleMessage, uh),
+		ListScheduledH:   NewListScheduledHandler(e.ListScheduled, uh),
+		CancelScheduledH: NewCancelScheduledHandler(e.CancelScheduled, uh),
+		CreatePollH:      NewCreatePollHandler(e.CreatePoll, uh),
+		VoteH:            NewVoteHandler(e.Vote, uh),
 	}
 }
 
@@ -176,6 +186,8 @@ func (s *Server) InviteRoom(ctx context.Context, message *chatpb.InviteRoomReque
 			switch en.GoaErrorName() {
 			case "invalid_argument":
 				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "permission-denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
 			}
 		}
 		return nil, goagrpc.EncodeError(err)
@@ -230,6 +242,162 @@ func (s *Server) StreamRoom(stream chatpb.Chat_StreamRoomServer) error {
 	return nil
 }
 
+// NewScheduleMessageHandler creates a gRPC handler which serves the "chat"
+// service "schedule-message" endpoint.
+func NewScheduleMessageHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeScheduleMessageRequest, EncodeScheduleMessageResponse)
+	}
+	return h
+}
+
+// ScheduleMessage implements the "ScheduleMessage" method in chatpb.ChatServer
+// interface.
+func (s *Server) ScheduleMessage(ctx context.Context, message *chatpb.ScheduleMessageRequest) (*chatpb.ScheduleMessageResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "schedule-message")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "chat")
+	resp, err := s.ScheduleMessageH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_argument":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "permission-denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*chatpb.ScheduleMessageResponse), nil
+}
+
+// NewListScheduledHandler creates a gRPC handler which serves the "chat"
+// service "list-scheduled" endpoint.
+func NewListScheduledHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListScheduledRequest, EncodeListScheduledResponse)
+	}
+	return h
+}
+
+// ListScheduled implements the "ListScheduled" method in chatpb.ChatServer
+// interface.
+func (s *Server) ListScheduled(ctx context.Context, message *chatpb.ListScheduledRequest) (*chatpb.ListScheduledResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list-scheduled")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "chat")
+	resp, err := s.ListScheduledH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "internal":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*chatpb.ListScheduledResponse), nil
+}
+
+// NewCancelScheduledHandler creates a gRPC handler which serves the "chat"
+// service "cancel-scheduled" endpoint.
+func NewCancelScheduledHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCancelScheduledRequest, EncodeCancelScheduledResponse)
+	}
+	return h
+}
+
+// CancelScheduled implements the "CancelScheduled" method in chatpb.ChatServer
+// interface.
+func (s *Server) CancelScheduled(ctx context.Context, message *chatpb.CancelScheduledRequest) (*chatpb.CancelScheduledResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "cancel-scheduled")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "chat")
+	resp, err := s.CancelScheduledH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "notfound":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "permission-denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*chatpb.CancelScheduledResponse), nil
+}
+
+// NewCreatePollHandler creates a gRPC handler which serves the "chat" service
+// "create-poll" endpoint.
+func NewCreatePollHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCreatePollRequest, EncodeCreatePollResponse)
+	}
+	return h
+}
+
+// CreatePoll implements the "CreatePoll" method in chatpb.ChatServer interface.
+func (s *Server) CreatePoll(ctx context.Context, message *chatpb.CreatePollRequest) (*chatpb.CreatePollResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "create-poll")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "chat")
+	resp, err := s.CreatePollH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_argument":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "permission-denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*chatpb.CreatePollResponse), nil
+}
+
+// NewVoteHandler creates a gRPC handler which serves the "chat" service "vote"
+// endpoint.
+func NewVoteHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeVoteRequest, EncodeVoteResponse)
+	}
+	return h
+}
+
+// Vote implements the "Vote" method in chatpb.ChatServer interface.
+func (s *Server) Vote(ctx context.Context, message *chatpb.VoteRequest) (*chatpb.VoteResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "vote")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "chat")
+	resp, err := s.VoteH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "notfound":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "invalid_argument":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "permission-denied":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*chatpb.VoteResponse), nil
+}
+
 // Send streams instances of "chatpb.StreamRoomResponse" to the "stream-room"
 // endpoint gRPC stream.
 func (s *StreamRoomServerStream) Send(res *chat.Chat) error {
@@ -245,18 +413,18 @@ func (s *StreamRoomServerStream) SendWithContext(ctx context.Context, res *chat.
 
 // Recv reads instances of "chatpb.StreamRoomStreamingRequest" from the
 // "stream-room" endpoint gRPC stream.
-func (s *StreamRoomServerStream) Recv() (string, error) {
-	var res string
+func (s *StreamRoomServerStream) Recv() (*chat.MessageInput, error) {
+	var res *chat.MessageInput
 	v, err := s.stream.Recv()
 	if err != nil {
 		return res, err
 	}
-	return NewStreamRoomStreamingRequestStreamRoomStreamingRequest(v), nil
+	return NewStreamRoomStreamingRequestMessageInput(v), nil
 }
 
 // RecvWithContext reads instances of "chatpb.StreamRoomStreamingRequest" from
 // the "stream-room" endpoint gRPC stream with context.
-func (s *StreamRoomServerStream) RecvWithContext(ctx context.Context) (string, error) {
+func (s *StreamRoomServerStream) RecvWithContext(ctx context.Context) (*chat.MessageInput, error) {
 	return s.Recv()
 }
 
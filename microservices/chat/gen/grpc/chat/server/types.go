@@ -8,6 +8,7 @@
 package server
 
 import (
+	goa "goa.design/goa/v3/pkg"
 	chat "object-t.com/hackz-giganoto/microservices/chat/gen/chat"
 	chatpb "object-t.com/hackz-giganoto/microservices/chat/gen/grpc/chat/pb"
 )
@@ -51,6 +52,17 @@ func NewProtoHistoryResponse(result []*chat.Chat) *chatpb.HistoryResponse {
 			CreatedAt: val.CreatedAt,
 			UpdatedAt: val.UpdatedAt,
 			RoomId:    val.RoomID,
+			Kind:      val.Kind,
+			ClientId:  val.ClientID,
+		}
+		if val.AttachmentIds != nil {
+			message.Field[i].AttachmentIds = make([]string, len(val.AttachmentIds))
+			for j, val := range val.AttachmentIds {
+				message.Field[i].AttachmentIds[j] = val
+			}
+		}
+		if val.Poll != nil {
+			message.Field[i].Poll = svcChatPollToChatpbPoll(val.Poll)
 		}
 	}
 	return message
@@ -131,6 +143,17 @@ func NewProtoStreamRoomResponse(result *chat.Chat) *chatpb.StreamRoomResponse {
 		CreatedAt: result.CreatedAt,
 		UpdatedAt: result.UpdatedAt,
 		RoomId:    result.RoomID,
+		Kind:      result.Kind,
+		ClientId:  result.ClientID,
+	}
+	if result.AttachmentIds != nil {
+		message.AttachmentIds = make([]string, len(result.AttachmentIds))
+		for i, val := range result.AttachmentIds {
+			message.AttachmentIds[i] = val
+		}
+	}
+	if result.Poll != nil {
+		message.Poll = svcChatPollToChatpbPoll(result.Poll)
 	}
 	return message
 }
@@ -143,11 +166,278 @@ func NewProtoChat2StreamRoomResponse(result *chat.Chat) *chatpb.StreamRoomRespon
 		CreatedAt: result.CreatedAt,
 		UpdatedAt: result.UpdatedAt,
 		RoomId:    result.RoomID,
+		Kind:      result.Kind,
+		ClientId:  result.ClientID,
+	}
+	if result.AttachmentIds != nil {
+		v.AttachmentIds = make([]string, len(result.AttachmentIds))
+		for i, val := range result.AttachmentIds {
+			v.AttachmentIds[i] = val
+		}
+	}
+	if result.Poll != nil {
+		v.Poll = svcChatPollToChatpbPoll(result.Poll)
 	}
 	return v
 }
 
-func NewStreamRoomStreamingRequestStreamRoomStreamingRequest(v *chatpb.StreamRoomStreamingRequest) string {
-	spayload := v.Field
+func NewStreamRoomStreamingRequestMessageInput(v *chatpb.StreamRoomStreamingRequest) *chat.MessageInput {
+	spayload := &chat.MessageInput{
+		Message:  v.Message_,
+		ClientID: v.ClientId,
+	}
+	if v.AttachmentIds != nil {
+		spayload.AttachmentIds = make([]string, len(v.AttachmentIds))
+		for i, val := range v.AttachmentIds {
+			spayload.AttachmentIds[i] = val
+		}
+	}
 	return spayload
 }
+
+// NewScheduleMessagePayload builds the payload of the "schedule-message"
+// endpoint of the "chat" service from the gRPC request type.
+func NewScheduleMessagePayload(message *chatpb.ScheduleMessageRequest, token string) *chat.ScheduleMessagePayload {
+	v := &chat.ScheduleMessagePayload{
+		RoomID:  message.RoomId,
+		Message: message.Message_,
+		SendAt:  message.SendAt,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoScheduleMessageResponse builds the gRPC response type from the
+// result of the "schedule-message" endpoint of the "chat" service.
+func NewProtoScheduleMessageResponse(result *chat.ScheduledMessage) *chatpb.ScheduleMessageResponse {
+	message := &chatpb.ScheduleMessageResponse{
+		Id:        result.ID,
+		RoomId:    result.RoomID,
+		UserId:    result.UserID,
+		Message_:  result.Message,
+		SendAt:    result.SendAt,
+		CreatedAt: result.CreatedAt,
+	}
+	return message
+}
+
+// NewListScheduledPayload builds the payload of the "list-scheduled" endpoint
+// of the "chat" service from the gRPC request type.
+func NewListScheduledPayload(message *chatpb.ListScheduledRequest, token string) *chat.ListScheduledPayload {
+	v := &chat.ListScheduledPayload{
+		RoomID: message.RoomId,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoListScheduledResponse builds the gRPC response type from the result
+// of the "list-scheduled" endpoint of the "chat" service.
+func NewProtoListScheduledResponse(result []*chat.ScheduledMessage) *chatpb.ListScheduledResponse {
+	message := &chatpb.ListScheduledResponse{}
+	message.Field = make([]*chatpb.ScheduledMessage, len(result))
+	for i, val := range result {
+		message.Field[i] = &chatpb.ScheduledMessage{
+			Id:        val.ID,
+			RoomId:    val.RoomID,
+			UserId:    val.UserID,
+			Message_:  val.Message,
+			SendAt:    val.SendAt,
+			CreatedAt: val.CreatedAt,
+		}
+	}
+	return message
+}
+
+// NewCancelScheduledPayload builds the payload of the "cancel-scheduled"
+// endpoint of the "chat" service from the gRPC request type.
+func NewCancelScheduledPayload(message *chatpb.CancelScheduledRequest, token string) *chat.CancelScheduledPayload {
+	v := &chat.CancelScheduledPayload{
+		ID: message.Id,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCancelScheduledResponse builds the gRPC response type from the
+// result of the "cancel-scheduled" endpoint of the "chat" service.
+func NewProtoCancelScheduledResponse(result string) *chatpb.CancelScheduledResponse {
+	message := &chatpb.CancelScheduledResponse{}
+	message.Field = result
+	return message
+}
+
+// NewCreatePollPayload builds the payload of the "create-poll" endpoint of the
+// "chat" service from the gRPC request type.
+func NewCreatePollPayload(message *chatpb.CreatePollRequest, token string) *chat.CreatePollPayload {
+	v := &chat.CreatePollPayload{
+		RoomID:         message.RoomId,
+		Question:       message.Question,
+		MultipleChoice: message.MultipleChoice,
+		Anonymous:      message.Anonymous,
+		ClosesAt:       message.ClosesAt,
+	}
+	if message.Options != nil {
+		v.Options = make([]string, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = val
+		}
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoCreatePollResponse builds the gRPC response type from the result of
+// the "create-poll" endpoint of the "chat" service.
+func NewProtoCreatePollResponse(result *chat.Chat) *chatpb.CreatePollResponse {
+	message := &chatpb.CreatePollResponse{
+		UserId:    result.UserID,
+		Message_:  result.Message,
+		Id:        result.ID,
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+		RoomId:    result.RoomID,
+		Kind:      result.Kind,
+		ClientId:  result.ClientID,
+	}
+	if result.AttachmentIds != nil {
+		message.AttachmentIds = make([]string, len(result.AttachmentIds))
+		for i, val := range result.AttachmentIds {
+			message.AttachmentIds[i] = val
+		}
+	}
+	if result.Poll != nil {
+		message.Poll = svcChatPollToChatpbPoll(result.Poll)
+	}
+	return message
+}
+
+// NewVotePayload builds the payload of the "vote" endpoint of the "chat"
+// service from the gRPC request type.
+func NewVotePayload(message *chatpb.VoteRequest, token string) *chat.VotePayload {
+	v := &chat.VotePayload{
+		PollID: message.PollId,
+	}
+	if message.Options != nil {
+		v.Options = make([]int, len(message.Options))
+		for i, val := range message.Options {
+			v.Options[i] = int(val)
+		}
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoVoteResponse builds the gRPC response type from the result of the
+// "vote" endpoint of the "chat" service.
+func NewProtoVoteResponse(result *chat.Poll) *chatpb.VoteResponse {
+	message := &chatpb.VoteResponse{
+		Id:             result.ID,
+		Question:       result.Question,
+		MultipleChoice: result.MultipleChoice,
+		Anonymous:      result.Anonymous,
+		ClosesAt:       result.ClosesAt,
+		Closed:         result.Closed,
+	}
+	if result.Options != nil {
+		message.Options = make([]*chatpb.PollOption, len(result.Options))
+		for i, val := range result.Options {
+			message.Options[i] = &chatpb.PollOption{
+				Index: int32(val.Index),
+				Text:  val.Text,
+				Votes: int32(val.Votes),
+			}
+			if val.VoterIds != nil {
+				message.Options[i].VoterIds = make([]string, len(val.VoterIds))
+				for j, val := range val.VoterIds {
+					message.Options[i].VoterIds[j] = val
+				}
+			}
+		}
+	}
+	return message
+}
+
+// ValidateCreatePollRequest runs the validations defined on CreatePollRequest.
+func ValidateCreatePollRequest(message *chatpb.CreatePollRequest) (err error) {
+	if message.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "message"))
+	}
+	return
+}
+
+// ValidateVoteRequest runs the validations defined on VoteRequest.
+func ValidateVoteRequest(message *chatpb.VoteRequest) (err error) {
+	if message.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "message"))
+	}
+	return
+}
+
+// svcChatPollToChatpbPoll builds a value of type *chatpb.Poll from a value of
+// type *chat.Poll.
+func svcChatPollToChatpbPoll(v *chat.Poll) *chatpb.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &chatpb.Poll{
+		Id:             v.ID,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*chatpb.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &chatpb.PollOption{
+				Index: int32(val.Index),
+				Text:  val.Text,
+				Votes: int32(val.Votes),
+			}
+			if val.VoterIds != nil {
+				res.Options[i].VoterIds = make([]string, len(val.VoterIds))
+				for j, val := range val.VoterIds {
+					res.Options[i].VoterIds[j] = val
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// protobufChatpbPollToChatPoll builds a value of type *chat.Poll from a value
+// of type *chatpb.Poll.
+func protobufChatpbPollToChatPoll(v *chatpb.Poll) *chat.Poll {
+	if v == nil {
+		return nil
+	}
+	res := &chat.Poll{
+		ID:             v.Id,
+		Question:       v.Question,
+		MultipleChoice: v.MultipleChoice,
+		Anonymous:      v.Anonymous,
+		ClosesAt:       v.ClosesAt,
+		Closed:         v.Closed,
+	}
+	if v.Options != nil {
+		res.Options = make([]*chat.PollOption, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = &chat.PollOption{
+				Index: int(val.Index),
+				Text:  val.Text,
+				Votes: int(val.Votes),
+			}
+			if val.VoterIds != nil {
+				res.Options[i].VoterIds = make([]string, len(val.VoterIds))
+				for j, val := range val.VoterIds {
+					res.Options[i].VoterIds[j] = val
+				}
+			}
+		}
+	}
+
+	return res
+}
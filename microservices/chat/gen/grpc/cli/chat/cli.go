@@ -21,13 +21,13 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `chat (create-room|history|room-list|join-room|invite-room|stream-room)
+	return `chat (create-room|history|room-list|join-room|invite-room|stream-room|schedule-message|list-scheduled|cancel-scheduled|create-poll|vote)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` chat create-room --token "Totam provident reiciendis."` + "\n" +
+	return os.Args[0] + ` chat create-room --token "Earum assumenda sit molestiae illo voluptates atque."` + "\n" +
 		""
 }
 
@@ -61,6 +61,26 @@ func ParseEndpoint(
 		chatStreamRoomFlags      = flag.NewFlagSet("stream-room", flag.ExitOnError)
 		chatStreamRoomTokenFlag  = chatStreamRoomFlags.String("token", "REQUIRED", "")
 		chatStreamRoomRoomIDFlag = chatStreamRoomFlags.String("room-id", "REQUIRED", "")
+
+		chatScheduleMessageFlags       = flag.NewFlagSet("schedule-message", flag.ExitOnError)
+		chatScheduleMessageMessageFlag = chatScheduleMessageFlags.String("message", "", "")
+		chatScheduleMessageTokenFlag   = chatScheduleMessageFlags.String("token", "REQUIRED", "")
+
+		chatListScheduledFlags       = flag.NewFlagSet("list-scheduled", flag.ExitOnError)
+		chatListScheduledMessageFlag = chatListScheduledFlags.String("message", "", "")
+		chatListScheduledTokenFlag   = chatListScheduledFlags.String("token", "REQUIRED", "")
+
+		chatCancelScheduledFlags       = flag.NewFlagSet("cancel-scheduled", flag.ExitOnError)
+		chatCancelScheduledMessageFlag = chatCancelScheduledFlags.String("message", "", "")
+		chatCancelScheduledTokenFlag   = chatCancelScheduledFlags.String("token", "REQUIRED", "")
+
+		chatCreatePollFlags       = flag.NewFlagSet("create-poll", flag.ExitOnError)
+		chatCreatePollMessageFlag = chatCreatePollFlags.String("message", "", "")
+		chatCreatePollTokenFlag   = chatCreatePollFlags.String("token", "REQUIRED", "")
+
+		chatVoteFlags       = flag.NewFlagSet("vote", flag.ExitOnError)
+		chatVoteMessageFlag = chatVoteFlags.String("message", "", "")
+		chatVoteTokenFlag   = chatVoteFlags.String("token", "REQUIRED", "")
 	)
 	chatFlags.Usage = chatUsage
 	chatCreateRoomFlags.Usage = chatCreateRoomUsage
@@ -69,6 +89,11 @@ func ParseEndpoint(
 	chatJoinRoomFlags.Usage = chatJoinRoomUsage
 	chatInviteRoomFlags.Usage = chatInviteRoomUsage
 	chatStreamRoomFlags.Usage = chatStreamRoomUsage
+	chatScheduleMessageFlags.Usage = chatScheduleMessageUsage
+	chatListScheduledFlags.Usage = chatListScheduledUsage
+	chatCancelScheduledFlags.Usage = chatCancelScheduledUsage
+	chatCreatePollFlags.Usage = chatCreatePollUsage
+	chatVoteFlags.Usage = chatVoteUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -122,6 +147,21 @@ func ParseEndpoint(
 			case "stream-room":
 				epf = chatStreamRoomFlags
 
+			case "schedule-message":
+				epf = chatScheduleMessageFlags
+
+			case "list-scheduled":
+				epf = chatListScheduledFlags
+
+			case "cancel-scheduled":
+				epf = chatCancelScheduledFlags
+
+			case "create-poll":
+				epf = chatCreatePollFlags
+
+			case "vote":
+				epf = chatVoteFlags
+
 			}
 
 		}
@@ -165,6 +205,21 @@ func ParseEndpoint(
 			case "stream-room":
 				endpoint = c.StreamRoom()
 				data, err = chatc.BuildStreamRoomPayload(*chatStreamRoomTokenFlag, *chatStreamRoomRoomIDFlag)
+			case "schedule-message":
+				endpoint = c.ScheduleMessage()
+				data, err = chatc.BuildScheduleMessagePayload(*chatScheduleMessageMessageFlag, *chatScheduleMessageTokenFlag)
+			case "list-scheduled":
+				endpoint = c.ListScheduled()
+				data, err = chatc.BuildListScheduledPayload(*chatListScheduledMessageFlag, *chatListScheduledTokenFlag)
+			case "cancel-scheduled":
+				endpoint = c.CancelScheduled()
+				data, err = chatc.BuildCancelScheduledPayload(*chatCancelScheduledMessageFlag, *chatCancelScheduledTokenFlag)
+			case "create-poll":
+				endpoint = c.CreatePoll()
+				data, err = chatc.BuildCreatePollPayload(*chatCreatePollMessageFlag, *chatCreatePollTokenFlag)
+			case "vote":
+				endpoint = c.Vote()
+				data, err = chatc.BuildVotePayload(*chatVoteMessageFlag, *chatVoteTokenFlag)
 			}
 		}
 	}
@@ -188,6 +243,11 @@ COMMAND:
     join-room: Creates a new chat room
     invite-room: Creates a new chat room
     stream-room: Streams chat room events on a chat room
+    schedule-message: Schedules a message to be sent to a chat room later
+    list-scheduled: Lists the messages scheduled by the user
+    cancel-scheduled: Cancels a scheduled message before it is sent
+    create-poll: Posts a poll in a chat room
+    vote: Votes on a poll
 
 Additional help:
     %[1]s chat COMMAND --help
@@ -200,7 +260,7 @@ Creates a new chat room
     -token STRING: 
 
 Example:
-    %[1]s chat create-room --token "Totam provident reiciendis."
+    %[1]s chat create-room --token "Earum assumenda sit molestiae illo voluptates atque."
 `, os.Args[0])
 }
 
@@ -213,8 +273,8 @@ Get all chat rooms history
 
 Example:
     %[1]s chat history --message '{
-      "room_id": "Rerum sapiente reiciendis nobis et voluptatem."
-   }' --token "Corrupti libero."
+      "room_id": "Natus dolores accusamus non dolorem veritatis."
+   }' --token "At facere distinctio."
 `, os.Args[0])
 }
 
@@ -225,7 +285,7 @@ Get all chat rooms history
     -token STRING: 
 
 Example:
-    %[1]s chat room-list --token "Explicabo est sed labore."
+    %[1]s chat room-list --token "A ex unde similique."
 `, os.Args[0])
 }
 
@@ -238,8 +298,8 @@ Creates a new chat room
 
 Example:
     %[1]s chat join-room --message '{
-      "invite_key": "Enim voluptas."
-   }' --token "Quae reprehenderit voluptas esse quis laboriosam."
+      "invite_key": "Omnis voluptatem esse commodi aperiam ea ipsum."
+   }' --token "Eos beatae amet quia."
 `, os.Args[0])
 }
 
@@ -252,9 +312,9 @@ Creates a new chat room
 
 Example:
     %[1]s chat invite-room --message '{
-      "room_id": "Sed ipsam.",
-      "user_id": "Culpa laudantium qui esse non dolorem et."
-   }' --token "Rerum nam suscipit."
+      "room_id": "Ut laboriosam.",
+      "user_id": "Optio ut."
+   }' --token "Illum sed."
 `, os.Args[0])
 }
 
@@ -266,6 +326,90 @@ Streams chat room events on a chat room
     -room-id STRING: 
 
 Example:
-    %[1]s chat stream-room --token "Accusamus sunt." --room-id "Qui sit."
+    %[1]s chat stream-room --token "Nobis aut velit ad voluptates." --room-id "Quo ex ex beatae fugit consequatur recusandae."
+`, os.Args[0])
+}
+
+func chatScheduleMessageUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] chat schedule-message -message JSON -token STRING
+
+Schedules a message to be sent to a chat room later
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s chat schedule-message --message '{
+      "message": "Sequi dolores placeat ad.",
+      "room_id": "Omnis corrupti non ratione molestias.",
+      "send_at": 7347101748433722016
+   }' --token "Perspiciatis dignissimos consequuntur rerum consequuntur quos."
+`, os.Args[0])
+}
+
+func chatListScheduledUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] chat list-scheduled -message JSON -token STRING
+
+Lists the messages scheduled by the user
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s chat list-scheduled --message '{
+      "room_id": "Nihil molestias unde voluptas."
+   }' --token "Corporis laborum sit sit quo ratione."
+`, os.Args[0])
+}
+
+func chatCancelScheduledUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] chat cancel-scheduled -message JSON -token STRING
+
+Cancels a scheduled message before it is sent
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s chat cancel-scheduled --message '{
+      "id": "Perspiciatis optio beatae qui quae unde vel."
+   }' --token "Explicabo harum commodi sed quisquam impedit eaque."
+`, os.Args[0])
+}
+
+func chatCreatePollUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] chat create-poll -message JSON -token STRING
+
+Posts a poll in a chat room
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s chat create-poll --message '{
+      "anonymous": false,
+      "closes_at": 2886035326489961491,
+      "multiple_choice": false,
+      "options": [
+         "Iusto occaecati.",
+         "Porro voluptatem repudiandae recusandae repellendus."
+      ],
+      "question": "A recusandae est in.",
+      "room_id": "Rerum dignissimos iusto quam doloribus ullam qui."
+   }' --token "Necessitatibus eum a at velit."
+`, os.Args[0])
+}
+
+func chatVoteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] chat vote -message JSON -token STRING
+
+Votes on a poll
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s chat vote --message '{
+      "options": [
+         2746982251438003805,
+         3973790490981430682
+      ],
+      "poll_id": "Voluptas qui nesciunt."
+   }' --token "Facere aut quibusdam saepe praesentium."
 `, os.Args[0])
 }
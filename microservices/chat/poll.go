@@ -0,0 +1,235 @@
+package chatapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+)
+
+const maxPollOptions = 10
+
+// PollRecord is the stored definition of a poll. Votes are stored apart from
+// it, by user.
+type PollRecord struct {
+	ID             string
+	RoomID         string
+	MessageID      string
+	UserID         string
+	Question       string
+	Options        []string
+	MultipleChoice bool
+	Anonymous      bool
+	ClosesAt       *int64
+	CreatedAt      int64
+}
+
+func (e *PollRecord) closed() bool {
+	return e.ClosesAt != nil && time.Now().Unix() >= *e.ClosesAt
+}
+
+func (s *chatsrvc) CreatePoll(ctx context.Context, p *chat.CreatePollPayload) (res *chat.Chat, err error) {
+	log.Printf(ctx, "chat.create-poll")
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, chat.Unauthorized("user not authenticated")
+	}
+
+	if p.Question == "" {
+		return nil, chat.InvalidArgument("question must not be empty")
+	}
+	if len(p.Options) < 2 || len(p.Options) > maxPollOptions {
+		return nil, chat.InvalidArgument(fmt.Sprintf("a poll needs between 2 and %d options", maxPollOptions))
+	}
+	for _, option := range p.Options {
+		if option == "" {
+			return nil, chat.InvalidArgument("options must not be empty")
+		}
+	}
+	now := time.Now().Unix()
+	if p.ClosesAt != nil && *p.ClosesAt <= now {
+		return nil, chat.InvalidArgument("closes_at must be in the future")
+	}
+	if err := s.checkMember(ctx, "chat.create_poll", p.RoomID, userID); err != nil {
+		return nil, err
+	}
+
+	entry := &PollRecord{
+		ID:             uuid.NewString(),
+		RoomID:         p.RoomID,
+		MessageID:      uuid.NewString(),
+		UserID:         userID,
+		Question:       p.Question,
+		Options:        p.Options,
+		MultipleChoice: p.MultipleChoice != nil && *p.MultipleChoice,
+		Anonymous:      p.Anonymous != nil && *p.Anonymous,
+		ClosesAt:       p.ClosesAt,
+		CreatedAt:      now,
+	}
+
+	if err := s.store.SavePoll(ctx, entry); err != nil {
+		log.Print(ctx, log.KV{"chat.create_poll", "ERROR: failed to save poll"}, log.KV{"error", err.Error()})
+		return nil, chat.Internal("Internal server error")
+	}
+
+	res = &chat.Chat{
+		ID:        entry.MessageID,
+		RoomID:    entry.RoomID,
+		UserID:    userID,
+		Message:   entry.Question,
+		Poll:      tallyPoll(entry, nil),
+		Kind:      kind(kindPoll),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.store.Publish(ctx, res); err != nil {
+		log.Print(ctx, log.KV{"chat.create_poll", "ERROR: publish failed"}, log.KV{"error", err.Error()})
+		return nil, chat.Internal("Internal server error")
+	}
+
+	return res, nil
+}
+
+func (s *chatsrvc) Vote(ctx context.Context, p *chat.VotePayload) (res *chat.Poll, err error) {
+	log.Printf(ctx, "chat.vote")
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, chat.Unauthorized("user not authenticated")
+	}
+
+	entry, err := s.store.GetPoll(ctx, p.PollID)
+	if err != nil {
+		log.Print(ctx, log.KV{"chat.vote", "ERROR: failed to load poll"}, log.KV{"error", err.Error()})
+		return nil, chat.Internal("Internal server error")
+	}
+	if entry == nil {
+		return nil, chat.Notfound("poll not found")
+	}
+	if err := s.checkMember(ctx, "chat.vote", entry.RoomID, userID); err != nil {
+		return nil, err
+	}
+	if entry.closed() {
+		return nil, chat.InvalidArgument("poll is closed")
+	}
+
+	choices, err := validateChoices(entry, p.Options)
+	if err != nil {
+		return nil, chat.InvalidArgument(err.Error())
+	}
+
+	// A new vote replaces the previous one of the same user.
+	if err := s.store.SaveVote(ctx, entry.ID, userID, choices); err != nil {
+		log.Print(ctx, log.KV{"chat.vote", "ERROR: failed to save vote"}, log.KV{"error", err.Error()})
+		return nil, chat.Internal("Internal server error")
+	}
+
+	res, err = s.pollWithVotes(ctx, entry)
+	if err != nil {
+		log.Print(ctx, log.KV{"chat.vote", "ERROR: failed to load votes"}, log.KV{"error", err.Error()})
+		return nil, chat.Internal("Internal server error")
+	}
+
+	update := &chat.Chat{
+		ID:        entry.MessageID,
+		RoomID:    entry.RoomID,
+		UserID:    entry.UserID,
+		Message:   entry.Question,
+		Poll:      res,
+		Kind:      kind(kindPollUpdate),
+		CreatedAt: entry.CreatedAt,
+		UpdatedAt: time.Now().Unix(),
+	}
+	if err := s.store.Broadcast(ctx, update); err != nil {
+		log.Print(ctx, log.KV{"chat.vote", "ERROR: broadcast failed"}, log.KV{"error", err.Error()})
+	}
+
+	return res, nil
+}
+
+// currentPoll returns the poll with its current tally, nil if it does not
+// exist anymore.
+func (s *chatsrvc) currentPoll(ctx context.Context, id string) (*chat.Poll, error) {
+	entry, err := s.store.GetPoll(ctx, id)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return s.pollWithVotes(ctx, entry)
+}
+
+func (s *chatsrvc) pollWithVotes(ctx context.Context, entry *PollRecord) (*chat.Poll, error) {
+	votes, err := s.store.PollVotes(ctx, entry.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tallyPoll(entry, votes), nil
+}
+
+// validateChoices checks the chosen option indexes against the poll and
+// returns them deduplicated.
+func validateChoices(entry *PollRecord, options []int) ([]int, error) {
+	if len(options) == 0 {
+		return nil, fmt.Errorf("at least one option must be chosen")
+	}
+
+	seen := make(map[int]bool, len(options))
+	choices := make([]int, 0, len(options))
+	for _, option := range options {
+		if option < 0 || option >= len(entry.Options) {
+			return nil, fmt.Errorf("unknown option %d", option)
+		}
+		if seen[option] {
+			continue
+		}
+		seen[option] = true
+		choices = append(choices, option)
+	}
+
+	if !entry.MultipleChoice && len(choices) > 1 {
+		return nil, fmt.Errorf("only one option may be chosen")
+	}
+
+	return choices, nil
+}
+
+// tallyPoll counts the votes per option. Voters are only listed for polls
+// that are not anonymous.
+func tallyPoll(entry *PollRecord, votes map[string][]int) *chat.Poll {
+	poll := &chat.Poll{
+		ID:             entry.ID,
+		Question:       entry.Question,
+		Options:        make([]*chat.PollOption, len(entry.Options)),
+		MultipleChoice: entry.MultipleChoice,
+		Anonymous:      entry.Anonymous,
+		ClosesAt:       entry.ClosesAt,
+		Closed:         entry.closed(),
+	}
+	for i, text := range entry.Options {
+		poll.Options[i] = &chat.PollOption{Index: i, Text: text}
+	}
+
+	userIDs := make([]string, 0, len(votes))
+	for userID := range votes {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+
+	for _, userID := range userIDs {
+		for _, choice := range votes[userID] {
+			if choice < 0 || choice >= len(poll.Options) {
+				continue
+			}
+			option := poll.Options[choice]
+			option.Votes++
+			if !entry.Anonymous {
+				option.VoterIds = append(option.VoterIds, userID)
+			}
+		}
+	}
+
+	return poll
+}
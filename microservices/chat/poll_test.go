@@ -0,0 +1,177 @@
+package chatapi
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+)
+
+func TestCreatePollValidation(t *testing.T) {
+	past := time.Now().Add(-time.Minute).Unix()
+	cases := []struct {
+		name string
+		p    *chat.CreatePollPayload
+	}{
+		{"no question", &chat.CreatePollPayload{RoomID: "room-1", Options: []string{"a", "b"}}},
+		{"one option", &chat.CreatePollPayload{RoomID: "room-1", Question: "q", Options: []string{"a"}}},
+		{"too many options", &chat.CreatePollPayload{RoomID: "room-1", Question: "q", Options: make([]string, maxPollOptions+1)}},
+		{"empty option", &chat.CreatePollPayload{RoomID: "room-1", Question: "q", Options: []string{"a", ""}}},
+		{"closed", &chat.CreatePollPayload{RoomID: "room-1", Question: "q", Options: []string{"a", "b"}, ClosesAt: &past}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := newTestService(t)
+			if _, err := s.CreatePoll(userContext("alice", ""), c.p); errorName(err) != "invalid_argument" {
+				t.Errorf("err = %v, want invalid_argument", err)
+			}
+		})
+	}
+}
+
+func TestPollVotes(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	joinRoom(t, s, ctx, "room-1")
+	joinRoom(t, s, userContext("bob", ""), "room-1")
+	sub, err := s.store.Subscribe(ctx, "room-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+
+	msg, err := s.CreatePoll(ctx, &chat.CreatePollPayload{RoomID: "room-1", Question: "Lunch?", Options: []string{"ramen", "sushi", "curry"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if posted := <-sub.Channel(); *posted.Kind != kindPoll || posted.Poll.ID != msg.Poll.ID {
+		t.Errorf("posted = %+v, want the poll", posted)
+	}
+
+	votes := []struct {
+		userID  string
+		options []int
+	}{
+		{"alice", []int{0}},
+		{"bob", []int{1}},
+		// A new vote replaces the previous one.
+		{"alice", []int{1}},
+	}
+	for _, v := range votes {
+		if _, err := s.Vote(userContext(v.userID, ""), &chat.VotePayload{PollID: msg.Poll.ID, Options: v.options}); err != nil {
+			t.Fatal(err)
+		}
+		if update := <-sub.Channel(); *update.Kind != kindPollUpdate || update.ID != msg.ID {
+			t.Errorf("update = %+v, want the update of message %s", update, msg.ID)
+		}
+	}
+
+	history, err := s.History(ctx, &chat.HistoryPayload{RoomID: "room-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Poll == nil {
+		t.Fatalf("history = %v, want the poll", history)
+	}
+	// History returns the current tally, not the snapshot posted.
+	options := history[0].Poll.Options
+	if options[0].Votes != 0 || options[1].Votes != 2 || options[2].Votes != 0 {
+		t.Errorf("votes = %d, %d, %d, want 0, 2, 0", options[0].Votes, options[1].Votes, options[2].Votes)
+	}
+	if !slices.Equal(options[1].VoterIds, []string{"alice", "bob"}) {
+		t.Errorf("voters = %v, want alice, bob", options[1].VoterIds)
+	}
+}
+
+func TestVoteValidation(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	joinRoom(t, s, ctx, "room-1")
+	single, err := s.CreatePoll(ctx, &chat.CreatePollPayload{RoomID: "room-1", Question: "q", Options: []string{"a", "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		options []int
+	}{
+		{"no option", nil},
+		{"unknown option", []int{2}},
+		{"negative option", []int{-1}},
+		{"several options", []int{0, 1}},
+	}
+	for _, c := range cases {
+		if _, err := s.Vote(ctx, &chat.VotePayload{PollID: single.Poll.ID, Options: c.options}); errorName(err) != "invalid_argument" {
+			t.Errorf("%s: err = %v, want invalid_argument", c.name, err)
+		}
+	}
+
+	if _, err := s.Vote(ctx, &chat.VotePayload{PollID: "unknown", Options: []int{0}}); errorName(err) != "notfound" {
+		t.Errorf("vote on an unknown poll: err = %v, want notfound", err)
+	}
+	// Polls are scoped to the workspace they were posted in.
+	if _, err := s.Vote(userContext("alice", "w-1"), &chat.VotePayload{PollID: single.Poll.ID, Options: []int{0}}); errorName(err) != "notfound" {
+		t.Errorf("vote from another workspace: err = %v, want notfound", err)
+	}
+}
+
+func TestVoteMultipleChoiceAnonymous(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	joinRoom(t, s, ctx, "room-1")
+	msg, err := s.CreatePoll(ctx, &chat.CreatePollPayload{
+		RoomID:         "room-1",
+		Question:       "q",
+		Options:        []string{"a", "b"},
+		MultipleChoice: ptr(true),
+		Anonymous:      ptr(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Duplicate choices count once.
+	poll, err := s.Vote(ctx, &chat.VotePayload{PollID: msg.Poll.ID, Options: []int{0, 1, 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, option := range poll.Options {
+		if option.Votes != 1 {
+			t.Errorf("option %d has %d votes, want 1", option.Index, option.Votes)
+		}
+		if len(option.VoterIds) != 0 {
+			t.Errorf("anonymous poll lists voters %v", option.VoterIds)
+		}
+	}
+}
+
+func TestVoteOnClosedPoll(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	joinRoom(t, s, ctx, "room-1")
+	closesAt := time.Now().Add(-time.Second).Unix()
+	record := &PollRecord{ID: "poll-1", RoomID: "room-1", MessageID: "m-1", Question: "q", Options: []string{"a", "b"}, ClosesAt: &closesAt}
+	if err := s.store.SavePoll(ctx, record); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Vote(ctx, &chat.VotePayload{PollID: "poll-1", Options: []int{0}}); errorName(err) != "invalid_argument" {
+		t.Errorf("err = %v, want invalid_argument", err)
+	}
+}
+
+func TestVoteRequiresMembership(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	joinRoom(t, s, ctx, "room-1")
+	msg, err := s.CreatePoll(ctx, &chat.CreatePollPayload{RoomID: "room-1", Question: "q", Options: []string{"a", "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Vote(userContext("bob", ""), &chat.VotePayload{PollID: msg.Poll.ID, Options: []int{0}}); errorName(err) != "permission-denied" {
+		t.Errorf("vote by a non-member: err = %v, want permission-denied", err)
+	}
+}
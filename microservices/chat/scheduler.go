@@ -0,0 +1,187 @@
+package chatapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"goa.design/clue/log"
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+)
+
+const (
+	schedulerInterval  = time.Second
+	schedulerBatchSize = 100
+	// schedulerLease is how long a claimed message has to be published before
+	// it is delivered again, in case its replica died.
+	schedulerLease = time.Minute
+)
+
+func (s *chatsrvc) ScheduleMessage(ctx context.Context, p *chat.ScheduleMessagePayload) (res *chat.ScheduledMessage, err error) {
+	log.Printf(ctx, "chat.schedule-message")
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, chat.Unauthorized("user not authenticated")
+	}
+
+	if p.Message == "" {
+		return nil, chat.InvalidArgument("message must not be empty")
+	}
+	now := time.Now().Unix()
+	if p.SendAt <= now {
+		return nil, chat.InvalidArgument("send_at must be in the future")
+	}
+	if err := s.checkMember(ctx, "chat.schedule_message", p.RoomID, userID); err != nil {
+		return nil, err
+	}
+
+	res = &chat.ScheduledMessage{
+		ID:        uuid.NewString(),
+		RoomID:    p.RoomID,
+		UserID:    userID,
+		Message:   p.Message,
+		SendAt:    p.SendAt,
+		CreatedAt: now,
+	}
+
+	if err := s.store.SaveScheduled(ctx, res); err != nil {
+		log.Print(ctx, log.KV{"chat.schedule_message", "ERROR: failed to save scheduled message"}, log.KV{"error", err.Error()})
+		return nil, chat.Internal("Internal server error")
+	}
+
+	return res, nil
+}
+
+func (s *chatsrvc) ListScheduled(ctx context.Context, p *chat.ListScheduledPayload) (res []*chat.ScheduledMessage, err error) {
+	log.Printf(ctx, "chat.list-scheduled")
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, chat.Unauthorized("user not authenticated")
+	}
+
+	scheduledMessages, err := s.store.UserScheduled(ctx, userID)
+	if err != nil {
+		log.Print(ctx, log.KV{"chat.list_scheduled", "ERROR: failed to load scheduled messages"}, log.KV{"error", err.Error()})
+		return nil, chat.Internal("Internal server error")
+	}
+
+	res = make([]*chat.ScheduledMessage, 0, len(scheduledMessages))
+	for _, scheduled := range scheduledMessages {
+		if p.RoomID != nil && scheduled.RoomID != *p.RoomID {
+			continue
+		}
+		res = append(res, scheduled)
+	}
+
+	return res, nil
+}
+
+func (s *chatsrvc) CancelScheduled(ctx context.Context, p *chat.CancelScheduledPayload) (res string, err error) {
+	log.Printf(ctx, "chat.cancel-scheduled")
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return "", chat.Unauthorized("user not authenticated")
+	}
+
+	scheduled, err := s.store.GetScheduled(ctx, p.ID)
+	if err != nil {
+		log.Print(ctx, log.KV{"chat.cancel_scheduled", "ERROR: failed to load scheduled message"}, log.KV{"error", err.Error()})
+		return "", chat.Internal("Internal server error")
+	}
+	if scheduled == nil {
+		return "", chat.Notfound("scheduled message not found")
+	}
+	if scheduled.UserID != userID {
+		return "", chat.PermissionDenied("scheduled message belongs to another user")
+	}
+
+	// Claiming the message races with the scheduler, whoever claims it first
+	// owns the message.
+	claimed, err := s.store.ClaimScheduled(ctx, p.ID, schedulerLease)
+	if err != nil {
+		log.Print(ctx, log.KV{"chat.cancel_scheduled", "ERROR: failed to claim scheduled message"}, log.KV{"error", err.Error()})
+		return "", chat.Internal("Internal server error")
+	}
+	if !claimed {
+		return "", chat.Notfound("scheduled message already sent")
+	}
+
+	if err := s.store.DeleteScheduled(ctx, scheduled); err != nil {
+		log.Print(ctx, log.KV{"chat.cancel_scheduled", "ERROR: failed to delete scheduled message"}, log.KV{"error", err.Error()})
+		return "", chat.Internal("Internal server error")
+	}
+
+	return p.ID, nil
+}
+
+// runScheduler periodically publishes the scheduled messages that are due.
+// Every replica runs the loop; claiming a message in the store guarantees
+// that only one of them delivers it. The message is only deleted once
+// published, a replica dying in between leaves it to be delivered again when
+// its claim expires.
+func (s *chatsrvc) runScheduler(ctx context.Context) {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.dispatchScheduled(ctx)
+		}
+	}
+}
+
+func (s *chatsrvc) dispatchScheduled(ctx context.Context) {
+	keys, err := s.store.DueScheduled(ctx, time.Now().Unix(), schedulerBatchSize)
+	if err != nil {
+		log.Print(ctx, log.KV{"chat.scheduler", "ERROR: failed to load due messages"}, log.KV{"error", err.Error()})
+		return
+	}
+
+	for _, key := range keys {
+		ctx, id := splitWorkspaceKey(ctx, key)
+		claimed, err := s.store.ClaimScheduled(ctx, id, schedulerLease)
+		if err != nil {
+			log.Print(ctx, log.KV{"chat.scheduler", "ERROR: failed to claim scheduled message"}, log.KV{"error", err.Error()})
+			continue
+		}
+		if !claimed {
+			// Another replica or a cancellation got there first.
+			continue
+		}
+
+		scheduled, err := s.store.GetScheduled(ctx, id)
+		if err != nil {
+			log.Print(ctx, log.KV{"chat.scheduler", "ERROR: failed to load scheduled message"}, log.KV{"id", id}, log.KV{"error", err.Error()})
+			continue
+		}
+		if scheduled == nil {
+			// Drop the claim of a message that does not exist anymore.
+			s.store.DeleteScheduled(ctx, &chat.ScheduledMessage{ID: id})
+			continue
+		}
+
+		now := time.Now().Unix()
+		newChat := chat.Chat{
+			ID:        uuid.NewString(),
+			RoomID:    scheduled.RoomID,
+			UserID:    scheduled.UserID,
+			Message:   scheduled.Message,
+			Kind:      kind(kindMessage),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := s.store.Publish(ctx, &newChat); err != nil {
+			log.Print(ctx, log.KV{"chat.scheduler", "ERROR: publish failed"}, log.KV{"id", id}, log.KV{"error", err.Error()})
+			// Put the message back so that the next tick retries it.
+			s.store.RequeueScheduled(ctx, scheduled)
+			continue
+		}
+
+		if err := s.store.DeleteScheduled(ctx, scheduled); err != nil {
+			log.Print(ctx, log.KV{"chat.scheduler", "ERROR: failed to delete scheduled message"}, log.KV{"id", id}, log.KV{"error", err.Error()})
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package chatapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+)
+
+// saveDue saves a scheduled message of the user that is already due.
+func saveDue(t *testing.T, s *chatsrvc, ctx context.Context, id, roomID, message string) *chat.ScheduledMessage {
+	t.Helper()
+	msg := &chat.ScheduledMessage{
+		ID:        id,
+		RoomID:    roomID,
+		UserID:    ctx.Value("user_id").(string),
+		Message:   message,
+		SendAt:    time.Now().Add(-time.Second).Unix(),
+		CreatedAt: time.Now().Add(-time.Minute).Unix(),
+	}
+	if err := s.store.SaveScheduled(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// history returns the messages of the room, joining it first.
+func history(t *testing.T, s *chatsrvc, ctx context.Context, roomID string) []*chat.Chat {
+	t.Helper()
+	joinRoom(t, s, ctx, roomID)
+	res, err := s.History(ctx, &chat.HistoryPayload{RoomID: roomID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestScheduleMessageValidation(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+
+	cases := []struct {
+		name string
+		p    *chat.ScheduleMessagePayload
+	}{
+		{"empty message", &chat.ScheduleMessagePayload{RoomID: "room-1", SendAt: time.Now().Add(time.Hour).Unix()}},
+		{"in the past", &chat.ScheduleMessagePayload{RoomID: "room-1", Message: "hi", SendAt: time.Now().Add(-time.Second).Unix()}},
+	}
+	for _, c := range cases {
+		if _, err := s.ScheduleMessage(ctx, c.p); errorName(err) != "invalid_argument" {
+			t.Errorf("%s: err = %v, want invalid_argument", c.name, err)
+		}
+	}
+}
+
+func TestScheduleAndList(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	sendAt := time.Now().Add(time.Hour).Unix()
+
+	for _, roomID := range []string{"room-1", "room-2"} {
+		joinRoom(t, s, ctx, roomID)
+		res, err := s.ScheduleMessage(ctx, &chat.ScheduleMessagePayload{RoomID: roomID, Message: "later", SendAt: sendAt})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.UserID != "alice" || res.SendAt != sendAt {
+			t.Errorf("scheduled = %+v", res)
+		}
+	}
+
+	all, err := s.ListScheduled(ctx, &chat.ListScheduledPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Errorf("listed %d messages, want 2", len(all))
+	}
+	inRoom, err := s.ListScheduled(ctx, &chat.ListScheduledPayload{RoomID: ptr("room-1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inRoom) != 1 || inRoom[0].RoomID != "room-1" {
+		t.Errorf("listed %v, want the message of room-1", inRoom)
+	}
+	others, err := s.ListScheduled(userContext("bob", ""), &chat.ListScheduledPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(others) != 0 {
+		t.Errorf("bob lists the messages of alice: %v", others)
+	}
+
+	// Messages that are not due yet are left alone.
+	s.dispatchScheduled(context.Background())
+	if got := history(t, s, ctx, "room-1"); len(got) != 0 {
+		t.Errorf("message sent before it was due: %v", got)
+	}
+}
+
+func TestDispatchScheduled(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	workspaceCtx := userContext("alice", "w-1")
+	saveDue(t, s, ctx, "s-1", "room-1", "first")
+	saveDue(t, s, workspaceCtx, "s-2", "room-1", "in workspace")
+
+	s.dispatchScheduled(context.Background())
+
+	// Each message is published in the workspace it was scheduled in.
+	for c, want := range map[context.Context]string{ctx: "first", workspaceCtx: "in workspace"} {
+		got := history(t, s, c, "room-1")
+		if len(got) != 1 || got[0].Message != want || got[0].UserID != "alice" {
+			t.Fatalf("history = %v, want %q", got, want)
+		}
+	}
+	for c, id := range map[context.Context]string{ctx: "s-1", workspaceCtx: "s-2"} {
+		if scheduled, _ := s.store.GetScheduled(c, id); scheduled != nil {
+			t.Errorf("%s kept after it was sent", id)
+		}
+	}
+
+	// A message is only sent once.
+	s.dispatchScheduled(context.Background())
+	if got := history(t, s, ctx, "room-1"); len(got) != 1 {
+		t.Errorf("history has %d messages after a second dispatch, want 1", len(got))
+	}
+}
+
+func TestCancelScheduled(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	saveDue(t, s, ctx, "s-1", "room-1", "never")
+
+	if _, err := s.CancelScheduled(userContext("bob", ""), &chat.CancelScheduledPayload{ID: "s-1"}); errorName(err) != "permission-denied" {
+		t.Errorf("cancel by another user: err = %v, want permission-denied", err)
+	}
+	if _, err := s.CancelScheduled(ctx, &chat.CancelScheduledPayload{ID: "s-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CancelScheduled(ctx, &chat.CancelScheduledPayload{ID: "s-1"}); errorName(err) != "notfound" {
+		t.Errorf("second cancel: err = %v, want notfound", err)
+	}
+
+	s.dispatchScheduled(context.Background())
+	if got := history(t, s, ctx, "room-1"); len(got) != 0 {
+		t.Errorf("canceled message sent: %v", got)
+	}
+}
+
+func TestDispatchRedeliversExpiredClaim(t *testing.T) {
+	s := newTestService(t)
+	ctx := userContext("alice", "")
+	saveDue(t, s, ctx, "s-1", "room-1", "eventually")
+
+	// A replica claimed the message and is still publishing it.
+	if claimed, err := s.store.ClaimScheduled(ctx, "s-1", time.Hour); err != nil || !claimed {
+		t.Fatalf("claim = %v, %v", claimed, err)
+	}
+	s.dispatchScheduled(context.Background())
+	if got := history(t, s, ctx, "room-1"); len(got) != 0 {
+		t.Fatalf("message claimed by another replica sent: %v", got)
+	}
+	if _, err := s.CancelScheduled(ctx, &chat.CancelScheduledPayload{ID: "s-1"}); errorName(err) != "notfound" {
+		t.Errorf("cancel of a claimed message: err = %v, want notfound", err)
+	}
+
+	// The replica died, its claim expires.
+	if err := s.store.RequeueScheduled(ctx, &chat.ScheduledMessage{ID: "s-1", SendAt: time.Now().Unix()}); err != nil {
+		t.Fatal(err)
+	}
+	if claimed, err := s.store.ClaimScheduled(ctx, "s-1", -time.Second); err != nil || !claimed {
+		t.Fatalf("claim = %v, %v", claimed, err)
+	}
+	s.dispatchScheduled(context.Background())
+	if got := history(t, s, ctx, "room-1"); len(got) != 1 || got[0].Message != "eventually" {
+		t.Fatalf("history = %v, want the message delivered again", got)
+	}
+	if scheduled, _ := s.store.GetScheduled(ctx, "s-1"); scheduled != nil {
+		t.Error("message kept after it was sent")
+	}
+}
@@ -0,0 +1,83 @@
+package chatapi
+
+import (
+	"context"
+	"time"
+
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+)
+
+// ChatStore persists the chat state and fans messages out to the streams
+// subscribed to a room. Lookups return nil without error when the requested
+// item does not exist.
+type ChatStore interface {
+	// CreateRoom registers a new room.
+	CreateRoom(ctx context.Context, roomID string) error
+	// AddMember adds the room to the rooms of the user.
+	AddMember(ctx context.Context, roomID, userID string) error
+	// UserRooms returns the rooms the user is a member of.
+	UserRooms(ctx context.Context, userID string) ([]string, error)
+	// IsMember reports whether the user is a member of the room.
+	IsMember(ctx context.Context, roomID, userID string) (bool, error)
+
+	// SaveInvite records an invitation of the user to the room.
+	SaveInvite(ctx context.Context, inviteID, userID, roomID string) error
+	// InvitedRoom returns the room the user was invited to with inviteID, an
+	// empty string if there is no such invitation.
+	InvitedRoom(ctx context.Context, inviteID, userID string) (string, error)
+
+	// History returns the messages of the room, newest first.
+	History(ctx context.Context, roomID string) ([]*chat.Chat, error)
+	// Publish appends the message to the room history and fans it out.
+	Publish(ctx context.Context, msg *chat.Chat) error
+	// Broadcast fans the event out without recording it in the history.
+	Broadcast(ctx context.Context, event *chat.Chat) error
+	// Subscribe returns a subscription to the messages published to the room.
+	Subscribe(ctx context.Context, roomID string) (Subscription, error)
+
+	// ClaimClientID records the client ID of msg for ttl. It returns the
+	// message previously recorded with the same client ID, nil if msg is the
+	// first one.
+	ClaimClientID(ctx context.Context, msg *chat.Chat, ttl time.Duration) (*chat.Chat, error)
+	// ReleaseClientID forgets the client ID of msg.
+	ReleaseClientID(ctx context.Context, msg *chat.Chat) error
+
+	// SaveScheduled stores the message and queues it for delivery.
+	SaveScheduled(ctx context.Context, msg *chat.ScheduledMessage) error
+	// GetScheduled returns the scheduled message.
+	GetScheduled(ctx context.Context, id string) (*chat.ScheduledMessage, error)
+	// UserScheduled returns the messages scheduled by the user.
+	UserScheduled(ctx context.Context, userID string) ([]*chat.ScheduledMessage, error)
+	// DueScheduled returns up to limit IDs of queued messages due at until,
+	// in every workspace. The IDs are scoped to their workspace. Claimed
+	// messages whose lease expired at until are queued again first.
+	DueScheduled(ctx context.Context, until int64, limit int) ([]string, error)
+	// ClaimScheduled moves the message from the delivery queue to the claimed
+	// messages for lease. It reports false if the message was not queued
+	// anymore, so that concurrent callers never both own it. A message that is
+	// not deleted before its lease expires is delivered again.
+	ClaimScheduled(ctx context.Context, id string, lease time.Duration) (bool, error)
+	// RequeueScheduled puts a claimed message back in the delivery queue.
+	RequeueScheduled(ctx context.Context, msg *chat.ScheduledMessage) error
+	// DeleteScheduled removes the scheduled message and its claim.
+	DeleteScheduled(ctx context.Context, msg *chat.ScheduledMessage) error
+
+	// SavePoll stores the poll definition.
+	SavePoll(ctx context.Context, poll *PollRecord) error
+	// GetPoll returns the poll definition.
+	GetPoll(ctx context.Context, id string) (*PollRecord, error)
+	// SaveVote records the options chosen by the user, replacing the
+	// previous vote of the user.
+	SaveVote(ctx context.Context, pollID, userID string, choices []int) error
+	// PollVotes returns the chosen options by user.
+	PollVotes(ctx context.Context, pollID string) (map[string][]int, error)
+}
+
+// Subscription delivers the messages published to a room.
+type Subscription interface {
+	// Channel returns the channel receiving the messages. It is closed when
+	// the subscription is closed.
+	Channel() <-chan *chat.Chat
+	// Close ends the subscription.
+	Close() error
+}
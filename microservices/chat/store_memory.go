@@ -0,0 +1,359 @@
+package chatapi
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+)
+
+type memoryStore struct {
+	mu          sync.Mutex
+	rooms       map[string]bool
+	userRooms   map[string][]string
+	invites     map[string]string
+	history     map[string][]*chat.Chat
+	subscribers map[string]map[*memorySubscription]bool
+	clientIDs   map[string]clientIDClaim
+	scheduled   map[string]*chat.ScheduledMessage
+	queue       map[string]int64
+	claimed     map[string]int64
+	polls       map[string]*PollRecord
+	votes       map[string]map[string][]int
+}
+
+type clientIDClaim struct {
+	msg       *chat.Chat
+	expiresAt time.Time
+}
+
+// NewMemoryStore returns a ChatStore keeping everything in memory. Messages
+// are only fanned out to the streams of the current process, so it suits tests
+// and single replica development setups.
+func NewMemoryStore() ChatStore {
+	return &memoryStore{
+		rooms:       make(map[string]bool),
+		userRooms:   make(map[string][]string),
+		invites:     make(map[string]string),
+		history:     make(map[string][]*chat.Chat),
+		subscribers: make(map[string]map[*memorySubscription]bool),
+		clientIDs:   make(map[string]clientIDClaim),
+		scheduled:   make(map[string]*chat.ScheduledMessage),
+		queue:       make(map[string]int64),
+		claimed:     make(map[string]int64),
+		polls:       make(map[string]*PollRecord),
+		votes:       make(map[string]map[string][]int),
+	}
+}
+
+func (s *memoryStore) CreateRoom(ctx context.Context, roomID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rooms[workspaceKey(ctx, roomID)] = true
+	return nil
+}
+
+func (s *memoryStore) AddMember(ctx context.Context, roomID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := workspaceKey(ctx, userID)
+	s.userRooms[key] = append([]string{roomID}, s.userRooms[key]...)
+	return nil
+}
+
+func (s *memoryStore) UserRooms(ctx context.Context, userID string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.userRooms[workspaceKey(ctx, userID)]...), nil
+}
+
+func (s *memoryStore) IsMember(ctx context.Context, roomID, userID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Contains(s.userRooms[workspaceKey(ctx, userID)], roomID), nil
+}
+
+func (s *memoryStore) SaveInvite(ctx context.Context, inviteID, userID, roomID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.invites[workspaceKey(ctx, inviteID+":"+userID)] = roomID
+	return nil
+}
+
+func (s *memoryStore) InvitedRoom(ctx context.Context, inviteID, userID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.invites[workspaceKey(ctx, inviteID+":"+userID)], nil
+}
+
+func (s *memoryStore) History(ctx context.Context, roomID string) ([]*chat.Chat, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := s.history[workspaceKey(ctx, roomID)]
+	res := make([]*chat.Chat, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		msg := *history[i]
+		res = append(res, &msg)
+	}
+
+	return res, nil
+}
+
+func (s *memoryStore) Publish(ctx context.Context, msg *chat.Chat) error {
+	s.mu.Lock()
+	stored := *msg
+	key := workspaceKey(ctx, msg.RoomID)
+	s.history[key] = append(s.history[key], &stored)
+	s.mu.Unlock()
+
+	return s.Broadcast(ctx, msg)
+}
+
+func (s *memoryStore) Broadcast(ctx context.Context, event *chat.Chat) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subscribers[workspaceKey(ctx, event.RoomID)] {
+		e := *event
+		sub.deliver(&e)
+	}
+
+	return nil
+}
+
+func (s *memoryStore) Subscribe(ctx context.Context, roomID string) (Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := workspaceKey(ctx, roomID)
+	sub := &memorySubscription{
+		store: s,
+		key:   key,
+		ch:    make(chan *chat.Chat, subscriptionBuffer),
+	}
+	if s.subscribers[key] == nil {
+		s.subscribers[key] = make(map[*memorySubscription]bool)
+	}
+	s.subscribers[key][sub] = true
+
+	return sub, nil
+}
+
+// subscriptionBuffer is the number of messages a slow subscriber may lag
+// behind before messages are dropped for it, like Redis does for slow pub/sub
+// clients.
+const subscriptionBuffer = 100
+
+type memorySubscription struct {
+	store *memoryStore
+	// key is the room scoped to its workspace.
+	key    string
+	ch     chan *chat.Chat
+	closed bool
+}
+
+// deliver must be called with the store lock held.
+func (s *memorySubscription) deliver(msg *chat.Chat) {
+	select {
+	case s.ch <- msg:
+	default:
+	}
+}
+
+func (s *memorySubscription) Channel() <-chan *chat.Chat {
+	return s.ch
+}
+
+func (s *memorySubscription) Close() error {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	delete(s.store.subscribers[s.key], s)
+	close(s.ch)
+
+	return nil
+}
+
+func (s *memoryStore) ClaimClientID(ctx context.Context, msg *chat.Chat, ttl time.Duration) (*chat.Chat, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := clientIDKey(ctx, msg)
+	if claim, ok := s.clientIDs[key]; ok && time.Now().Before(claim.expiresAt) {
+		delivered := *claim.msg
+		return &delivered, nil
+	}
+
+	claimed := *msg
+	s.clientIDs[key] = clientIDClaim{msg: &claimed, expiresAt: time.Now().Add(ttl)}
+
+	return nil, nil
+}
+
+func (s *memoryStore) ReleaseClientID(ctx context.Context, msg *chat.Chat) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clientIDs, clientIDKey(ctx, msg))
+	return nil
+}
+
+func (s *memoryStore) SaveScheduled(ctx context.Context, msg *chat.ScheduledMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *msg
+	key := workspaceKey(ctx, msg.ID)
+	s.scheduled[key] = &stored
+	s.queue[key] = msg.SendAt
+	return nil
+}
+
+func (s *memoryStore) GetScheduled(ctx context.Context, id string) (*chat.ScheduledMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	msg, ok := s.scheduled[workspaceKey(ctx, id)]
+	if !ok {
+		return nil, nil
+	}
+	res := *msg
+	return &res, nil
+}
+
+func (s *memoryStore) UserScheduled(ctx context.Context, userID string) ([]*chat.ScheduledMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var res []*chat.ScheduledMessage
+	for key, msg := range s.scheduled {
+		if msg.UserID != userID || key != workspaceKey(ctx, msg.ID) {
+			continue
+		}
+		m := *msg
+		res = append(res, &m)
+	}
+
+	return res, nil
+}
+
+func (s *memoryStore) DueScheduled(ctx context.Context, until int64, limit int) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, expiresAt := range s.claimed {
+		if expiresAt <= until {
+			delete(s.claimed, id)
+			s.queue[id] = until
+		}
+	}
+
+	var ids []string
+	for id, sendAt := range s.queue {
+		if sendAt <= until {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return s.queue[ids[i]] < s.queue[ids[j]]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	return ids, nil
+}
+
+func (s *memoryStore) ClaimScheduled(ctx context.Context, id string, lease time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := workspaceKey(ctx, id)
+	if _, ok := s.queue[key]; !ok {
+		return false, nil
+	}
+	delete(s.queue, key)
+	s.claimed[key] = time.Now().Add(lease).Unix()
+	return true, nil
+}
+
+func (s *memoryStore) RequeueScheduled(ctx context.Context, msg *chat.ScheduledMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := workspaceKey(ctx, msg.ID)
+	delete(s.claimed, key)
+	s.queue[key] = msg.SendAt
+	return nil
+}
+
+func (s *memoryStore) DeleteScheduled(ctx context.Context, msg *chat.ScheduledMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := workspaceKey(ctx, msg.ID)
+	delete(s.scheduled, key)
+	delete(s.queue, key)
+	delete(s.claimed, key)
+	return nil
+}
+
+func (s *memoryStore) SavePoll(ctx context.Context, poll *PollRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *poll
+	s.polls[workspaceKey(ctx, poll.ID)] = &stored
+	return nil
+}
+
+func (s *memoryStore) GetPoll(ctx context.Context, id string) (*PollRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	poll, ok := s.polls[workspaceKey(ctx, id)]
+	if !ok {
+		return nil, nil
+	}
+	res := *poll
+	return &res, nil
+}
+
+func (s *memoryStore) SaveVote(ctx context.Context, pollID, userID string, choices []int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := workspaceKey(ctx, pollID)
+	if s.votes[key] == nil {
+		s.votes[key] = make(map[string][]int)
+	}
+	s.votes[key][userID] = append([]int{}, choices...)
+	return nil
+}
+
+func (s *memoryStore) PollVotes(ctx context.Context, pollID string) (map[string][]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	votes := s.votes[workspaceKey(ctx, pollID)]
+	res := make(map[string][]int, len(votes))
+	for userID, choices := range votes {
+		res[userID] = append([]int{}, choices...)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,384 @@
+package chatapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"goa.design/clue/log"
+	"object-t.com/hackz-giganoto/microservices/chat/gen/chat"
+)
+
+const (
+	roomsKey       = "rooms"
+	roomPublishKey = "room_publish"
+	historyKey     = "history"
+	inviteKey      = "invite"
+	dedupKey       = "dedup"
+
+	// scheduledKey is the sorted set of scheduled message IDs scored by their
+	// delivery timestamp. It is shared by the workspaces, its members are the
+	// IDs scoped to their workspace.
+	scheduledKey = "scheduled"
+	// scheduledClaimedKey is the sorted set of the claimed scheduled message
+	// IDs scored by the expiry of their lease.
+	scheduledClaimedKey = "scheduled_claimed"
+	scheduledMessageKey = "scheduled_message"
+	scheduledUserKey    = "scheduled_user"
+
+	pollKey      = "poll"
+	pollVotesKey = "poll_votes"
+)
+
+// claimScheduledScript moves a queued message to the claimed messages.
+var claimScheduledScript = redis.NewScript(`
+if redis.call("ZREM", KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call("ZADD", KEYS[2], ARGV[2], ARGV[1])
+return 1
+`)
+
+// requeueExpiredScript queues again the claimed messages whose lease expired.
+var requeueExpiredScript = redis.NewScript(`
+local expired = redis.call("ZRANGEBYSCORE", KEYS[2], "-inf", ARGV[1])
+for _, member in ipairs(expired) do
+	redis.call("ZREM", KEYS[2], member)
+	redis.call("ZADD", KEYS[1], ARGV[1], member)
+end
+return #expired
+`)
+
+type redisStore struct {
+	redis *redis.Client
+}
+
+// NewRedisStore returns a ChatStore backed by Redis. Messages are fanned out
+// with Redis pub/sub so that every replica sees them.
+func NewRedisStore(redis *redis.Client) ChatStore {
+	return &redisStore{redis: redis}
+}
+
+func (s *redisStore) CreateRoom(ctx context.Context, roomID string) error {
+	return s.redis.SAdd(ctx, workspaceKey(ctx, roomsKey), roomID).Err()
+}
+
+func (s *redisStore) AddMember(ctx context.Context, roomID, userID string) error {
+	return s.redis.LPush(ctx, workspaceKey(ctx, roomsKey+":"+userID), roomID).Err()
+}
+
+func (s *redisStore) UserRooms(ctx context.Context, userID string) ([]string, error) {
+	return s.redis.LRange(ctx, workspaceKey(ctx, roomsKey+":"+userID), 0, -1).Result()
+}
+
+func (s *redisStore) IsMember(ctx context.Context, roomID, userID string) (bool, error) {
+	_, err := s.redis.LPos(ctx, workspaceKey(ctx, roomsKey+":"+userID), roomID, redis.LPosArgs{}).Result()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("redis LPos failed: %w", err)
+	}
+	return true, nil
+}
+
+func (s *redisStore) SaveInvite(ctx context.Context, inviteID, userID, roomID string) error {
+	return s.redis.Set(ctx, workspaceKey(ctx, inviteKey+":"+inviteID+":"+userID), roomID, 0).Err()
+}
+
+func (s *redisStore) InvitedRoom(ctx context.Context, inviteID, userID string) (string, error) {
+	roomID, err := s.redis.Get(ctx, workspaceKey(ctx, inviteKey+":"+inviteID+":"+userID)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return roomID, err
+}
+
+func (s *redisStore) History(ctx context.Context, roomID string) ([]*chat.Chat, error) {
+	histories, err := s.redis.LRange(ctx, workspaceKey(ctx, historyKey+":"+roomID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis LRange failed: %w", err)
+	}
+
+	res := make([]*chat.Chat, 0, len(histories))
+	for _, historyJSON := range histories {
+		var msg chat.Chat
+		if err := json.Unmarshal([]byte(historyJSON), &msg); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+		}
+		res = append(res, &msg)
+	}
+
+	return res, nil
+}
+
+func (s *redisStore) Publish(ctx context.Context, msg *chat.Chat) error {
+	msgJSON, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	if err := s.redis.LPush(ctx, workspaceKey(ctx, historyKey+":"+msg.RoomID), msgJSON).Err(); err != nil {
+		return fmt.Errorf("redis LPush failed: %w", err)
+	}
+
+	if err := s.redis.Publish(ctx, workspaceKey(ctx, roomPublishKey+":"+msg.RoomID), msgJSON).Err(); err != nil {
+		return fmt.Errorf("redis Publish failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) Broadcast(ctx context.Context, event *chat.Chat) error {
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	if err := s.redis.Publish(ctx, workspaceKey(ctx, roomPublishKey+":"+event.RoomID), eventJSON).Err(); err != nil {
+		return fmt.Errorf("redis Publish failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) Subscribe(ctx context.Context, roomID string) (Subscription, error) {
+	pubsub := s.redis.Subscribe(ctx, workspaceKey(ctx, roomPublishKey+":"+roomID))
+	sub := &redisSubscription{
+		ctx:    ctx,
+		pubsub: pubsub,
+		ch:     make(chan *chat.Chat),
+	}
+	go sub.run()
+
+	return sub, nil
+}
+
+// redisSubscription decodes the messages received from a Redis channel.
+type redisSubscription struct {
+	ctx    context.Context
+	pubsub *redis.PubSub
+	ch     chan *chat.Chat
+}
+
+func (s *redisSubscription) run() {
+	defer close(s.ch)
+
+	for msg := range s.pubsub.Channel() {
+		var newChat chat.Chat
+		if err := json.Unmarshal([]byte(msg.Payload), &newChat); err != nil {
+			log.Print(s.ctx, log.KV{"chat.subscription", "ERROR: json.Unmarshal from pubsub failed"}, log.KV{"error", err.Error()})
+			continue
+		}
+		s.ch <- &newChat
+	}
+}
+
+func (s *redisSubscription) Channel() <-chan *chat.Chat {
+	return s.ch
+}
+
+func (s *redisSubscription) Close() error {
+	err := s.pubsub.Close()
+	// Unblock run if nobody reads the channel anymore.
+	for range s.ch {
+	}
+	return err
+}
+
+func (s *redisStore) ClaimClientID(ctx context.Context, msg *chat.Chat, ttl time.Duration) (*chat.Chat, error) {
+	msgJSON, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	key := clientIDKey(ctx, msg)
+	claimed, err := s.redis.SetNX(ctx, key, msgJSON, ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis SetNX failed: %w", err)
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	deliveredJSON, err := s.redis.Get(ctx, key).Result()
+	if err == redis.Nil {
+		// The claim expired in between, the message counts as new.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var delivered chat.Chat
+	if err := json.Unmarshal([]byte(deliveredJSON), &delivered); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &delivered, nil
+}
+
+func (s *redisStore) ReleaseClientID(ctx context.Context, msg *chat.Chat) error {
+	return s.redis.Del(ctx, clientIDKey(ctx, msg)).Err()
+}
+
+func clientIDKey(ctx context.Context, msg *chat.Chat) string {
+	return workspaceKey(ctx, fmt.Sprintf("%s:%s:%s:%s", dedupKey, msg.RoomID, msg.UserID, *msg.ClientID))
+}
+
+func (s *redisStore) SaveScheduled(ctx context.Context, msg *chat.ScheduledMessage) error {
+	msgJSON, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, workspaceKey(ctx, scheduledMessageKey+":"+msg.ID), msgJSON, 0)
+		pipe.SAdd(ctx, workspaceKey(ctx, scheduledUserKey+":"+msg.UserID), msg.ID)
+		pipe.ZAdd(ctx, scheduledKey, redis.Z{Score: float64(msg.SendAt), Member: workspaceKey(ctx, msg.ID)})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) GetScheduled(ctx context.Context, id string) (*chat.ScheduledMessage, error) {
+	msgJSON, err := s.redis.Get(ctx, workspaceKey(ctx, scheduledMessageKey+":"+id)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var msg chat.ScheduledMessage
+	if err := json.Unmarshal([]byte(msgJSON), &msg); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &msg, nil
+}
+
+func (s *redisStore) UserScheduled(ctx context.Context, userID string) ([]*chat.ScheduledMessage, error) {
+	ids, err := s.redis.SMembers(ctx, workspaceKey(ctx, scheduledUserKey+":"+userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis SMembers failed: %w", err)
+	}
+
+	res := make([]*chat.ScheduledMessage, 0, len(ids))
+	for _, id := range ids {
+		msg, err := s.GetScheduled(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if msg == nil {
+			continue
+		}
+		res = append(res, msg)
+	}
+
+	return res, nil
+}
+
+func (s *redisStore) DueScheduled(ctx context.Context, until int64, limit int) ([]string, error) {
+	keys := []string{scheduledKey, scheduledClaimedKey}
+	if err := requeueExpiredScript.Run(ctx, s.redis, keys, until).Err(); err != nil {
+		return nil, fmt.Errorf("failed to requeue expired claims: %w", err)
+	}
+
+	return s.redis.ZRangeByScore(ctx, scheduledKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(until, 10),
+		Count: int64(limit),
+	}).Result()
+}
+
+func (s *redisStore) ClaimScheduled(ctx context.Context, id string, lease time.Duration) (bool, error) {
+	keys := []string{scheduledKey, scheduledClaimedKey}
+	claimed, err := claimScheduledScript.Run(ctx, s.redis, keys, workspaceKey(ctx, id), time.Now().Add(lease).Unix()).Int()
+	if err != nil {
+		return false, err
+	}
+	return claimed == 1, nil
+}
+
+func (s *redisStore) RequeueScheduled(ctx context.Context, msg *chat.ScheduledMessage) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, scheduledClaimedKey, workspaceKey(ctx, msg.ID))
+		pipe.ZAdd(ctx, scheduledKey, redis.Z{Score: float64(msg.SendAt), Member: workspaceKey(ctx, msg.ID)})
+		return nil
+	})
+	return err
+}
+
+func (s *redisStore) DeleteScheduled(ctx context.Context, msg *chat.ScheduledMessage) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, workspaceKey(ctx, scheduledMessageKey+":"+msg.ID))
+		pipe.SRem(ctx, workspaceKey(ctx, scheduledUserKey+":"+msg.UserID), msg.ID)
+		pipe.ZRem(ctx, scheduledKey, workspaceKey(ctx, msg.ID))
+		pipe.ZRem(ctx, scheduledClaimedKey, workspaceKey(ctx, msg.ID))
+		return nil
+	})
+	return err
+}
+
+func (s *redisStore) SavePoll(ctx context.Context, poll *PollRecord) error {
+	pollJSON, err := json.Marshal(poll)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	return s.redis.Set(ctx, workspaceKey(ctx, pollKey+":"+poll.ID), pollJSON, 0).Err()
+}
+
+func (s *redisStore) GetPoll(ctx context.Context, id string) (*PollRecord, error) {
+	pollJSON, err := s.redis.Get(ctx, workspaceKey(ctx, pollKey+":"+id)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var poll PollRecord
+	if err := json.Unmarshal([]byte(pollJSON), &poll); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &poll, nil
+}
+
+func (s *redisStore) SaveVote(ctx context.Context, pollID, userID string, choices []int) error {
+	choicesJSON, err := json.Marshal(choices)
+	if err != nil {
+		return fmt.Errorf("json.Marshal failed: %w", err)
+	}
+
+	// Votes are kept in a hash keyed by user so that concurrent votes never
+	// overwrite each other.
+	return s.redis.HSet(ctx, workspaceKey(ctx, pollVotesKey+":"+pollID), userID, choicesJSON).Err()
+}
+
+func (s *redisStore) PollVotes(ctx context.Context, pollID string) (map[string][]int, error) {
+	votesJSON, err := s.redis.HGetAll(ctx, workspaceKey(ctx, pollVotesKey+":"+pollID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis HGetAll failed: %w", err)
+	}
+
+	votes := make(map[string][]int, len(votesJSON))
+	for userID, choicesJSON := range votesJSON {
+		var choices []int
+		if err := json.Unmarshal([]byte(choicesJSON), &choices); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+		}
+		votes[userID] = choices
+	}
+
+	return votes, nil
+}
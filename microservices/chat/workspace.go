@@ -0,0 +1,31 @@
+package chatapi
+
+import (
+	"context"
+	"strings"
+)
+
+// workspacePrefix prefixes the Redis keys and the scheduled queue members of
+// a workspace.
+const workspacePrefix = "workspace:"
+
+// workspaceKey scopes the key to the workspace of the request. The default
+// workspace keeps the unscoped keys of the rooms created before workspaces.
+func workspaceKey(ctx context.Context, key string) string {
+	workspaceID, _ := ctx.Value("workspace_id").(string)
+	if workspaceID == "" {
+		return key
+	}
+	return workspacePrefix + workspaceID + ":" + key
+}
+
+// splitWorkspaceKey returns the context of the workspace the key is scoped to
+// and the unscoped key.
+func splitWorkspaceKey(ctx context.Context, key string) (context.Context, string) {
+	if rest, ok := strings.CutPrefix(key, workspacePrefix); ok {
+		if workspaceID, unscoped, ok := strings.Cut(rest, ":"); ok {
+			return context.WithValue(ctx, "workspace_id", workspaceID), unscoped
+		}
+	}
+	return ctx, key
+}
@@ -0,0 +1,160 @@
+package design
+
+import . "goa.design/goa/v3/dsl"
+
+var JWTAuth = JWTSecurity("jwt", func() {
+	Description("JWT")
+	Scope("api:read", "Read access to API resources")
+	Scope("api:write", "Write access to API resources")
+})
+
+var Attachment = Type("Attachment", func() {
+	Description("Uploaded file metadata")
+
+	Field(1, "id", String, "Attachment ID")
+	Field(2, "filename", String, "Original file name")
+	Field(3, "content_type", String, "MIME type of the file")
+	Field(4, "size", Int64, "File size in bytes")
+	Field(5, "user_id", String, "User ID of the uploader")
+	Field(6, "created_at", Int64, "Upload timestamp")
+	Field(7, "download_url", String, "Presigned download URL")
+	Required("id", "filename", "content_type", "size", "user_id", "created_at")
+})
+
+var _ = API("files", func() {
+	Title("Files Service")
+	Description("File and image attachment storage service")
+	Version("1.0")
+
+	Server("files", func() {
+		Host("localhost", func() {
+			URI("grpc://localhost:50054")
+			URI("http://localhost:8081")
+		})
+	})
+})
+
+var _ = Service("files", func() {
+	Description("Stores chat attachments and serves them through presigned URLs")
+
+	GRPC(func() {
+		Package("files.v1")
+	})
+
+	Error("unauthorized", String, "Unauthorized access")
+	Error("internal_error", String, "Internal server error")
+
+	Method("upload", func() {
+		Description("Upload a file as a stream of chunks")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "filename", String, "Original file name")
+			Field(2, "content_type", String, "MIME type of the file")
+			Required("token", "filename", "content_type")
+		})
+
+		StreamingPayload(Bytes)
+		Result(Attachment)
+
+		Error("invalid_argument", String, "Invalid upload")
+
+		GRPC(func() {
+			Metadata(func() {
+				Attribute("filename")
+				Attribute("content_type")
+			})
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("invalid_argument", CodeInvalidArgument)
+			Response("internal_error", CodeInternal)
+		})
+	})
+
+	Method("attachments", func() {
+		Description("Get attachment metadata with presigned download URLs")
+
+		Security(JWTAuth, func() {
+			Scope("api:read")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "ids", ArrayOf(String), "Attachment IDs")
+			Field(2, "room_id", String, "Room the attachments were shared in")
+			Required("token", "ids")
+		})
+
+		Result(ArrayOf(Attachment))
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("internal_error", CodeInternal)
+		})
+	})
+
+	Method("share", func() {
+		Description("Share attachments of the caller in a chat room")
+
+		Security(JWTAuth, func() {
+			Scope("api:write")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token")
+			Field(1, "room_id", String, "Room the attachments are sent to")
+			Field(2, "ids", ArrayOf(String), "Attachment IDs")
+			Required("token", "room_id", "ids")
+		})
+
+		Error("not_found", String, "Attachment not found")
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+			Response("internal_error", CodeInternal)
+		})
+	})
+
+	Method("download", func() {
+		Description("Download a file through a presigned URL")
+
+		Payload(func() {
+			Field(1, "id", String, "Attachment ID")
+			Field(2, "expires", Int64, "URL expiration timestamp")
+			Field(3, "signature", String, "URL signature")
+			Required("id", "expires", "signature")
+		})
+
+		Result(func() {
+			Attribute("content_type", String, "MIME type of the file")
+			Attribute("content_length", Int64, "File size in bytes")
+			Attribute("content_disposition", String, "Content disposition header")
+			Required("content_type", "content_length", "content_disposition")
+		})
+
+		Error("not_found", String, "Attachment not found")
+		Error("invalid_signature", String, "Invalid or expired signature")
+
+		HTTP(func() {
+			GET("/files/{id}")
+			Param("expires")
+			Param("signature")
+			SkipResponseBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("content_type:Content-Type")
+				Header("content_length:Content-Length")
+				Header("content_disposition:Content-Disposition")
+			})
+			Response("not_found", StatusNotFound)
+			Response("invalid_signature", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+})
@@ -0,0 +1,330 @@
+package filesapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"goa.design/clue/log"
+	"goa.design/goa/v3/security"
+	"google.golang.org/grpc/metadata"
+	chatpb "object-t.com/hackz-giganoto/microservices/chat/gen/grpc/chat/pb"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	security2 "object-t.com/hackz-giganoto/pkg/security"
+)
+
+const (
+	attachmentKey = "attachment"
+	// attachmentRoomsKey holds the rooms an attachment was shared in.
+	attachmentRoomsKey = "attachment_rooms"
+
+	maxUploadSize  = 10 << 20
+	downloadURLTTL = 15 * time.Minute
+)
+
+var errUploadTooLarge = errors.New("upload too large")
+
+type filessrvc struct {
+	redis     *redis.Client
+	storage   Storage
+	chat      chatpb.ChatClient
+	publicURL string
+	urlSecret []byte
+}
+
+// NewFiles returns the files service implementation. The rooms of the callers
+// are looked up in chat. FILES_URL_SECRET signs the download URLs and must be
+// set.
+func NewFiles(redis *redis.Client, storage Storage, chat chatpb.ChatClient) (files.Service, error) {
+	publicURL := os.Getenv("FILES_PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = "http://localhost:8081"
+	}
+
+	urlSecret := []byte(os.Getenv("FILES_URL_SECRET"))
+	if len(urlSecret) == 0 {
+		return nil, errors.New("FILES_URL_SECRET environment variable is not set")
+	}
+
+	return &filessrvc{
+		redis:     redis,
+		storage:   storage,
+		chat:      chat,
+		publicURL: publicURL,
+		urlSecret: urlSecret,
+	}, nil
+}
+
+// JWTAuth implements the authorization logic for service "files" for the "jwt"
+// security scheme.
+func (s *filessrvc) JWTAuth(ctx context.Context, token string, scheme *security.JWTScheme) (context.Context, error) {
+	claims, err := security2.ValidToken(token)
+	if err != nil {
+		log.Printf(ctx, "invalid token: %v", err)
+		return ctx, files.Unauthorized("invalid token")
+	}
+	// The token is forwarded to chat to look up the rooms of the caller.
+	ctx = context.WithValue(ctx, "jwt_token", token)
+	return security2.HasPermission(ctx, claims, scheme)
+}
+
+// Upload a file as a stream of chunks
+func (s *filessrvc) Upload(ctx context.Context, p *files.UploadPayload, stream files.UploadServerStream) (err error) {
+	log.Printf(ctx, "files.upload")
+	userID := security2.ContextAuthInfo(ctx).UserID()
+	if userID == "" {
+		return files.Unauthorized("user not authenticated")
+	}
+
+	if p.Filename == "" {
+		return files.InvalidArgument("filename must not be empty")
+	}
+
+	attachment := &files.Attachment{
+		ID:          uuid.NewString(),
+		Filename:    p.Filename,
+		ContentType: p.ContentType,
+		UserID:      userID,
+		CreatedAt:   time.Now().Unix(),
+	}
+
+	size, err := s.storage.Save(ctx, attachment.ID, &uploadReader{stream: stream, max: maxUploadSize})
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			return files.InvalidArgument(fmt.Sprintf("file exceeds %d bytes", maxUploadSize))
+		}
+		log.Print(ctx, log.KV{"files.upload", "ERROR: failed to store file"}, log.KV{"error", err.Error()})
+		return files.InternalError("Internal server error")
+	}
+	if size == 0 {
+		s.storage.Delete(ctx, attachment.ID)
+		return files.InvalidArgument("file must not be empty")
+	}
+	attachment.Size = size
+
+	attachmentJSON, err := json.Marshal(attachment)
+	if err != nil {
+		log.Print(ctx, log.KV{"files.upload", "ERROR: json.Marshal failed"}, log.KV{"error", err.Error()})
+		return files.InternalError("Internal server error")
+	}
+
+	if err := s.redis.Set(ctx, attachmentKey+":"+attachment.ID, attachmentJSON, 0).Err(); err != nil {
+		log.Print(ctx, log.KV{"files.upload", "ERROR: redis Set failed"}, log.KV{"error", err.Error()})
+		s.storage.Delete(ctx, attachment.ID)
+		return files.InternalError("Internal server error")
+	}
+
+	downloadURL := s.signedURL(attachment.ID, time.Now().Add(downloadURLTTL).Unix())
+	attachment.DownloadURL = &downloadURL
+
+	return stream.SendAndClose(attachment)
+}
+
+// Get attachment metadata with presigned download URLs
+func (s *filessrvc) Attachments(ctx context.Context, p *files.AttachmentsPayload) (res []*files.Attachment, err error) {
+	log.Printf(ctx, "files.attachments")
+	userID := security2.ContextAuthInfo(ctx).UserID()
+	if userID == "" {
+		return nil, files.Unauthorized("user not authenticated")
+	}
+
+	// The attachments shared in the room are visible to its members.
+	var roomID string
+	if p.RoomID != nil && *p.RoomID != "" {
+		member, err := s.roomMember(ctx, *p.RoomID)
+		if err != nil {
+			log.Print(ctx, log.KV{"files.attachments", "ERROR: failed to load rooms"}, log.KV{"error", err.Error()})
+			return nil, files.InternalError("Internal server error")
+		}
+		if member {
+			roomID = *p.RoomID
+		}
+	}
+
+	res = make([]*files.Attachment, 0, len(p.Ids))
+	expires := time.Now().Add(downloadURLTTL).Unix()
+	for _, id := range p.Ids {
+		attachment, err := s.getAttachment(ctx, id)
+		if err != nil {
+			log.Print(ctx, log.KV{"files.attachments", "ERROR: failed to load attachment"}, log.KV{"error", err.Error()})
+			return nil, files.InternalError("Internal server error")
+		}
+		if attachment == nil {
+			continue
+		}
+
+		// Attachments the caller may not see are skipped like missing ones.
+		if attachment.UserID != userID {
+			if roomID == "" {
+				continue
+			}
+			shared, err := s.redis.SIsMember(ctx, attachmentRoomsKey+":"+id, roomID).Result()
+			if err != nil {
+				log.Print(ctx, log.KV{"files.attachments", "ERROR: redis SIsMember failed"}, log.KV{"error", err.Error()})
+				return nil, files.InternalError("Internal server error")
+			}
+			if !shared {
+				continue
+			}
+		}
+
+		downloadURL := s.signedURL(attachment.ID, expires)
+		attachment.DownloadURL = &downloadURL
+		res = append(res, attachment)
+	}
+
+	return res, nil
+}
+
+// Share attachments of the caller in a chat room
+func (s *filessrvc) Share(ctx context.Context, p *files.SharePayload) (err error) {
+	log.Printf(ctx, "files.share")
+	userID := security2.ContextAuthInfo(ctx).UserID()
+	if userID == "" {
+		return files.Unauthorized("user not authenticated")
+	}
+
+	for _, id := range p.Ids {
+		attachment, err := s.getAttachment(ctx, id)
+		if err != nil {
+			log.Print(ctx, log.KV{"files.share", "ERROR: failed to load attachment"}, log.KV{"error", err.Error()})
+			return files.InternalError("Internal server error")
+		}
+		// Only the uploader shares an attachment.
+		if attachment == nil || attachment.UserID != userID {
+			return files.NotFound(fmt.Sprintf("attachment %s not found", id))
+		}
+	}
+
+	for _, id := range p.Ids {
+		if err := s.redis.SAdd(ctx, attachmentRoomsKey+":"+id, p.RoomID).Err(); err != nil {
+			log.Print(ctx, log.KV{"files.share", "ERROR: redis SAdd failed"}, log.KV{"error", err.Error()})
+			return files.InternalError("Internal server error")
+		}
+	}
+
+	return nil
+}
+
+// Download a file through a presigned URL
+func (s *filessrvc) Download(ctx context.Context, p *files.DownloadPayload) (res *files.DownloadResult, resp io.ReadCloser, err error) {
+	log.Printf(ctx, "files.download")
+
+	if time.Now().Unix() > p.Expires || !hmac.Equal([]byte(p.Signature), []byte(s.sign(p.ID, p.Expires))) {
+		return nil, nil, files.InvalidSignature("invalid or expired signature")
+	}
+
+	attachment, err := s.getAttachment(ctx, p.ID)
+	if err != nil {
+		log.Print(ctx, log.KV{"files.download", "ERROR: failed to load attachment"}, log.KV{"error", err.Error()})
+		return nil, nil, files.InternalError("Internal server error")
+	}
+	if attachment == nil {
+		return nil, nil, files.NotFound("attachment not found")
+	}
+
+	resp, err = s.storage.Open(ctx, attachment.ID)
+	if err != nil {
+		log.Print(ctx, log.KV{"files.download", "ERROR: failed to open file"}, log.KV{"error", err.Error()})
+		return nil, nil, files.NotFound("attachment not found")
+	}
+
+	res = &files.DownloadResult{
+		ContentType:        attachment.ContentType,
+		ContentLength:      attachment.Size,
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}),
+	}
+
+	return res, resp, nil
+}
+
+func (s *filessrvc) getAttachment(ctx context.Context, id string) (*files.Attachment, error) {
+	attachmentJSON, err := s.redis.Get(ctx, attachmentKey+":"+id).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis Get failed: %w", err)
+	}
+
+	var attachment files.Attachment
+	if err := json.Unmarshal([]byte(attachmentJSON), &attachment); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal failed: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+// roomMember reports whether the caller is a member of the room, as listed by
+// chat with the token of the caller.
+func (s *filessrvc) roomMember(ctx context.Context, roomID string) (bool, error) {
+	if token, ok := ctx.Value("jwt_token").(string); ok {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	resp, err := s.chat.RoomList(ctx, &chatpb.RoomListRequest{})
+	if err != nil {
+		return false, fmt.Errorf("chat RoomList failed: %w", err)
+	}
+	for _, id := range resp.Field {
+		if id == roomID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// sign returns the signature authorizing the download of id until expires.
+func (s *filessrvc) sign(id string, expires int64) string {
+	mac := hmac.New(sha256.New, s.urlSecret)
+	mac.Write([]byte(id + ":" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *filessrvc) signedURL(id string, expires int64) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", s.sign(id, expires))
+	return fmt.Sprintf("%s/files/%s?%s", s.publicURL, url.PathEscape(id), query.Encode())
+}
+
+// uploadReader adapts the upload stream to an io.Reader and enforces the
+// maximum upload size.
+type uploadReader struct {
+	stream files.UploadServerStream
+	buf    []byte
+	size   int64
+	max    int64
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.size += int64(n)
+	if r.size > r.max {
+		return n, errUploadTooLarge
+	}
+
+	return n, nil
+}
@@ -0,0 +1,278 @@
+package filesapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+	"google.golang.org/grpc"
+	chatpb "object-t.com/hackz-giganoto/microservices/chat/gen/grpc/chat/pb"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	security2 "object-t.com/hackz-giganoto/pkg/security"
+)
+
+// newTestService returns the files service backed by an in-memory redis and
+// a temporary directory, with the rooms of the users listed by chat.
+func newTestService(t *testing.T, rooms map[string][]string) *filessrvc {
+	t.Helper()
+	storage, err := NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &filessrvc{
+		redis:     redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()}),
+		storage:   storage,
+		chat:      &testChat{rooms: rooms},
+		publicURL: "http://files.test",
+		urlSecret: []byte("secret"),
+	}
+}
+
+// userContext returns the context of a request of the user.
+func userContext(t *testing.T, userID string) context.Context {
+	t.Helper()
+	claims := map[string]any{"sub": userID, "scopes": []any{"api:read", "api:write"}}
+	ctx, err := security2.HasPermission(context.Background(), claims, &security.JWTScheme{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// testChat reads the caller from the forwarded token.
+	return context.WithValue(ctx, "jwt_token", userID)
+}
+
+// errorName returns the name of the goa error, empty for other errors.
+func errorName(err error) string {
+	var namer goa.GoaErrorNamer
+	if errors.As(err, &namer) {
+		return namer.GoaErrorName()
+	}
+	return ""
+}
+
+// testChat is a chat client listing the rooms of the caller.
+type testChat struct {
+	chatpb.ChatClient
+	rooms map[string][]string
+}
+
+func (c *testChat) RoomList(ctx context.Context, in *chatpb.RoomListRequest, opts ...grpc.CallOption) (*chatpb.RoomListResponse, error) {
+	userID, _ := ctx.Value("jwt_token").(string)
+	return &chatpb.RoomListResponse{Field: c.rooms[userID]}, nil
+}
+
+// testUpload is an upload stream sending the content in chunks.
+type testUpload struct {
+	chunks [][]byte
+	res    *files.Attachment
+}
+
+func newTestUpload(content []byte, chunkSize int) *testUpload {
+	u := &testUpload{}
+	for len(content) > 0 {
+		n := min(chunkSize, len(content))
+		u.chunks = append(u.chunks, content[:n])
+		content = content[n:]
+	}
+	return u
+}
+
+func (u *testUpload) Recv() ([]byte, error) {
+	if len(u.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := u.chunks[0]
+	u.chunks = u.chunks[1:]
+	return chunk, nil
+}
+
+func (u *testUpload) RecvWithContext(ctx context.Context) ([]byte, error) {
+	return u.Recv()
+}
+
+func (u *testUpload) SendAndClose(a *files.Attachment) error {
+	u.res = a
+	return nil
+}
+
+func (u *testUpload) SendAndCloseWithContext(ctx context.Context, a *files.Attachment) error {
+	return u.SendAndClose(a)
+}
+
+// upload uploads content as the user and returns the attachment.
+func upload(t *testing.T, s *filessrvc, userID string, content []byte) *files.Attachment {
+	t.Helper()
+	stream := newTestUpload(content, 1024)
+	if err := s.Upload(userContext(t, userID), &files.UploadPayload{Filename: "a.txt", ContentType: "text/plain"}, stream); err != nil {
+		t.Fatal(err)
+	}
+	return stream.res
+}
+
+func TestUploadSizeLimit(t *testing.T) {
+	cases := []struct {
+		name string
+		size int
+		err  string
+	}{
+		{"empty", 0, "invalid_argument"},
+		{"small", 1, ""},
+		{"at limit", maxUploadSize, ""},
+		{"over limit", maxUploadSize + 1, "invalid_argument"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := newTestService(t, nil)
+			stream := newTestUpload(bytes.Repeat([]byte("a"), c.size), 64<<10)
+			err := s.Upload(userContext(t, "alice"), &files.UploadPayload{Filename: "a.txt", ContentType: "text/plain"}, stream)
+			if errorName(err) != c.err {
+				t.Fatalf("err = %v, want %q", err, c.err)
+			}
+			if c.err != "" {
+				return
+			}
+			if stream.res.Size != int64(c.size) {
+				t.Errorf("size = %d, want %d", stream.res.Size, c.size)
+			}
+		})
+	}
+}
+
+func TestDownloadSignature(t *testing.T) {
+	s := newTestService(t, nil)
+	attachment := upload(t, s, "alice", []byte("hello"))
+	other := upload(t, s, "alice", []byte("other"))
+
+	signed, err := url.Parse(*attachment.DownloadURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed.Path != "/files/"+attachment.ID {
+		t.Errorf("download path = %s, want /files/%s", signed.Path, attachment.ID)
+	}
+	expires, err := strconv.ParseInt(signed.Query().Get("expires"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl := time.Until(time.Unix(expires, 0)); ttl <= 0 || ttl > downloadURLTTL {
+		t.Errorf("download URL expires in %s, want at most %s", ttl, downloadURLTTL)
+	}
+
+	past := time.Now().Add(-time.Minute).Unix()
+	otherSecret := &filessrvc{urlSecret: []byte("other")}
+	cases := []struct {
+		name      string
+		id        string
+		expires   int64
+		signature string
+		err       string
+	}{
+		{"signed", attachment.ID, expires, signed.Query().Get("signature"), ""},
+		{"expired", attachment.ID, past, s.sign(attachment.ID, past), "invalid_signature"},
+		{"extended expiry", attachment.ID, expires + 1, signed.Query().Get("signature"), "invalid_signature"},
+		{"other attachment", other.ID, expires, signed.Query().Get("signature"), "invalid_signature"},
+		{"other secret", attachment.ID, expires, otherSecret.sign(attachment.ID, expires), "invalid_signature"},
+		{"no signature", attachment.ID, expires, "", "invalid_signature"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, body, err := s.Download(context.Background(), &files.DownloadPayload{ID: c.id, Expires: c.expires, Signature: c.signature})
+			if errorName(err) != c.err {
+				t.Fatalf("err = %v, want %q", err, c.err)
+			}
+			if c.err != "" {
+				return
+			}
+			defer body.Close()
+			content, err := io.ReadAll(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != "hello" {
+				t.Errorf("content = %q, want hello", content)
+			}
+		})
+	}
+}
+
+func TestAttachmentsVisibility(t *testing.T) {
+	s := newTestService(t, map[string][]string{
+		"alice": {"room-1"},
+		"bob":   {"room-1"},
+		"carol": {"room-2"},
+	})
+	shared := upload(t, s, "alice", []byte("shared"))
+	private := upload(t, s, "alice", []byte("private"))
+	if err := s.Share(userContext(t, "alice"), &files.SharePayload{RoomID: "room-1", Ids: []string{shared.ID}}); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		userID string
+		roomID string
+		want   []string
+	}{
+		{"uploader", "alice", "", []string{shared.ID, private.ID}},
+		{"member of the shared room", "bob", "room-1", []string{shared.ID}},
+		{"member without room", "bob", "", nil},
+		{"member of another room", "carol", "room-2", nil},
+		{"non-member naming the room", "carol", "room-1", nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := &files.AttachmentsPayload{Ids: []string{shared.ID, private.ID, "unknown"}}
+			if c.roomID != "" {
+				p.RoomID = &c.roomID
+			}
+			res, err := s.Attachments(userContext(t, c.userID), p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, a := range res {
+				got = append(got, a.ID)
+			}
+			if len(got) != len(c.want) {
+				t.Fatalf("attachments = %v, want %v", got, c.want)
+			}
+			for i := range got {
+				if got[i] != c.want[i] {
+					t.Errorf("attachments = %v, want %v", got, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestShareOnlyByUploader(t *testing.T) {
+	s := newTestService(t, nil)
+	attachment := upload(t, s, "alice", []byte("hello"))
+
+	cases := []struct {
+		name   string
+		userID string
+		ids    []string
+		err    string
+	}{
+		{"uploader", "alice", []string{attachment.ID}, ""},
+		{"other user", "bob", []string{attachment.ID}, "not_found"},
+		{"unknown attachment", "alice", []string{attachment.ID, "unknown"}, "not_found"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := s.Share(userContext(t, c.userID), &files.SharePayload{RoomID: "room-" + c.userID, Ids: c.ids})
+			if errorName(err) != c.err {
+				t.Errorf("err = %v, want %q", err, c.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files client
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package files
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "files" service client.
+type Client struct {
+	UploadEndpoint      goa.Endpoint
+	AttachmentsEndpoint goa.Endpoint
+	ShareEndpoint       goa.Endpoint
+	DownloadEndpoint    goa.Endpoint
+}
+
+// NewClient initializes a "files" service client given the endpoints.
+func NewClient(upload, attachments, share, download goa.Endpoint) *Client {
+	return &Client{
+		UploadEndpoint:      upload,
+		AttachmentsEndpoint: attachments,
+		ShareEndpoint:       share,
+		DownloadEndpoint:    download,
+	}
+}
+
+// Upload calls the "upload" endpoint of the "files" service.
+// Upload may return the following errors:
+//   - "invalid_argument" (type InvalidArgument)
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Upload(ctx context.Context, p *UploadPayload) (res UploadClientStream, err error) {
+	var ires any
+	ires, err = c.UploadEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(UploadClientStream), nil
+}
+
+// Attachments calls the "attachments" endpoint of the "files" service.
+// Attachments may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Attachments(ctx context.Context, p *AttachmentsPayload) (res []*Attachment, err error) {
+	var ires any
+	ires, err = c.AttachmentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Attachment), nil
+}
+
+// Share calls the "share" endpoint of the "files" service.
+// Share may return the following errors:
+//   - "not_found" (type NotFound)
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Share(ctx context.Context, p *SharePayload) (err error) {
+	_, err = c.ShareEndpoint(ctx, p)
+	return
+}
+
+// Download calls the "download" endpoint of the "files" service.
+// Download may return the following errors:
+//   - "not_found" (type NotFound)
+//   - "invalid_signature" (type InvalidSignature)
+//   - "unauthorized" (type Unauthorized)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) Download(ctx context.Context, p *DownloadPayload) (res *DownloadResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DownloadEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DownloadResponseData)
+	return o.Result, o.Body, nil
+}
@@ -0,0 +1,132 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files endpoints
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package files
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Endpoints wraps the "files" service endpoints.
+type Endpoints struct {
+	Upload      goa.Endpoint
+	Attachments goa.Endpoint
+	Share       goa.Endpoint
+	Download    goa.Endpoint
+}
+
+// UploadEndpointInput holds both the payload and the server stream of the
+// "upload" method.
+type UploadEndpointInput struct {
+	// Payload is the method payload.
+	Payload *UploadPayload
+	// Stream is the server stream used by the "upload" method to send data.
+	Stream UploadServerStream
+}
+
+// DownloadResponseData holds both the result and the HTTP response body reader
+// of the "download" method.
+type DownloadResponseData struct {
+	// Result is the method result.
+	Result *DownloadResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// NewEndpoints wraps the methods of the "files" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
+	return &Endpoints{
+		Upload:      NewUploadEndpoint(s, a.JWTAuth),
+		Attachments: NewAttachmentsEndpoint(s, a.JWTAuth),
+		Share:       NewShareEndpoint(s, a.JWTAuth),
+		Download:    NewDownloadEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "files" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Upload = m(e.Upload)
+	e.Attachments = m(e.Attachments)
+	e.Share = m(e.Share)
+	e.Download = m(e.Download)
+}
+
+// NewUploadEndpoint returns an endpoint function that calls the method
+// "upload" of service "files".
+func NewUploadEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*UploadEndpointInput)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:write"},
+		}
+		ctx, err = authJWTFn(ctx, ep.Payload.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Upload(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewAttachmentsEndpoint returns an endpoint function that calls the method
+// "attachments" of service "files".
+func NewAttachmentsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AttachmentsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Attachments(ctx, p)
+	}
+}
+
+// NewShareEndpoint returns an endpoint function that calls the method "share"
+// of service "files".
+func NewShareEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SharePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write"},
+			RequiredScopes: []string{"api:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Share(ctx, p)
+	}
+}
+
+// NewDownloadEndpoint returns an endpoint function that calls the method
+// "download" of service "files".
+func NewDownloadEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DownloadPayload)
+		res, body, err := s.Download(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadResponseData{Result: res, Body: body}, nil
+	}
+}
@@ -0,0 +1,251 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files service
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package files
+
+import (
+	"context"
+	"io"
+
+	"goa.design/goa/v3/security"
+)
+
+// Stores chat attachments and serves them through presigned URLs
+type Service interface {
+	// Upload a file as a stream of chunks
+	Upload(context.Context, *UploadPayload, UploadServerStream) (err error)
+	// Get attachment metadata with presigned download URLs
+	Attachments(context.Context, *AttachmentsPayload) (res []*Attachment, err error)
+	// Share attachments of the caller in a chat room
+	Share(context.Context, *SharePayload) (err error)
+	// Download a file through a presigned URL
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	Download(context.Context, *DownloadPayload) (res *DownloadResult, body io.ReadCloser, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "files"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "1.0"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "files"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"upload", "attachments", "share", "download"}
+
+// UploadServerStream is the interface a "upload" endpoint server stream must
+// satisfy.
+type UploadServerStream interface {
+	// SendAndClose streams instances of "Attachment" and closes the stream.
+	SendAndClose(*Attachment) error
+	// SendAndCloseWithContext streams instances of "Attachment" and closes the
+	// stream with context.
+	SendAndCloseWithContext(context.Context, *Attachment) error
+	// Recv reads instances of "[]byte" from the stream.
+	Recv() ([]byte, error)
+	// RecvWithContext reads instances of "[]byte" from the stream with context.
+	RecvWithContext(context.Context) ([]byte, error)
+}
+
+// UploadClientStream is the interface a "upload" endpoint client stream must
+// satisfy.
+type UploadClientStream interface {
+	// Send streams instances of "[]byte".
+	Send([]byte) error
+	// SendWithContext streams instances of "[]byte" with context.
+	SendWithContext(context.Context, []byte) error
+	// CloseAndRecv stops sending messages to the stream and reads instances of
+	// "Attachment" from the stream.
+	CloseAndRecv() (*Attachment, error)
+	// CloseAndRecvWithContext stops sending messages to the stream and reads
+	// instances of "Attachment" from the stream with context.
+	CloseAndRecvWithContext(context.Context) (*Attachment, error)
+}
+
+// Attachment is the result type of the files service upload method.
+type Attachment struct {
+	// Attachment ID
+	ID string
+	// Original file name
+	Filename string
+	// MIME type of the file
+	ContentType string
+	// File size in bytes
+	Size int64
+	// User ID of the uploader
+	UserID string
+	// Upload timestamp
+	CreatedAt int64
+	// Presigned download URL
+	DownloadURL *string
+}
+
+// AttachmentsPayload is the payload type of the files service attachments
+// method.
+type AttachmentsPayload struct {
+	// JWT token
+	Token string
+	// Attachment IDs
+	Ids []string
+	// Room the attachments were shared in
+	RoomID *string
+}
+
+// DownloadPayload is the payload type of the files service download method.
+type DownloadPayload struct {
+	// Attachment ID
+	ID string
+	// URL expiration timestamp
+	Expires int64
+	// URL signature
+	Signature string
+}
+
+// DownloadResult is the result type of the files service download method.
+type DownloadResult struct {
+	// MIME type of the file
+	ContentType string
+	// File size in bytes
+	ContentLength int64
+	// Content disposition header
+	ContentDisposition string
+}
+
+// SharePayload is the payload type of the files service share method.
+type SharePayload struct {
+	// JWT token
+	Token string
+	// Room the attachments are sent to
+	RoomID string
+	// Attachment IDs
+	Ids []string
+}
+
+// UploadPayload is the payload type of the files service upload method.
+type UploadPayload struct {
+	// JWT token
+	Token string
+	// Original file name
+	Filename string
+	// MIME type of the file
+	ContentType string
+}
+
+// Internal server error
+type InternalError string
+
+// Invalid upload
+type InvalidArgument string
+
+// Invalid or expired signature
+type InvalidSignature string
+
+// Attachment not found
+type NotFound string
+
+// Unauthorized access
+type Unauthorized string
+
+// Error returns an error description.
+func (e InternalError) Error() string {
+	return "Internal server error"
+}
+
+// ErrorName returns "internal_error".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InternalError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "internal_error".
+func (e InternalError) GoaErrorName() string {
+	return "internal_error"
+}
+
+// Error returns an error description.
+func (e InvalidArgument) Error() string {
+	return "Invalid upload"
+}
+
+// ErrorName returns "invalid_argument".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InvalidArgument) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "invalid_argument".
+func (e InvalidArgument) GoaErrorName() string {
+	return "invalid_argument"
+}
+
+// Error returns an error description.
+func (e InvalidSignature) Error() string {
+	return "Invalid or expired signature"
+}
+
+// ErrorName returns "invalid_signature".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InvalidSignature) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "invalid_signature".
+func (e InvalidSignature) GoaErrorName() string {
+	return "invalid_signature"
+}
+
+// Error returns an error description.
+func (e NotFound) Error() string {
+	return "Attachment not found"
+}
+
+// ErrorName returns "not_found".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e NotFound) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "not_found".
+func (e NotFound) GoaErrorName() string {
+	return "not_found"
+}
+
+// Error returns an error description.
+func (e Unauthorized) Error() string {
+	return "Unauthorized access"
+}
+
+// ErrorName returns "unauthorized".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e Unauthorized) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "unauthorized".
+func (e Unauthorized) GoaErrorName() string {
+	return "unauthorized"
+}
@@ -0,0 +1,212 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC client CLI support package
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	goa "goa.design/goa/v3/pkg"
+	grpc "google.golang.org/grpc"
+	filesc "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/client"
+)
+
+// UsageCommands returns the set of commands and sub-commands using the format
+//
+//	command (subcommand1|subcommand2|...)
+func UsageCommands() string {
+	return `files (upload|attachments|share)
+`
+}
+
+// UsageExamples produces an example of a valid invocation of the CLI tool.
+func UsageExamples() string {
+	return os.Args[0] + ` files upload --filename "Cum consequatur est explicabo." --content-type "Non placeat aut a et." --token "Enim ullam in."` + "\n" +
+		""
+}
+
+// ParseEndpoint returns the endpoint and payload as specified on the command
+// line.
+func ParseEndpoint(
+	cc *grpc.ClientConn,
+	opts ...grpc.CallOption,
+) (goa.Endpoint, any, error) {
+	var (
+		filesFlags = flag.NewFlagSet("files", flag.ContinueOnError)
+
+		filesUploadFlags           = flag.NewFlagSet("upload", flag.ExitOnError)
+		filesUploadFilenameFlag    = filesUploadFlags.String("filename", "REQUIRED", "")
+		filesUploadContentTypeFlag = filesUploadFlags.String("content-type", "REQUIRED", "")
+		filesUploadTokenFlag       = filesUploadFlags.String("token", "REQUIRED", "")
+
+		filesAttachmentsFlags       = flag.NewFlagSet("attachments", flag.ExitOnError)
+		filesAttachmentsMessageFlag = filesAttachmentsFlags.String("message", "", "")
+		filesAttachmentsTokenFlag   = filesAttachmentsFlags.String("token", "REQUIRED", "")
+
+		filesShareFlags       = flag.NewFlagSet("share", flag.ExitOnError)
+		filesShareMessageFlag = filesShareFlags.String("message", "", "")
+		filesShareTokenFlag   = filesShareFlags.String("token", "REQUIRED", "")
+	)
+	filesFlags.Usage = filesUsage
+	filesUploadFlags.Usage = filesUploadUsage
+	filesAttachmentsFlags.Usage = filesAttachmentsUsage
+	filesShareFlags.Usage = filesShareUsage
+
+	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	if flag.NArg() < 2 { // two non flag args are required: SERVICE and ENDPOINT (aka COMMAND)
+		return nil, nil, fmt.Errorf("not enough arguments")
+	}
+
+	var (
+		svcn string
+		svcf *flag.FlagSet
+	)
+	{
+		svcn = flag.Arg(0)
+		switch svcn {
+		case "files":
+			svcf = filesFlags
+		default:
+			return nil, nil, fmt.Errorf("unknown service %q", svcn)
+		}
+	}
+	if err := svcf.Parse(flag.Args()[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		epn string
+		epf *flag.FlagSet
+	)
+	{
+		epn = svcf.Arg(0)
+		switch svcn {
+		case "files":
+			switch epn {
+			case "upload":
+				epf = filesUploadFlags
+
+			case "attachments":
+				epf = filesAttachmentsFlags
+
+			case "share":
+				epf = filesShareFlags
+
+			}
+
+		}
+	}
+	if epf == nil {
+		return nil, nil, fmt.Errorf("unknown %q endpoint %q", svcn, epn)
+	}
+
+	// Parse endpoint flags if any
+	if svcf.NArg() > 1 {
+		if err := epf.Parse(svcf.Args()[1:]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		data     any
+		endpoint goa.Endpoint
+		err      error
+	)
+	{
+		switch svcn {
+		case "files":
+			c := filesc.NewClient(cc, opts...)
+			switch epn {
+			case "upload":
+				endpoint = c.Upload()
+				data, err = filesc.BuildUploadPayload(*filesUploadFilenameFlag, *filesUploadContentTypeFlag, *filesUploadTokenFlag)
+			case "attachments":
+				endpoint = c.Attachments()
+				data, err = filesc.BuildAttachmentsPayload(*filesAttachmentsMessageFlag, *filesAttachmentsTokenFlag)
+			case "share":
+				endpoint = c.Share()
+				data, err = filesc.BuildSharePayload(*filesShareMessageFlag, *filesShareTokenFlag)
+			}
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return endpoint, data, nil
+}
+
+// filesUsage displays the usage of the files command and its subcommands.
+func filesUsage() {
+	fmt.Fprintf(os.Stderr, `Stores chat attachments and serves them through presigned URLs
+Usage:
+    %[1]s [globalflags] files COMMAND [flags]
+
+COMMAND:
+    upload: Upload a file as a stream of chunks
+    attachments: Get attachment metadata with presigned download URLs
+    share: Share attachments of the caller in a chat room
+
+Additional help:
+    %[1]s files COMMAND --help
+`, os.Args[0])
+}
+func filesUploadUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] files upload -filename STRING -content-type STRING -token STRING
+
+Upload a file as a stream of chunks
+    -filename STRING: 
+    -content-type STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s files upload --filename "Cum consequatur est explicabo." --content-type "Non placeat aut a et." --token "Enim ullam in."
+`, os.Args[0])
+}
+
+func filesAttachmentsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] files attachments -message JSON -token STRING
+
+Get attachment metadata with presigned download URLs
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s files attachments --message '{
+      "ids": [
+         "Sed incidunt dolorem quisquam.",
+         "Nihil doloremque exercitationem explicabo id.",
+         "Magni ea.",
+         "Quisquam ea iusto illum delectus debitis."
+      ],
+      "room_id": "Et voluptas."
+   }' --token "Veritatis nesciunt debitis vel."
+`, os.Args[0])
+}
+
+func filesShareUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] files share -message JSON -token STRING
+
+Share attachments of the caller in a chat room
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s files share --message '{
+      "ids": [
+         "Et sunt reiciendis facere.",
+         "Aspernatur aspernatur excepturi."
+      ],
+      "room_id": "Dolor molestiae ipsa."
+   }' --token "Blanditiis qui voluptatem maxime temporibus et."
+`, os.Args[0])
+}
@@ -0,0 +1,101 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC client CLI support package
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+// BuildUploadPayload builds the payload for the files upload endpoint from CLI
+// flags.
+func BuildUploadPayload(filesUploadFilename string, filesUploadContentType string, filesUploadToken string) (*files.UploadPayload, error) {
+	var filename string
+	{
+		filename = filesUploadFilename
+	}
+	var contentType string
+	{
+		contentType = filesUploadContentType
+	}
+	var token string
+	{
+		token = filesUploadToken
+	}
+	v := &files.UploadPayload{}
+	v.Filename = filename
+	v.ContentType = contentType
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAttachmentsPayload builds the payload for the files attachments
+// endpoint from CLI flags.
+func BuildAttachmentsPayload(filesAttachmentsMessage string, filesAttachmentsToken string) (*files.AttachmentsPayload, error) {
+	var err error
+	var message filespb.AttachmentsRequest
+	{
+		if filesAttachmentsMessage != "" {
+			err = json.Unmarshal([]byte(filesAttachmentsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ids\": [\n         \"Sed incidunt dolorem quisquam.\",\n         \"Nihil doloremque exercitationem explicabo id.\",\n         \"Magni ea.\",\n         \"Quisquam ea iusto illum delectus debitis.\"\n      ],\n      \"room_id\": \"Et voluptas.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = filesAttachmentsToken
+	}
+	v := &files.AttachmentsPayload{
+		RoomID: message.RoomId,
+	}
+	if message.Ids != nil {
+		v.Ids = make([]string, len(message.Ids))
+		for i, val := range message.Ids {
+			v.Ids[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSharePayload builds the payload for the files share endpoint from CLI
+// flags.
+func BuildSharePayload(filesShareMessage string, filesShareToken string) (*files.SharePayload, error) {
+	var err error
+	var message filespb.ShareRequest
+	{
+		if filesShareMessage != "" {
+			err = json.Unmarshal([]byte(filesShareMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"ids\": [\n         \"Et sunt reiciendis facere.\",\n         \"Aspernatur aspernatur excepturi.\"\n      ],\n      \"room_id\": \"Dolor molestiae ipsa.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = filesShareToken
+	}
+	v := &files.SharePayload{
+		RoomID: message.RoomId,
+	}
+	if message.Ids != nil {
+		v.Ids = make([]string, len(message.Ids))
+		for i, val := range message.Ids {
+			v.Ids[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
@@ -0,0 +1,132 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC client
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package client
+
+import (
+	"context"
+
+	goagrpc "goa.design/goa/v3/grpc"
+	goapb "goa.design/goa/v3/grpc/pb"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+// Client lists the service endpoint gRPC clients.
+type Client struct {
+	grpccli filespb.FilesClient
+	opts    []grpc.CallOption
+}
+
+// UploadClientStream implements the files.UploadClientStream interface.
+type UploadClientStream struct {
+	stream filespb.Files_UploadClient
+}
+
+// NewClient instantiates gRPC client for all the files service servers.
+func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
+	return &Client{
+		grpccli: filespb.NewFilesClient(cc),
+		opts:    opts,
+	}
+}
+
+// Upload calls the "Upload" function in filespb.FilesClient interface.
+func (c *Client) Upload() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildUploadFunc(c.grpccli, c.opts...),
+			EncodeUploadRequest,
+			DecodeUploadResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Attachments calls the "Attachments" function in filespb.FilesClient
+// interface.
+func (c *Client) Attachments() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildAttachmentsFunc(c.grpccli, c.opts...),
+			EncodeAttachmentsRequest,
+			DecodeAttachmentsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Share calls the "Share" function in filespb.FilesClient interface.
+func (c *Client) Share() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildShareFunc(c.grpccli, c.opts...),
+			EncodeShareRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CloseAndRecv reads instances of "filespb.UploadResponse" from the "upload"
+// endpoint gRPC stream.
+func (s *UploadClientStream) CloseAndRecv() (*files.Attachment, error) {
+	var res *files.Attachment
+	v, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return res, err
+	}
+	return NewUploadResponseAttachment(v), nil
+}
+
+// CloseAndRecvWithContext reads instances of "filespb.UploadResponse" from the
+// "upload" endpoint gRPC stream with context.
+func (s *UploadClientStream) CloseAndRecvWithContext(ctx context.Context) (*files.Attachment, error) {
+	return s.CloseAndRecv()
+}
+
+// Send streams instances of "filespb.UploadStreamingRequest" to the "upload"
+// endpoint gRPC stream.
+func (s *UploadClientStream) Send(res []byte) error {
+	v := NewProtoUploadStreamingRequest(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "filespb.UploadStreamingRequest" to the
+// "upload" endpoint gRPC stream with context.
+func (s *UploadClientStream) SendWithContext(ctx context.Context, res []byte) error {
+	return s.Send(res)
+}
@@ -0,0 +1,110 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC client encoders and decoders
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package client
+
+import (
+	"context"
+
+	goagrpc "goa.design/goa/v3/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+// BuildUploadFunc builds the remote method to invoke for "files" service
+// "upload" endpoint.
+func BuildUploadFunc(grpccli filespb.FilesClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Upload(ctx, opts...)
+		}
+		return grpccli.Upload(ctx, opts...)
+	}
+}
+
+// EncodeUploadRequest encodes requests sent to files upload endpoint.
+func EncodeUploadRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*files.UploadPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("files", "upload", "*files.UploadPayload", v)
+	}
+	(*md).Append("filename", payload.Filename)
+	(*md).Append("content_type", payload.ContentType)
+	(*md).Append("authorization", payload.Token)
+	return nil, nil
+}
+
+// DecodeUploadResponse decodes responses from the files upload endpoint.
+func DecodeUploadResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &UploadClientStream{
+		stream: v.(filespb.Files_UploadClient),
+	}, nil
+}
+
+// BuildAttachmentsFunc builds the remote method to invoke for "files" service
+// "attachments" endpoint.
+func BuildAttachmentsFunc(grpccli filespb.FilesClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Attachments(ctx, reqpb.(*filespb.AttachmentsRequest), opts...)
+		}
+		return grpccli.Attachments(ctx, &filespb.AttachmentsRequest{}, opts...)
+	}
+}
+
+// EncodeAttachmentsRequest encodes requests sent to files attachments endpoint.
+func EncodeAttachmentsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*files.AttachmentsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("files", "attachments", "*files.AttachmentsPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoAttachmentsRequest(payload), nil
+}
+
+// DecodeAttachmentsResponse decodes responses from the files attachments
+// endpoint.
+func DecodeAttachmentsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*filespb.AttachmentsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("files", "attachments", "*filespb.AttachmentsResponse", v)
+	}
+	res := NewAttachmentsResult(message)
+	return res, nil
+}
+
+// BuildShareFunc builds the remote method to invoke for "files" service
+// "share" endpoint.
+func BuildShareFunc(grpccli filespb.FilesClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Share(ctx, reqpb.(*filespb.ShareRequest), opts...)
+		}
+		return grpccli.Share(ctx, &filespb.ShareRequest{}, opts...)
+	}
+}
+
+// EncodeShareRequest encodes requests sent to files share endpoint.
+func EncodeShareRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*files.SharePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("files", "share", "*files.SharePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoShareRequest(payload), nil
+}
@@ -0,0 +1,80 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC client types
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package client
+
+import (
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+func NewUploadResponseAttachment(v *filespb.UploadResponse) *files.Attachment {
+	result := &files.Attachment{
+		ID:          v.Id,
+		Filename:    v.Filename,
+		ContentType: v.ContentType,
+		Size:        v.Size,
+		UserID:      v.UserId,
+		CreatedAt:   v.CreatedAt,
+		DownloadURL: v.DownloadUrl,
+	}
+	return result
+}
+
+func NewProtoUploadStreamingRequest(spayload []byte) *filespb.UploadStreamingRequest {
+	v := &filespb.UploadStreamingRequest{}
+	v.Field = spayload
+	return v
+}
+
+// NewProtoAttachmentsRequest builds the gRPC request type from the payload of
+// the "attachments" endpoint of the "files" service.
+func NewProtoAttachmentsRequest(payload *files.AttachmentsPayload) *filespb.AttachmentsRequest {
+	message := &filespb.AttachmentsRequest{
+		RoomId: payload.RoomID,
+	}
+	if payload.Ids != nil {
+		message.Ids = make([]string, len(payload.Ids))
+		for i, val := range payload.Ids {
+			message.Ids[i] = val
+		}
+	}
+	return message
+}
+
+// NewAttachmentsResult builds the result type of the "attachments" endpoint of
+// the "files" service from the gRPC response type.
+func NewAttachmentsResult(message *filespb.AttachmentsResponse) []*files.Attachment {
+	result := make([]*files.Attachment, len(message.Field))
+	for i, val := range message.Field {
+		result[i] = &files.Attachment{
+			ID:          val.Id,
+			Filename:    val.Filename,
+			ContentType: val.ContentType,
+			Size:        val.Size,
+			UserID:      val.UserId,
+			CreatedAt:   val.CreatedAt,
+			DownloadURL: val.DownloadUrl,
+		}
+	}
+	return result
+}
+
+// NewProtoShareRequest builds the gRPC request type from the payload of the
+// "share" endpoint of the "files" service.
+func NewProtoShareRequest(payload *files.SharePayload) *filespb.ShareRequest {
+	message := &filespb.ShareRequest{
+		RoomId: payload.RoomID,
+	}
+	if payload.Ids != nil {
+		message.Ids = make([]string, len(payload.Ids))
+		for i, val := range payload.Ids {
+			message.Ids[i] = val
+		}
+	}
+	return message
+}
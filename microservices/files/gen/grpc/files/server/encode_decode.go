@@ -0,0 +1,170 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC server encoders and decoders
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/metadata"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+// EncodeUploadResponse encodes responses from the "files" service "upload"
+// endpoint.
+func EncodeUploadResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*files.Attachment)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("files", "upload", "*files.Attachment", v)
+	}
+	resp := NewProtoUploadResponse(result)
+	return resp, nil
+}
+
+// DecodeUploadRequest decodes requests sent to "files" service "upload"
+// endpoint.
+func DecodeUploadRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		filename    string
+		contentType string
+		token       string
+		err         error
+	)
+	{
+		if vals := md.Get("filename"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("filename", "metadata"))
+		} else {
+			filename = vals[0]
+		}
+		if vals := md.Get("content_type"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "metadata"))
+		} else {
+			contentType = vals[0]
+		}
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var payload *files.UploadPayload
+	{
+		payload = NewUploadPayload(filename, contentType, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeAttachmentsResponse encodes responses from the "files" service
+// "attachments" endpoint.
+func EncodeAttachmentsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.([]*files.Attachment)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("files", "attachments", "[]*files.Attachment", v)
+	}
+	resp := NewProtoAttachmentsResponse(result)
+	return resp, nil
+}
+
+// DecodeAttachmentsRequest decodes requests sent to "files" service
+// "attachments" endpoint.
+func DecodeAttachmentsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *filespb.AttachmentsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*filespb.AttachmentsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("files", "attachments", "*filespb.AttachmentsRequest", v)
+		}
+		if err = ValidateAttachmentsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *files.AttachmentsPayload
+	{
+		payload = NewAttachmentsPayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
+
+// EncodeShareResponse encodes responses from the "files" service "share"
+// endpoint.
+func EncodeShareResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoShareResponse()
+	return resp, nil
+}
+
+// DecodeShareRequest decodes requests sent to "files" service "share" endpoint.
+func DecodeShareRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *filespb.ShareRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*filespb.ShareRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("files", "share", "*filespb.ShareRequest", v)
+		}
+		if err = ValidateShareRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *files.SharePayload
+	{
+		payload = NewSharePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
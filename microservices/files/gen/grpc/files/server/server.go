@@ -0,0 +1,183 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC server
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+// Server implements the filespb.FilesServer interface.
+type Server struct {
+	UploadH      goagrpc.StreamHandler
+	AttachmentsH goagrpc.UnaryHandler
+	ShareH       goagrpc.UnaryHandler
+	filespb.UnimplementedFilesServer
+}
+
+// UploadServerStream implements the files.UploadServerStream interface.
+type UploadServerStream struct {
+	stream filespb.Files_UploadServer
+}
+
+// New instantiates the server struct with the files service endpoints.
+func New(e *files.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
+	return &Server{
+		UploadH:      NewUploadHandler(e.Upload, sh),
+		AttachmentsH: NewAttachmentsHandler(e.Attachments, uh),
+		ShareH:       NewShareHandler(e.Share, uh),
+	}
+}
+
+// NewUploadHandler creates a gRPC handler which serves the "files" service
+// "upload" endpoint.
+func NewUploadHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, DecodeUploadRequest)
+	}
+	return h
+}
+
+// Upload implements the "Upload" method in filespb.FilesServer interface.
+func (s *Server) Upload(stream filespb.Files_UploadServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "upload")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "files")
+	p, err := s.UploadH.Decode(ctx, nil)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				return goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "invalid_argument":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	ep := &files.UploadEndpointInput{
+		Stream:  &UploadServerStream{stream: stream},
+		Payload: p.(*files.UploadPayload),
+	}
+	err = s.UploadH.Handle(ctx, ep)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				return goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "invalid_argument":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// NewAttachmentsHandler creates a gRPC handler which serves the "files"
+// service "attachments" endpoint.
+func NewAttachmentsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeAttachmentsRequest, EncodeAttachmentsResponse)
+	}
+	return h
+}
+
+// Attachments implements the "Attachments" method in filespb.FilesServer
+// interface.
+func (s *Server) Attachments(ctx context.Context, message *filespb.AttachmentsRequest) (*filespb.AttachmentsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "attachments")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "files")
+	resp, err := s.AttachmentsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*filespb.AttachmentsResponse), nil
+}
+
+// NewShareHandler creates a gRPC handler which serves the "files" service
+// "share" endpoint.
+func NewShareHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeShareRequest, EncodeShareResponse)
+	}
+	return h
+}
+
+// Share implements the "Share" method in filespb.FilesServer interface.
+func (s *Server) Share(ctx context.Context, message *filespb.ShareRequest) (*filespb.ShareResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "share")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "files")
+	resp, err := s.ShareH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*filespb.ShareResponse), nil
+}
+
+// SendAndClose streams instances of "filespb.UploadResponse" to the "upload"
+// endpoint gRPC stream.
+func (s *UploadServerStream) SendAndClose(res *files.Attachment) error {
+	v := NewProtoAttachmentUploadResponse(res)
+	return s.stream.SendAndClose(v)
+}
+
+// SendAndCloseWithContext streams instances of "filespb.UploadResponse" to the
+// "upload" endpoint gRPC stream with context.
+func (s *UploadServerStream) SendAndCloseWithContext(ctx context.Context, res *files.Attachment) error {
+	return s.SendAndClose(res)
+}
+
+// Recv reads instances of "filespb.UploadStreamingRequest" from the "upload"
+// endpoint gRPC stream.
+func (s *UploadServerStream) Recv() ([]byte, error) {
+	var res []byte
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	return NewUploadStreamingRequestUploadStreamingRequest(v), nil
+}
+
+// RecvWithContext reads instances of "filespb.UploadStreamingRequest" from the
+// "upload" endpoint gRPC stream with context.
+func (s *UploadServerStream) RecvWithContext(ctx context.Context) ([]byte, error) {
+	return s.Recv()
+}
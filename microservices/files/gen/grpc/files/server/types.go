@@ -0,0 +1,132 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files gRPC server types
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package server
+
+import (
+	goa "goa.design/goa/v3/pkg"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+	filespb "object-t.com/hackz-giganoto/microservices/files/gen/grpc/files/pb"
+)
+
+// NewUploadPayload builds the payload of the "upload" endpoint of the "files"
+// service from the gRPC request type.
+func NewUploadPayload(filename string, contentType string, token string) *files.UploadPayload {
+	v := &files.UploadPayload{}
+	v.Filename = filename
+	v.ContentType = contentType
+	v.Token = token
+	return v
+}
+
+// NewProtoUploadResponse builds the gRPC response type from the result of the
+// "upload" endpoint of the "files" service.
+func NewProtoUploadResponse(result *files.Attachment) *filespb.UploadResponse {
+	message := &filespb.UploadResponse{
+		Id:          result.ID,
+		Filename:    result.Filename,
+		ContentType: result.ContentType,
+		Size:        result.Size,
+		UserId:      result.UserID,
+		CreatedAt:   result.CreatedAt,
+		DownloadUrl: result.DownloadURL,
+	}
+	return message
+}
+
+func NewProtoAttachmentUploadResponse(result *files.Attachment) *filespb.UploadResponse {
+	v := &filespb.UploadResponse{
+		Id:          result.ID,
+		Filename:    result.Filename,
+		ContentType: result.ContentType,
+		Size:        result.Size,
+		UserId:      result.UserID,
+		CreatedAt:   result.CreatedAt,
+		DownloadUrl: result.DownloadURL,
+	}
+	return v
+}
+
+func NewUploadStreamingRequestUploadStreamingRequest(v *filespb.UploadStreamingRequest) []byte {
+	spayload := v.Field
+	return spayload
+}
+
+// NewAttachmentsPayload builds the payload of the "attachments" endpoint of
+// the "files" service from the gRPC request type.
+func NewAttachmentsPayload(message *filespb.AttachmentsRequest, token string) *files.AttachmentsPayload {
+	v := &files.AttachmentsPayload{
+		RoomID: message.RoomId,
+	}
+	if message.Ids != nil {
+		v.Ids = make([]string, len(message.Ids))
+		for i, val := range message.Ids {
+			v.Ids[i] = val
+		}
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoAttachmentsResponse builds the gRPC response type from the result of
+// the "attachments" endpoint of the "files" service.
+func NewProtoAttachmentsResponse(result []*files.Attachment) *filespb.AttachmentsResponse {
+	message := &filespb.AttachmentsResponse{}
+	message.Field = make([]*filespb.Attachment, len(result))
+	for i, val := range result {
+		message.Field[i] = &filespb.Attachment{
+			Id:          val.ID,
+			Filename:    val.Filename,
+			ContentType: val.ContentType,
+			Size:        val.Size,
+			UserId:      val.UserID,
+			CreatedAt:   val.CreatedAt,
+			DownloadUrl: val.DownloadURL,
+		}
+	}
+	return message
+}
+
+// NewSharePayload builds the payload of the "share" endpoint of the "files"
+// service from the gRPC request type.
+func NewSharePayload(message *filespb.ShareRequest, token string) *files.SharePayload {
+	v := &files.SharePayload{
+		RoomID: message.RoomId,
+	}
+	if message.Ids != nil {
+		v.Ids = make([]string, len(message.Ids))
+		for i, val := range message.Ids {
+			v.Ids[i] = val
+		}
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoShareResponse builds the gRPC response type from the result of the
+// "share" endpoint of the "files" service.
+func NewProtoShareResponse() *filespb.ShareResponse {
+	message := &filespb.ShareResponse{}
+	return message
+}
+
+// ValidateAttachmentsRequest runs the validations defined on
+// AttachmentsRequest.
+func ValidateAttachmentsRequest(message *filespb.AttachmentsRequest) (err error) {
+	if message.Ids == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ids", "message"))
+	}
+	return
+}
+
+// ValidateShareRequest runs the validations defined on ShareRequest.
+func ValidateShareRequest(message *filespb.ShareRequest) (err error) {
+	if message.Ids == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ids", "message"))
+	}
+	return
+}
@@ -0,0 +1,154 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files HTTP client CLI support package
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+	filesc "object-t.com/hackz-giganoto/microservices/files/gen/http/files/client"
+)
+
+// UsageCommands returns the set of commands and sub-commands using the format
+//
+//	command (subcommand1|subcommand2|...)
+func UsageCommands() string {
+	return `files download
+`
+}
+
+// UsageExamples produces an example of a valid invocation of the CLI tool.
+func UsageExamples() string {
+	return os.Args[0] + ` files download --id "Corrupti ut rerum voluptatem exercitationem nemo quis." --expires 8464755567256776842 --signature "Quidem facere perferendis sunt at."` + "\n" +
+		""
+}
+
+// ParseEndpoint returns the endpoint and payload as specified on the command
+// line.
+func ParseEndpoint(
+	scheme, host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restore bool,
+) (goa.Endpoint, any, error) {
+	var (
+		filesFlags = flag.NewFlagSet("files", flag.ContinueOnError)
+
+		filesDownloadFlags         = flag.NewFlagSet("download", flag.ExitOnError)
+		filesDownloadIDFlag        = filesDownloadFlags.String("id", "REQUIRED", "Attachment ID")
+		filesDownloadExpiresFlag   = filesDownloadFlags.String("expires", "REQUIRED", "")
+		filesDownloadSignatureFlag = filesDownloadFlags.String("signature", "REQUIRED", "")
+	)
+	filesFlags.Usage = filesUsage
+	filesDownloadFlags.Usage = filesDownloadUsage
+
+	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	if flag.NArg() < 2 { // two non flag args are required: SERVICE and ENDPOINT (aka COMMAND)
+		return nil, nil, fmt.Errorf("not enough arguments")
+	}
+
+	var (
+		svcn string
+		svcf *flag.FlagSet
+	)
+	{
+		svcn = flag.Arg(0)
+		switch svcn {
+		case "files":
+			svcf = filesFlags
+		default:
+			return nil, nil, fmt.Errorf("unknown service %q", svcn)
+		}
+	}
+	if err := svcf.Parse(flag.Args()[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		epn string
+		epf *flag.FlagSet
+	)
+	{
+		epn = svcf.Arg(0)
+		switch svcn {
+		case "files":
+			switch epn {
+			case "download":
+				epf = filesDownloadFlags
+
+			}
+
+		}
+	}
+	if epf == nil {
+		return nil, nil, fmt.Errorf("unknown %q endpoint %q", svcn, epn)
+	}
+
+	// Parse endpoint flags if any
+	if svcf.NArg() > 1 {
+		if err := epf.Parse(svcf.Args()[1:]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		data     any
+		endpoint goa.Endpoint
+		err      error
+	)
+	{
+		switch svcn {
+		case "files":
+			c := filesc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "download":
+				endpoint = c.Download()
+				data, err = filesc.BuildDownloadPayload(*filesDownloadIDFlag, *filesDownloadExpiresFlag, *filesDownloadSignatureFlag)
+			}
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return endpoint, data, nil
+}
+
+// filesUsage displays the usage of the files command and its subcommands.
+func filesUsage() {
+	fmt.Fprintf(os.Stderr, `Stores chat attachments and serves them through presigned URLs
+Usage:
+    %[1]s [globalflags] files COMMAND [flags]
+
+COMMAND:
+    download: Download a file through a presigned URL
+
+Additional help:
+    %[1]s files COMMAND --help
+`, os.Args[0])
+}
+func filesDownloadUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] files download -id STRING -expires INT64 -signature STRING
+
+Download a file through a presigned URL
+    -id STRING: Attachment ID
+    -expires INT64: 
+    -signature STRING: 
+
+Example:
+    %[1]s files download --id "Corrupti ut rerum voluptatem exercitationem nemo quis." --expires 8464755567256776842 --signature "Quidem facere perferendis sunt at."
+`, os.Args[0])
+}
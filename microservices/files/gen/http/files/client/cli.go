@@ -0,0 +1,42 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files HTTP client CLI support package
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package client
+
+import (
+	"fmt"
+	"strconv"
+
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+)
+
+// BuildDownloadPayload builds the payload for the files download endpoint from
+// CLI flags.
+func BuildDownloadPayload(filesDownloadID string, filesDownloadExpires string, filesDownloadSignature string) (*files.DownloadPayload, error) {
+	var err error
+	var id string
+	{
+		id = filesDownloadID
+	}
+	var expires int64
+	{
+		expires, err = strconv.ParseInt(filesDownloadExpires, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for expires, must be INT64")
+		}
+	}
+	var signature string
+	{
+		signature = filesDownloadSignature
+	}
+	v := &files.DownloadPayload{}
+	v.ID = id
+	v.Expires = expires
+	v.Signature = signature
+
+	return v, nil
+}
@@ -0,0 +1,154 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+)
+
+// BuildDownloadRequest instantiates a HTTP request object with method and path
+// set to call the "files" service "download" endpoint
+func (c *Client) BuildDownloadRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*files.DownloadPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("files", "download", "*files.DownloadPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadFilesPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("files", "download", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDownloadRequest returns an encoder for requests sent to the files
+// download server.
+func EncodeDownloadRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*files.DownloadPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("files", "download", "*files.DownloadPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("expires", fmt.Sprintf("%v", p.Expires))
+		values.Add("signature", p.Signature)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDownloadResponse returns a decoder for responses returned by the files
+// download endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeDownloadResponse may return the following errors:
+//   - "internal_error" (type files.InternalError): http.StatusInternalServerError
+//   - "invalid_signature" (type files.InvalidSignature): http.StatusForbidden
+//   - "not_found" (type files.NotFound): http.StatusNotFound
+//   - error: internal error
+func DecodeDownloadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentLength      int64
+				contentDisposition string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			{
+				contentLengthRaw := resp.Header.Get("Content-Length")
+				if contentLengthRaw == "" {
+					return nil, goahttp.ErrValidationError("files", "download", goa.MissingFieldError("content_length", "header"))
+				}
+				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
+				}
+				contentLength = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("files", "download", err)
+			}
+			res := NewDownloadResultOK(contentType, contentLength, contentDisposition)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("files", "download", err)
+			}
+			return nil, NewDownloadInternalError(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("files", "download", err)
+			}
+			return nil, NewDownloadInvalidSignature(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("files", "download", err)
+			}
+			return nil, NewDownloadNotFound(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("files", "download", resp.StatusCode, string(body))
+		}
+	}
+}
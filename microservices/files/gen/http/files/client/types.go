@@ -0,0 +1,46 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files HTTP client types
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package client
+
+import (
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+)
+
+// NewDownloadResultOK builds a "files" service "download" endpoint result from
+// a HTTP "OK" response.
+func NewDownloadResultOK(contentType string, contentLength int64, contentDisposition string) *files.DownloadResult {
+	v := &files.DownloadResult{}
+	v.ContentType = contentType
+	v.ContentLength = contentLength
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// NewDownloadInternalError builds a files service download endpoint
+// internal_error error.
+func NewDownloadInternalError(body string) files.InternalError {
+	v := files.InternalError(body)
+
+	return v
+}
+
+// NewDownloadInvalidSignature builds a files service download endpoint
+// invalid_signature error.
+func NewDownloadInvalidSignature(body string) files.InvalidSignature {
+	v := files.InvalidSignature(body)
+
+	return v
+}
+
+// NewDownloadNotFound builds a files service download endpoint not_found error.
+func NewDownloadNotFound(body string) files.NotFound {
+	v := files.NotFound(body)
+
+	return v
+}
@@ -0,0 +1,17 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the files service.
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// DownloadFilesPath returns the URL path to the files service download HTTP endpoint.
+func DownloadFilesPath(id string) string {
+	return fmt.Sprintf("/files/%v", id)
+}
@@ -0,0 +1,22 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// files HTTP server types
+//
+// Command:
+// $ goa gen object-t.com/hackz-giganoto/microservices/files/design
+
+package server
+
+import (
+	files "object-t.com/hackz-giganoto/microservices/files/gen/files"
+)
+
+// NewDownloadPayload builds a files service download endpoint payload.
+func NewDownloadPayload(id string, expires int64, signature string) *files.DownloadPayload {
+	v := &files.DownloadPayload{}
+	v.ID = id
+	v.Expires = expires
+	v.Signature = signature
+
+	return v
+}
@@ -0,0 +1,75 @@
+package filesapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Storage persists the content of uploaded files. The local filesystem
+// implementation can be swapped for an S3-compatible one.
+type Storage interface {
+	// Save writes the content read from r under key and returns the number
+	// of bytes written.
+	Save(ctx context.Context, key string, r io.Reader) (int64, error)
+	// Open returns a reader for the content stored under key.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under key.
+	Delete(ctx context.Context, key string) error
+}
+
+type localStorage struct {
+	dir string
+}
+
+// NewLocalStorage returns a Storage keeping files in dir.
+func NewLocalStorage(dir string) (Storage, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &localStorage{dir: dir}, nil
+}
+
+func (s *localStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.Base(key))
+}
+
+func (s *localStorage) Save(ctx context.Context, key string, r io.Reader) (int64, error) {
+	// Write to a temporary file first so that a failed upload never leaves a
+	// partial file behind the key.
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (s *localStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(s.path(key))
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
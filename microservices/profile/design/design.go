@@ -50,6 +50,8 @@ var _ = Service("profile", func() {
 		Result(func() {
 			Field(1, "user_id", String, "User ID")
 			Field(2, "name", String, "User name")
+			Field(3, "login", String, "Login at the identity provider")
+			Field(4, "avatar_url", String, "Avatar URL from the identity provider")
 			Required("user_id", "name")
 		})
 
@@ -95,4 +97,40 @@ var _ = Service("profile", func() {
 			Response("internal_error", CodeInternal)
 		})
 	})
+
+	Method("register_profile", func() {
+		Description("Create the profile of a new user seeded from its identity provider. Called by auth on first login and on the first switch to a workspace, an existing profile is left unchanged")
+
+		Security(JWTAuth, func() {
+			Scope("api:register")
+		})
+
+		Payload(func() {
+			Token("token", String, "JWT token of the calling service")
+			Field(1, "user_id", String, "User ID")
+			Field(2, "name", String, "User name")
+			Field(3, "login", String, "Login at the identity provider")
+			Field(4, "avatar_url", String, "Avatar URL from the identity provider")
+			Field(5, "workspace_id", String, "Workspace of the profile, absent for the default workspace")
+			Required("token", "user_id", "name", "login")
+		})
+
+		Result(func() {
+			Field(1, "user_id", String, "User ID")
+			Field(2, "name", String, "User name")
+			Field(3, "created", Boolean, "Whether the profile was created by this call")
+			Required("user_id", "name", "created")
+		})
+
+		Error("unauthorized", String, "Unauthorized access")
+		Error("bad_request", String, "Invalid request")
+		Error("internal_error", String, "Internal server error")
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("bad_request", CodeInvalidArgument)
+			Response("internal_error", CodeInternal)
+		})
+	})
 })
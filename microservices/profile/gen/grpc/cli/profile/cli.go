@@ -21,15 +21,15 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `profile (get-profile|update-profile)
+	return `profile (get-profile|update-profile|register-profile)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` profile get-profile --message '{
-      "user_id": "Omnis alias quo numquam numquam molestiae quod."
-   }' --token "Quia repellendus et ullam blanditiis natus sunt."` + "\n" +
+      "user_id": "Velit maiores."
+   }' --token "Voluptatem qui dolores."` + "\n" +
 		""
 }
 
@@ -49,10 +49,15 @@ func ParseEndpoint(
 		profileUpdateProfileFlags       = flag.NewFlagSet("update-profile", flag.ExitOnError)
 		profileUpdateProfileMessageFlag = profileUpdateProfileFlags.String("message", "", "")
 		profileUpdateProfileTokenFlag   = profileUpdateProfileFlags.String("token", "", "")
+
+		profileRegisterProfileFlags       = flag.NewFlagSet("register-profile", flag.ExitOnError)
+		profileRegisterProfileMessageFlag = profileRegisterProfileFlags.String("message", "", "")
+		profileRegisterProfileTokenFlag   = profileRegisterProfileFlags.String("token", "REQUIRED", "")
 	)
 	profileFlags.Usage = profileUsage
 	profileGetProfileFlags.Usage = profileGetProfileUsage
 	profileUpdateProfileFlags.Usage = profileUpdateProfileUsage
+	profileRegisterProfileFlags.Usage = profileRegisterProfileUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -94,6 +99,9 @@ func ParseEndpoint(
 			case "update-profile":
 				epf = profileUpdateProfileFlags
 
+			case "register-profile":
+				epf = profileRegisterProfileFlags
+
 			}
 
 		}
@@ -125,6 +133,9 @@ func ParseEndpoint(
 			case "update-profile":
 				endpoint = c.UpdateProfile()
 				data, err = profilec.BuildUpdateProfilePayload(*profileUpdateProfileMessageFlag, *profileUpdateProfileTokenFlag)
+			case "register-profile":
+				endpoint = c.RegisterProfile()
+				data, err = profilec.BuildRegisterProfilePayload(*profileRegisterProfileMessageFlag, *profileRegisterProfileTokenFlag)
 			}
 		}
 	}
@@ -144,6 +155,7 @@ Usage:
 COMMAND:
     get-profile: Get user profile
     update-profile: Update user profile
+    register-profile: Create the profile of a new user seeded from its identity provider. Called by auth on first login and on the first switch to a workspace, an existing profile is left unchanged
 
 Additional help:
     %[1]s profile COMMAND --help
@@ -158,8 +170,8 @@ Get user profile
 
 Example:
     %[1]s profile get-profile --message '{
-      "user_id": "Omnis alias quo numquam numquam molestiae quod."
-   }' --token "Quia repellendus et ullam blanditiis natus sunt."
+      "user_id": "Velit maiores."
+   }' --token "Voluptatem qui dolores."
 `, os.Args[0])
 }
 
@@ -172,7 +184,25 @@ Update user profile
 
 Example:
     %[1]s profile update-profile --message '{
-      "name": "Omnis enim omnis autem accusamus."
-   }' --token "Officiis ad quas nihil."
+      "name": "Et itaque."
+   }' --token "Incidunt nisi culpa."
+`, os.Args[0])
+}
+
+func profileRegisterProfileUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] profile register-profile -message JSON -token STRING
+
+Create the profile of a new user seeded from its identity provider. Called by auth on first login and on the first switch to a workspace, an existing profile is left unchanged
+    -message JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s profile register-profile --message '{
+      "avatar_url": "Non laudantium corrupti illum adipisci.",
+      "login": "Eveniet ea qui tempore quo velit.",
+      "name": "Rerum necessitatibus provident sed iure.",
+      "user_id": "Perferendis aperiam architecto nemo optio.",
+      "workspace_id": "Inventore perspiciatis ea."
+   }' --token "Et atque ut modi."
 `, os.Args[0])
 }
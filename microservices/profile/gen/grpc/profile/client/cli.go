@@ -24,7 +24,7 @@ func BuildGetProfilePayload(profileGetProfileMessage string, profileGetProfileTo
 		if profileGetProfileMessage != "" {
 			err = json.Unmarshal([]byte(profileGetProfileMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": \"Omnis alias quo numquam numquam molestiae quod.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": \"Velit maiores.\"\n   }'")
 			}
 		}
 	}
@@ -49,7 +49,7 @@ func BuildUpdateProfilePayload(profileUpdateProfileMessage string, profileUpdate
 		if profileUpdateProfileMessage != "" {
 			err = json.Unmarshal([]byte(profileUpdateProfileMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"Omnis enim omnis autem accusamus.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"Et itaque.\"\n   }'")
 			}
 		}
 	}
@@ -66,3 +66,32 @@ func BuildUpdateProfilePayload(profileUpdateProfileMessage string, profileUpdate
 
 	return v, nil
 }
+
+// BuildRegisterProfilePayload builds the payload for the profile
+// register_profile endpoint from CLI flags.
+func BuildRegisterProfilePayload(profileRegisterProfileMessage string, profileRegisterProfileToken string) (*profile.RegisterProfilePayload, error) {
+	var err error
+	var message profilepb.RegisterProfileRequest
+	{
+		if profileRegisterProfileMessage != "" {
+			err = json.Unmarshal([]byte(profileRegisterProfileMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"Non laudantium corrupti illum adipisci.\",\n      \"login\": \"Eveniet ea qui tempore quo velit.\",\n      \"name\": \"Rerum necessitatibus provident sed iure.\",\n      \"user_id\": \"Perferendis aperiam architecto nemo optio.\",\n      \"workspace_id\": \"Inventore perspiciatis ea.\"\n   }'")
+			}
+		}
+	}
+	var token string
+	{
+		token = profileRegisterProfileToken
+	}
+	v := &profile.RegisterProfilePayload{
+		UserID:      message.UserId,
+		Name:        message.Name,
+		Login:       message.Login,
+		AvatarURL:   message.AvatarUrl,
+		WorkspaceID: message.WorkspaceId,
+	}
+	v.Token = token
+
+	return v, nil
+}
@@ -74,3 +74,25 @@ func (c *Client) UpdateProfile() goa.Endpoint {
 		return res, nil
 	}
 }
+
+// RegisterProfile calls the "RegisterProfile" function in
+// profilepb.ProfileClient interface.
+func (c *Client) RegisterProfile() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRegisterProfileFunc(c.grpccli, c.opts...),
+			EncodeRegisterProfileRequest,
+			DecodeRegisterProfileResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
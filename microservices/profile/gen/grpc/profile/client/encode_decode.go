@@ -90,3 +90,39 @@ func DecodeUpdateProfileResponse(ctx context.Context, v any, hdr, trlr metadata.
 	res := NewUpdateProfileResult(message)
 	return res, nil
 }
+
+// BuildRegisterProfileFunc builds the remote method to invoke for "profile"
+// service "register_profile" endpoint.
+func BuildRegisterProfileFunc(grpccli profilepb.ProfileClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RegisterProfile(ctx, reqpb.(*profilepb.RegisterProfileRequest), opts...)
+		}
+		return grpccli.RegisterProfile(ctx, &profilepb.RegisterProfileRequest{}, opts...)
+	}
+}
+
+// EncodeRegisterProfileRequest encodes requests sent to profile
+// register_profile endpoint.
+func EncodeRegisterProfileRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*profile.RegisterProfilePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("profile", "register_profile", "*profile.RegisterProfilePayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return NewProtoRegisterProfileRequest(payload), nil
+}
+
+// DecodeRegisterProfileResponse decodes responses from the profile
+// register_profile endpoint.
+func DecodeRegisterProfileResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*profilepb.RegisterProfileResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("profile", "register_profile", "*profilepb.RegisterProfileResponse", v)
+	}
+	res := NewRegisterProfileResult(message)
+	return res, nil
+}
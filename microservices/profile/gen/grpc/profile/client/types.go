@@ -25,8 +25,10 @@ func NewProtoGetProfileRequest(payload *profile.GetProfilePayload) *profilepb.Ge
 // the "profile" service from the gRPC response type.
 func NewGetProfileResult(message *profilepb.GetProfileResponse) *profile.GetProfileResult {
 	result := &profile.GetProfileResult{
-		UserID: message.UserId,
-		Name:   message.Name,
+		UserID:    message.UserId,
+		Name:      message.Name,
+		Login:     message.Login,
+		AvatarURL: message.AvatarUrl,
 	}
 	return result
 }
@@ -49,3 +51,27 @@ func NewUpdateProfileResult(message *profilepb.UpdateProfileResponse) *profile.U
 	}
 	return result
 }
+
+// NewProtoRegisterProfileRequest builds the gRPC request type from the payload
+// of the "register_profile" endpoint of the "profile" service.
+func NewProtoRegisterProfileRequest(payload *profile.RegisterProfilePayload) *profilepb.RegisterProfileRequest {
+	message := &profilepb.RegisterProfileRequest{
+		UserId:      payload.UserID,
+		Name:        payload.Name,
+		Login:       payload.Login,
+		AvatarUrl:   payload.AvatarURL,
+		WorkspaceId: payload.WorkspaceID,
+	}
+	return message
+}
+
+// NewRegisterProfileResult builds the result type of the "register_profile"
+// endpoint of the "profile" service from the gRPC response type.
+func NewRegisterProfileResult(message *profilepb.RegisterProfileResponse) *profile.RegisterProfileResult {
+	result := &profile.RegisterProfileResult{
+		UserID:  message.UserId,
+		Name:    message.Name,
+		Created: message.Created,
+	}
+	return result
+}
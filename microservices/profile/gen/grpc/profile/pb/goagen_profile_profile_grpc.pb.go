@@ -26,8 +26,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Profile_GetProfile_FullMethodName    = "/profile.v1.Profile/GetProfile"
-	Profile_UpdateProfile_FullMethodName = "/profile.v1.Profile/UpdateProfile"
+	Profile_GetProfile_FullMethodName      = "/profile.v1.Profile/GetProfile"
+	Profile_UpdateProfile_FullMethodName   = "/profile.v1.Profile/UpdateProfile"
+	Profile_RegisterProfile_FullMethodName = "/profile.v1.Profile/RegisterProfile"
 )
 
 // ProfileClient is the client API for Profile service.
@@ -40,6 +41,10 @@ type ProfileClient interface {
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	// Update user profile
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// Create the profile of a new user seeded from its identity provider. Called
+	// by auth on first login and on the first switch to a workspace, an existing
+	// profile is left unchanged
+	RegisterProfile(ctx context.Context, in *RegisterProfileRequest, opts ...grpc.CallOption) (*RegisterProfileResponse, error)
 }
 
 type profileClient struct {
@@ -70,6 +75,16 @@ func (c *profileClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequ
 	return out, nil
 }
 
+func (c *profileClient) RegisterProfile(ctx context.Context, in *RegisterProfileRequest, opts ...grpc.CallOption) (*RegisterProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterProfileResponse)
+	err := c.cc.Invoke(ctx, Profile_RegisterProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServer is the server API for Profile service.
 // All implementations must embed UnimplementedProfileServer
 // for forward compatibility.
@@ -80,6 +95,10 @@ type ProfileServer interface {
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
 	// Update user profile
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// Create the profile of a new user seeded from its identity provider. Called
+	// by auth on first login and on the first switch to a workspace, an existing
+	// profile is left unchanged
+	RegisterProfile(context.Context, *RegisterProfileRequest) (*RegisterProfileResponse, error)
 	mustEmbedUnimplementedProfileServer()
 }
 
@@ -96,6 +115,9 @@ func (UnimplementedProfileServer) GetProfile(context.Context, *GetProfileRequest
 func (UnimplementedProfileServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedProfileServer) RegisterProfile(context.Context, *RegisterProfileRequest) (*RegisterProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProfile not implemented")
+}
 func (UnimplementedProfileServer) mustEmbedUnimplementedProfileServer() {}
 func (UnimplementedProfileServer) testEmbeddedByValue()                 {}
 
@@ -153,6 +175,24 @@ func _Profile_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Profile_RegisterProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).RegisterProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_RegisterProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).RegisterProfile(ctx, req.(*RegisterProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Profile_ServiceDesc is the grpc.ServiceDesc for Profile service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -168,6 +208,10 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _Profile_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "RegisterProfile",
+			Handler:    _Profile_RegisterProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "goagen_profile_profile.proto",
@@ -115,3 +115,52 @@ func DecodeUpdateProfileRequest(ctx context.Context, v any, md metadata.MD) (any
 	}
 	return payload, nil
 }
+
+// EncodeRegisterProfileResponse encodes responses from the "profile" service
+// "register_profile" endpoint.
+func EncodeRegisterProfileResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*profile.RegisterProfileResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("profile", "register_profile", "*profile.RegisterProfileResult", v)
+	}
+	resp := NewProtoRegisterProfileResponse(result)
+	return resp, nil
+}
+
+// DecodeRegisterProfileRequest decodes requests sent to "profile" service
+// "register_profile" endpoint.
+func DecodeRegisterProfileRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *profilepb.RegisterProfileRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*profilepb.RegisterProfileRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("profile", "register_profile", "*profilepb.RegisterProfileRequest", v)
+		}
+	}
+	var payload *profile.RegisterProfilePayload
+	{
+		payload = NewRegisterProfilePayload(message, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+	}
+	return payload, nil
+}
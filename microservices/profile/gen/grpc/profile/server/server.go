@@ -20,16 +20,18 @@ import (
 
 // Server implements the profilepb.ProfileServer interface.
 type Server struct {
-	GetProfileH    goagrpc.UnaryHandler
-	UpdateProfileH goagrpc.UnaryHandler
+	GetProfileH      goagrpc.UnaryHandler
+	UpdateProfileH   goagrpc.UnaryHandler
+	RegisterProfileH goagrpc.UnaryHandler
 	profilepb.UnimplementedProfileServer
 }
 
 // New instantiates the server struct with the profile service endpoints.
 func New(e *profile.Endpoints, uh goagrpc.UnaryHandler) *Server {
 	return &Server{
-		GetProfileH:    NewGetProfileHandler(e.GetProfile, uh),
-		UpdateProfileH: NewUpdateProfileHandler(e.UpdateProfile, uh),
+		GetProfileH:      NewGetProfileHandler(e.GetProfile, uh),
+		UpdateProfileH:   NewUpdateProfileHandler(e.UpdateProfile, uh),
+		RegisterProfileH: NewRegisterProfileHandler(e.RegisterProfile, uh),
 	}
 }
 
@@ -96,3 +98,35 @@ func (s *Server) UpdateProfile(ctx context.Context, message *profilepb.UpdatePro
 	}
 	return resp.(*profilepb.UpdateProfileResponse), nil
 }
+
+// NewRegisterProfileHandler creates a gRPC handler which serves the "profile"
+// service "register_profile" endpoint.
+func NewRegisterProfileHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRegisterProfileRequest, EncodeRegisterProfileResponse)
+	}
+	return h
+}
+
+// RegisterProfile implements the "RegisterProfile" method in
+// profilepb.ProfileServer interface.
+func (s *Server) RegisterProfile(ctx context.Context, message *profilepb.RegisterProfileRequest) (*profilepb.RegisterProfileResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "register_profile")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "profile")
+	resp, err := s.RegisterProfileH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "bad_request":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*profilepb.RegisterProfileResponse), nil
+}
@@ -26,8 +26,10 @@ func NewGetProfilePayload(message *profilepb.GetProfileRequest, token string) *p
 // the "get_profile" endpoint of the "profile" service.
 func NewProtoGetProfileResponse(result *profile.GetProfileResult) *profilepb.GetProfileResponse {
 	message := &profilepb.GetProfileResponse{
-		UserId: result.UserID,
-		Name:   result.Name,
+		UserId:    result.UserID,
+		Name:      result.Name,
+		Login:     result.Login,
+		AvatarUrl: result.AvatarURL,
 	}
 	return message
 }
@@ -51,3 +53,28 @@ func NewProtoUpdateProfileResponse(result *profile.UpdateProfileResult) *profile
 	}
 	return message
 }
+
+// NewRegisterProfilePayload builds the payload of the "register_profile"
+// endpoint of the "profile" service from the gRPC request type.
+func NewRegisterProfilePayload(message *profilepb.RegisterProfileRequest, token string) *profile.RegisterProfilePayload {
+	v := &profile.RegisterProfilePayload{
+		UserID:      message.UserId,
+		Name:        message.Name,
+		Login:       message.Login,
+		AvatarURL:   message.AvatarUrl,
+		WorkspaceID: message.WorkspaceId,
+	}
+	v.Token = token
+	return v
+}
+
+// NewProtoRegisterProfileResponse builds the gRPC response type from the
+// result of the "register_profile" endpoint of the "profile" service.
+func NewProtoRegisterProfileResponse(result *profile.RegisterProfileResult) *profilepb.RegisterProfileResponse {
+	message := &profilepb.RegisterProfileResponse{
+		UserId:  result.UserID,
+		Name:    result.Name,
+		Created: result.Created,
+	}
+	return message
+}
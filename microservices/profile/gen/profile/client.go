@@ -15,15 +15,17 @@ import (
 
 // Client is the "profile" service client.
 type Client struct {
-	GetProfileEndpoint    goa.Endpoint
-	UpdateProfileEndpoint goa.Endpoint
+	GetProfileEndpoint      goa.Endpoint
+	UpdateProfileEndpoint   goa.Endpoint
+	RegisterProfileEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "profile" service client given the endpoints.
-func NewClient(getProfile, updateProfile goa.Endpoint) *Client {
+func NewClient(getProfile, updateProfile, registerProfile goa.Endpoint) *Client {
 	return &Client{
-		GetProfileEndpoint:    getProfile,
-		UpdateProfileEndpoint: updateProfile,
+		GetProfileEndpoint:      getProfile,
+		UpdateProfileEndpoint:   updateProfile,
+		RegisterProfileEndpoint: registerProfile,
 	}
 }
 
@@ -56,3 +58,19 @@ func (c *Client) UpdateProfile(ctx context.Context, p *UpdateProfilePayload) (re
 	}
 	return ires.(*UpdateProfileResult), nil
 }
+
+// RegisterProfile calls the "register_profile" endpoint of the "profile"
+// service.
+// RegisterProfile may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "bad_request" (type BadRequest)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) RegisterProfile(ctx context.Context, p *RegisterProfilePayload) (res *RegisterProfileResult, err error) {
+	var ires any
+	ires, err = c.RegisterProfileEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RegisterProfileResult), nil
+}
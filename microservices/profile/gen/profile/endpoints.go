@@ -16,8 +16,9 @@ import (
 
 // Endpoints wraps the "profile" service endpoints.
 type Endpoints struct {
-	GetProfile    goa.Endpoint
-	UpdateProfile goa.Endpoint
+	GetProfile      goa.Endpoint
+	UpdateProfile   goa.Endpoint
+	RegisterProfile goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "profile" service with endpoints.
@@ -25,8 +26,9 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		GetProfile:    NewGetProfileEndpoint(s, a.JWTAuth),
-		UpdateProfile: NewUpdateProfileEndpoint(s, a.JWTAuth),
+		GetProfile:      NewGetProfileEndpoint(s, a.JWTAuth),
+		UpdateProfile:   NewUpdateProfileEndpoint(s, a.JWTAuth),
+		RegisterProfile: NewRegisterProfileEndpoint(s, a.JWTAuth),
 	}
 }
 
@@ -34,6 +36,7 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetProfile = m(e.GetProfile)
 	e.UpdateProfile = m(e.UpdateProfile)
+	e.RegisterProfile = m(e.RegisterProfile)
 }
 
 // NewGetProfileEndpoint returns an endpoint function that calls the method
@@ -77,3 +80,22 @@ func NewUpdateProfileEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		return s.UpdateProfile(ctx, p)
 	}
 }
+
+// NewRegisterProfileEndpoint returns an endpoint function that calls the
+// method "register_profile" of service "profile".
+func NewRegisterProfileEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RegisterProfilePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"api:read", "api:write", "api:register"},
+			RequiredScopes: []string{"api:register"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RegisterProfile(ctx, p)
+	}
+}
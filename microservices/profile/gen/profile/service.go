@@ -19,6 +19,10 @@ type Service interface {
 	GetProfile(context.Context, *GetProfilePayload) (res *GetProfileResult, err error)
 	// Update user profile
 	UpdateProfile(context.Context, *UpdateProfilePayload) (res *UpdateProfileResult, err error)
+	// Create the profile of a new user seeded from its identity provider. Called
+	// by auth on first login and on the first switch to a workspace, an existing
+	// profile is left unchanged
+	RegisterProfile(context.Context, *RegisterProfilePayload) (res *RegisterProfileResult, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -41,7 +45,7 @@ const ServiceName = "profile"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"get_profile", "update_profile"}
+var MethodNames = [3]string{"get_profile", "update_profile", "register_profile"}
 
 // GetProfilePayload is the payload type of the profile service get_profile
 // method.
@@ -59,6 +63,38 @@ type GetProfileResult struct {
 	UserID string
 	// User name
 	Name string
+	// Login at the identity provider
+	Login *string
+	// Avatar URL from the identity provider
+	AvatarURL *string
+}
+
+// RegisterProfilePayload is the payload type of the profile service
+// register_profile method.
+type RegisterProfilePayload struct {
+	// JWT token of the calling service
+	Token string
+	// User ID
+	UserID string
+	// User name
+	Name string
+	// Login at the identity provider
+	Login string
+	// Avatar URL from the identity provider
+	AvatarURL *string
+	// Workspace of the profile, absent for the default workspace
+	WorkspaceID *string
+}
+
+// RegisterProfileResult is the result type of the profile service
+// register_profile method.
+type RegisterProfileResult struct {
+	// User ID
+	UserID string
+	// User name
+	Name string
+	// Whether the profile was created by this call
+	Created bool
 }
 
 // UpdateProfilePayload is the payload type of the profile service
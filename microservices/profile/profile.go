@@ -44,7 +44,8 @@ func (s *profilesrvc) JWTAuth(ctx context.Context, token string, scheme *securit
 func (s *profilesrvc) GetProfile(ctx context.Context, p *profile.GetProfilePayload) (res *profile.GetProfileResult, err error) {
 	log.Printf(ctx, "profile.get_profile")
 
-	profileKey := "profile:" + p.UserID
+	workspaceID := security2.ContextAuthInfo(ctx).WorkspaceID()
+	profileKey := workspaceKey(workspaceID, "profile:"+p.UserID)
 	userName := s.redis.Get(ctx, profileKey).Val()
 
 	if userName == "" {
@@ -56,14 +57,31 @@ func (s *profilesrvc) GetProfile(ctx context.Context, p *profile.GetProfilePaylo
 		Name:   userName,
 	}
 
+	identity, err := s.redis.HGetAll(ctx, profileIdentityKey(workspaceID, p.UserID)).Result()
+	if err != nil {
+		log.Print(ctx, log.KV{"profile.get_profile", "ERROR: failed to load identity"}, log.KV{"error", err.Error()})
+		return nil, profile.InternalError("Internal error")
+	}
+	if login, ok := identity["login"]; ok {
+		res.Login = &login
+	}
+	if avatarURL, ok := identity["avatar_url"]; ok {
+		res.AvatarURL = &avatarURL
+	}
+
 	return
 }
 
 // Update user profile
 func (s *profilesrvc) UpdateProfile(ctx context.Context, p *profile.UpdateProfilePayload) (res *profile.UpdateProfileResult, err error) {
 	log.Printf(ctx, "profile.update_profile")
-	userId := security2.ContextAuthInfo(ctx).Claims["user_id"].(string)
-	profileKey := "profile:" + userId
+	authInfo := security2.ContextAuthInfo(ctx)
+	userId := authInfo.UserID()
+	if userId == "" {
+		// Service principals have no profile of their own
+		return nil, profile.Unauthorized("user not authenticated")
+	}
+	profileKey := workspaceKey(authInfo.WorkspaceID(), "profile:"+userId)
 
 	if s.redis.Set(ctx, profileKey, p.Name, 0).Err() != nil {
 		return nil, profile.InternalError("Internal error")
@@ -74,3 +92,71 @@ func (s *profilesrvc) UpdateProfile(ctx context.Context, p *profile.UpdateProfil
 		Name:   p.Name,
 	}, nil
 }
+
+// Create the profile of a new user seeded from its identity provider
+func (s *profilesrvc) RegisterProfile(ctx context.Context, p *profile.RegisterProfilePayload) (res *profile.RegisterProfileResult, err error) {
+	log.Printf(ctx, "profile.register_profile")
+	if !security2.ContextAuthInfo(ctx).IsService() {
+		// Users create their profile with UpdateProfile
+		return nil, profile.Unauthorized("only services may register profiles")
+	}
+	if p.UserID == "" {
+		return nil, profile.BadRequest("user_id is required")
+	}
+
+	name := p.Name
+	if name == "" {
+		name = p.Login
+	}
+
+	var workspaceID string
+	if p.WorkspaceID != nil {
+		workspaceID = *p.WorkspaceID
+	}
+
+	// SETNX keeps the name the user may have chosen since, so that
+	// registering twice is harmless.
+	profileKey := workspaceKey(workspaceID, "profile:"+p.UserID)
+	created, err := s.redis.SetNX(ctx, profileKey, name, 0).Result()
+	if err != nil {
+		log.Print(ctx, log.KV{"profile.register_profile", "ERROR: failed to create profile"}, log.KV{"error", err.Error()})
+		return nil, profile.InternalError("Internal error")
+	}
+
+	identityKey := profileIdentityKey(workspaceID, p.UserID)
+	if err := s.redis.HSetNX(ctx, identityKey, "login", p.Login).Err(); err != nil {
+		log.Print(ctx, log.KV{"profile.register_profile", "ERROR: failed to store identity"}, log.KV{"error", err.Error()})
+		return nil, profile.InternalError("Internal error")
+	}
+	if p.AvatarURL != nil && *p.AvatarURL != "" {
+		if err := s.redis.HSetNX(ctx, identityKey, "avatar_url", *p.AvatarURL).Err(); err != nil {
+			log.Print(ctx, log.KV{"profile.register_profile", "ERROR: failed to store identity"}, log.KV{"error", err.Error()})
+			return nil, profile.InternalError("Internal error")
+		}
+	}
+
+	if !created {
+		name = s.redis.Get(ctx, profileKey).Val()
+	}
+
+	return &profile.RegisterProfileResult{
+		UserID:  p.UserID,
+		Name:    name,
+		Created: created,
+	}, nil
+}
+
+// profileIdentityKey is the hash of the identity provider fields of the
+// profile.
+func profileIdentityKey(workspaceID, userID string) string {
+	return workspaceKey(workspaceID, "profile_identity:"+userID)
+}
+
+// workspaceKey scopes the Redis key to the workspace. The default workspace
+// keeps the unscoped keys of the profiles created before workspaces.
+func workspaceKey(workspaceID, key string) string {
+	if workspaceID == "" {
+		return key
+	}
+	return "workspace:" + workspaceID + ":" + key
+}
@@ -0,0 +1,284 @@
+// Package cliauth logs the command line clients in with the device
+// authorization flow of the auth service and keeps their credentials.
+package cliauth
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Credentials are the tokens of a logged in user.
+type Credentials struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	UserID       string    `json:"user_id"`
+}
+
+// ErrNotLoggedIn is returned when no credentials are stored.
+var ErrNotLoggedIn = errors.New("not logged in, run the login command first")
+
+type tokenResult struct {
+	AccessToken  string `json:"access_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	UserID       string `json:"user_id"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type deviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// authURL returns the base URL of the auth service.
+func authURL() string {
+	if u := os.Getenv("AUTH_URL"); u != "" {
+		return strings.TrimSuffix(u, "/")
+	}
+	return "http://localhost:8000"
+}
+
+// credentialsPath returns where the credentials are stored.
+func credentialsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "hackz-giganoto", "credentials.json"), nil
+}
+
+// Login runs the device authorization flow with the identity provider and
+// stores the credentials.
+func Login(provider string) (*Credentials, error) {
+	var device deviceAuthorization
+	// The client ID is shown to the user on the consent page.
+	status, _, err := post("/auth/device/code", map[string]string{"provider": provider, "client_id": filepath.Base(os.Args[0])}, &device)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("device authorization failed with status %d", status)
+	}
+
+	fmt.Fprintf(os.Stderr, "Open %s and enter the code %s\n", device.VerificationURI, device.UserCode)
+	fmt.Fprintf(os.Stderr, "or open %s\n", device.VerificationURIComplete)
+
+	interval := time.Duration(device.Interval) * time.Second
+	deadline := time.Now().Add(time.Duration(device.ExpiresIn) * time.Second)
+	for time.Now().Before(deadline) {
+		time.Sleep(interval)
+
+		var res tokenResult
+		status, goaErr, err := post("/auth/device/token", map[string]string{
+			"grant_type":  "urn:ietf:params:oauth:grant-type:device_code",
+			"device_code": device.DeviceCode,
+		}, &res)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case status == http.StatusOK:
+			creds := credentials(&res)
+			return creds, save(creds)
+		case goaErr == "authorization_pending":
+		case goaErr == "slow_down":
+			interval += 5 * time.Second
+		default:
+			return nil, fmt.Errorf("login failed: %s", errorName(status, goaErr))
+		}
+	}
+
+	return nil, errors.New("login failed: the device code expired")
+}
+
+// Token returns the internal JWT of the logged in user, refreshing the
+// credentials if they expired.
+func Token() (string, error) {
+	creds, err := load()
+	if err != nil {
+		return "", err
+	}
+
+	// Refresh a bit early so that the JWT outlives the request.
+	if time.Now().Add(30 * time.Second).After(creds.ExpiresAt) {
+		if creds, err = refresh(creds); err != nil {
+			return "", err
+		}
+	}
+
+	var res struct {
+		JWT string `json:"jwt"`
+	}
+	status, goaErr, err := post("/introspect", map[string]string{"token": creds.AccessToken}, &res)
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("token introspection failed: %s", errorName(status, goaErr))
+	}
+	return res.JWT, nil
+}
+
+// Logout removes the stored credentials.
+func Logout() error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func refresh(creds *Credentials) (*Credentials, error) {
+	var res tokenResult
+	status, goaErr, err := post("/auth/token/refresh", map[string]string{"refresh_token": creds.RefreshToken}, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		if status == http.StatusUnauthorized {
+			return nil, ErrNotLoggedIn
+		}
+		return nil, fmt.Errorf("token refresh failed: %s", errorName(status, goaErr))
+	}
+
+	creds = credentials(&res)
+	return creds, save(creds)
+}
+
+func credentials(res *tokenResult) *Credentials {
+	return &Credentials{
+		AccessToken:  res.AccessToken,
+		RefreshToken: res.RefreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(res.ExpiresIn) * time.Second),
+		UserID:       res.UserID,
+	}
+}
+
+func load() (*Credentials, error) {
+	path, err := credentialsPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotLoggedIn
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("invalid credentials file %s: %w", path, err)
+	}
+	return &creds, nil
+}
+
+func save(creds *Credentials) error {
+	path, err := credentialsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// post sends the JSON body to the auth service and decodes a successful
+// response into res. It returns the status and the goa error name.
+func post(path string, body any, res any) (int, string, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return 0, "", err
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Post(authURL()+path, "application/json", strings.NewReader(string(data)))
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to reach auth service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, resp.Header.Get("goa-error"), nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return 0, "", fmt.Errorf("invalid response of auth service: %w", err)
+	}
+	return resp.StatusCode, "", nil
+}
+
+func errorName(status int, goaErr string) string {
+	if goaErr != "" {
+		return goaErr
+	}
+	return fmt.Sprintf("status %d", status)
+}
+
+// Handle runs the login and logout commands given as the command line
+// arguments, it reports whether the command was one of them. For the other
+// commands it passes the JWT of the logged in user with --token unless a
+// token is given on the command line.
+func Handle() (bool, error) {
+	args := flag.Args()
+	if len(args) == 0 {
+		return false, nil
+	}
+
+	switch args[0] {
+	case "login":
+		loginFlags := flag.NewFlagSet("login", flag.ContinueOnError)
+		provider := loginFlags.String("provider", "github", "Identity provider (github, gitlab, google, oidc)")
+		if err := loginFlags.Parse(args[1:]); err != nil {
+			return true, err
+		}
+		creds, err := Login(*provider)
+		if err != nil {
+			return true, err
+		}
+		fmt.Fprintf(os.Stderr, "Logged in as %s\n", creds.UserID)
+		return true, nil
+	case "logout":
+		return true, Logout()
+	}
+
+	for _, arg := range os.Args[1:] {
+		if arg == "-h" || arg == "--help" || arg == "-help" {
+			return false, nil
+		}
+		name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+		if strings.HasPrefix(arg, "-") && name == "token" {
+			return false, nil
+		}
+	}
+	// Without an endpoint the client prints its usage.
+	if len(args) < 2 {
+		return false, nil
+	}
+
+	token, err := Token()
+	if err != nil {
+		return false, err
+	}
+	os.Args = append(os.Args, "--token", token)
+	return false, nil
+}
@@ -0,0 +1,3 @@
+module object-t.com/hackz-giganoto/pkg/cliauth
+
+go 1.24.0
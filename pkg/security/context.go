@@ -14,9 +14,41 @@ func (auth AuthInfo) String() string {
 		return "AuthInfo: UnAuthenticated"
 	}
 
+	if auth.IsService() {
+		return "AuthInfo: Service " + auth.ClientID()
+	}
+
 	return "AuthInfo: Authenticated"
 }
 
+// IsService reports whether the principal is a service client authenticated
+// with the client credentials grant rather than a user.
+func (auth AuthInfo) IsService() bool {
+	return auth.ClientID() != ""
+}
+
+// ClientID returns the client ID of a service principal, empty for users.
+func (auth AuthInfo) ClientID() string {
+	clientID, _ := auth.Claims["client_id"].(string)
+	return clientID
+}
+
+// UserID returns the ID of a user principal, empty for services.
+func (auth AuthInfo) UserID() string {
+	if auth.IsService() {
+		return ""
+	}
+	userID, _ := auth.Claims["sub"].(string)
+	return userID
+}
+
+// WorkspaceID returns the workspace the principal acts in, empty for the
+// default workspace.
+func (auth AuthInfo) WorkspaceID() string {
+	workspaceID, _ := auth.Claims["workspace_id"].(string)
+	return workspaceID
+}
+
 type ctxValue int
 
 const (
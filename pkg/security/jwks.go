@@ -0,0 +1,153 @@
+package security
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultJWKSURL = "http://auth:8000/.well-known/jwks.json"
+
+	// jwksTTL is how long the fetched keys are trusted before refetching.
+	jwksTTL = 5 * time.Minute
+	// jwksMinRefetch limits the refetches triggered by unknown key IDs.
+	jwksMinRefetch = 10 * time.Second
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// jwksCache keeps the public keys published by the auth service by key ID.
+// The keys are fetched outside of the lock, so that a slow auth service only
+// holds up the validations that wait for a key they do not have.
+type jwksCache struct {
+	url    string
+	client *http.Client
+	// fetches coalesces the concurrent fetches into one request.
+	fetches singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func newJWKSCache() *jwksCache {
+	url := os.Getenv("JWKS_URL")
+	if url == "" {
+		url = defaultJWKSURL
+	}
+
+	return &jwksCache{
+		url:    url,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// key returns the public key with the given ID. The keys are refetched when
+// they are stale or when the ID is unknown, as it may have just been rotated.
+func (c *jwksCache) key(kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	key, ok := c.keys[kid]
+	age := time.Since(c.fetchedAt)
+	c.mu.Unlock()
+
+	if ok {
+		if age >= jwksTTL {
+			// The stale key keeps verifying while the keys are refetched,
+			// and while auth is unreachable.
+			c.fetches.DoChan("jwks", c.fetch)
+		}
+		return key, nil
+	}
+	if age < jwksMinRefetch {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+
+	if _, err, _ := c.fetches.Do("jwks", c.fetch); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	key, ok = c.keys[kid]
+	c.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	return key, nil
+}
+
+// fetch fetches the keys and swaps them in. The fetch time is recorded once
+// done, so that the lookups arriving meanwhile join the fetch rather than
+// being throttled.
+func (c *jwksCache) fetch() (any, error) {
+	defer func() {
+		c.mu.Lock()
+		c.fetchedAt = time.Now()
+		c.mu.Unlock()
+	}()
+
+	resp, err := c.client.Get(c.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(body.Keys))
+	for _, k := range body.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, err
+		}
+		keys[k.Kid] = key
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.mu.Unlock()
+
+	return nil, nil
+}
+
+func (k jwk) publicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus for key %s: %w", k.Kid, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent for key %s: %w", k.Kid, err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
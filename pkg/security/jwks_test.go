@@ -0,0 +1,87 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testJWKS serves the JWKS of the key, blocking the requests until release
+// is closed.
+func testJWKS(t *testing.T, kid string, key *rsa.PublicKey, release <-chan struct{}) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var fetches atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		<-release
+		json.NewEncoder(w).Encode(map[string]any{"keys": []jwk{{
+			Kty: "RSA",
+			Kid: kid,
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &fetches
+}
+
+func TestJWKSCacheFetchesOutsideLock(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release := make(chan struct{})
+	srv, fetches := testJWKS(t, "new", &private.PublicKey, release)
+
+	cached := &private.PublicKey
+	c := &jwksCache{
+		url:       srv.URL,
+		client:    srv.Client(),
+		keys:      map[string]*rsa.PublicKey{"cached": cached},
+		fetchedAt: time.Now().Add(-jwksMinRefetch),
+	}
+
+	// Concurrent lookups of an unknown key share one fetch.
+	results := make(chan error, 3)
+	for range 3 {
+		go func() {
+			_, err := c.key("new")
+			results <- err
+		}()
+	}
+	for fetches.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// A cached key is served while the fetch is blocked.
+	done := make(chan struct{})
+	go func() {
+		if key, err := c.key("cached"); err != nil || key != cached {
+			t.Errorf("cached key = %v, %v", key, err)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("cached key lookup blocked by the fetch")
+	}
+
+	close(release)
+	for range 3 {
+		if err := <-results; err != nil {
+			t.Error(err)
+		}
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("JWKS fetched %d times, want 1", got)
+	}
+}
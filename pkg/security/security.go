@@ -7,27 +7,17 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	. "goa.design/goa/v3/dsl"
 	"goa.design/goa/v3/security"
-	"log"
-	"os"
 )
 
-var secret []byte
-
-func init() {
-	secretStr := os.Getenv("JWT_SECRET")
-
-	if secretStr == "" {
-		log.Fatal("FATAL: JWT_SECRET environment variable is not set.")
-	}
-
-	secret = []byte(secretStr)
-}
+// jwks caches the keys the auth service signs the internal JWTs with.
+var jwks = newJWKSCache()
 
 var JWTAuth = JWTSecurity("jwt", func() {
 	Description("JWT")
 
 	Scope("api:read", "APIリソースへの読み取りアクセス")
 	Scope("api:write", "APIリソースへの書き込みアクセス")
+	Scope("api:moderate", "モデレーターアクセス")
 	Scope("api:admin", "管理者アクセス")
 })
 
@@ -58,12 +48,13 @@ func ValidToken(tokenString string) (jwt.MapClaims, error) {
 	claims := make(jwt.MapClaims)
 
 	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("missing key ID")
 		}
 
-		return secret, nil
-	})
+		return jwks.key(kid)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {